@@ -0,0 +1,6 @@
+data "snowflake_replication_groups" "current" {
+}
+
+data "snowflake_replication_groups" "in_account" {
+  in_account = "<account_locator>"
+}
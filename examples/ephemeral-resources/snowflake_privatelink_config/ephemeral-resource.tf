@@ -0,0 +1,5 @@
+ephemeral "snowflake_privatelink_config" "config" {}
+
+provider "restapi" {
+  uri = "https://${ephemeral.snowflake_privatelink_config.config.account_url}"
+}
@@ -0,0 +1,15 @@
+ephemeral "snowflake_programmatic_access_token" "token" {
+  user                                      = "SERVICE_USER"
+  name                                      = "TERRAFORM_RUN_${formatdate("YYYYMMDDhhmmss", timestamp())}"
+  role_restriction                          = "ANALYST"
+  days_to_expiry                            = 1
+  mins_to_bypass_network_policy_requirement = 10
+  comment                                   = "Token used by the Terraform run"
+}
+
+provider "snowflake" {
+  alias    = "service"
+  account  = "ab12345"
+  user     = "SERVICE_USER"
+  password = ephemeral.snowflake_programmatic_access_token.token.token
+}
@@ -0,0 +1,12 @@
+ephemeral "snowflake_rsa_key_pair" "key" {
+  key_size = 4096
+}
+
+# Like the other ephemeral values, the keys can only be referenced from provider configurations, locals and other ephemeral resources.
+provider "restapi" {
+  alias = "secret_manager"
+  uri   = "https://secrets.example.com/api"
+  headers = {
+    X-Public-Key-Fingerprint = ephemeral.snowflake_rsa_key_pair.key.public_key_fingerprint
+  }
+}
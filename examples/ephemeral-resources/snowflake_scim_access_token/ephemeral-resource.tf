@@ -0,0 +1,11 @@
+ephemeral "snowflake_scim_access_token" "scim" {
+  integration_name = "AAD_PROVISIONING"
+}
+
+# The token can be passed to the configuration of another provider without being persisted in the state.
+provider "restapi" {
+  uri = "https://idp.example.com/api"
+  headers = {
+    Authorization = "Bearer ${ephemeral.snowflake_scim_access_token.scim.access_token}"
+  }
+}
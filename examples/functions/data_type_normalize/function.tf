@@ -0,0 +1,4 @@
+locals {
+  # NUMBER
+  data_type = provider::snowflake::data_type_normalize("int")
+}
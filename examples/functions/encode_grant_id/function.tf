@@ -0,0 +1,5 @@
+import {
+  to = snowflake_database_grant.grant
+  # DATABASE|USAGE|false|ROLE_A,ROLE_B|
+  id = provider::snowflake::encode_grant_id("DATABASE", "USAGE", false, ["ROLE_A", "ROLE_B"], [])
+}
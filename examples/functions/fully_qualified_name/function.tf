@@ -0,0 +1,4 @@
+locals {
+  # "DATABASE"."SCHEMA"."MY.TABLE"
+  table_name = provider::snowflake::fully_qualified_name("DATABASE", "SCHEMA", "MY.TABLE")
+}
@@ -0,0 +1,7 @@
+locals {
+  table = provider::snowflake::parse_identifier("\"DATABASE\".\"SCHEMA\".\"MY.TABLE\"")
+  # DATABASE
+  database_name = local.table.database
+  # MY.TABLE
+  table_name = local.table.name
+}
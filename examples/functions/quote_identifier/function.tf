@@ -0,0 +1,4 @@
+locals {
+  # "my.table"
+  quoted = provider::snowflake::quote_identifier("my.table")
+}
@@ -0,0 +1,9 @@
+resource "snowflake_session_policy" "default" {
+  database = "prod"
+  schema   = "security"
+  name     = "default_policy"
+}
+
+resource "snowflake_account_session_policy_attachment" "attachment" {
+  session_policy = snowflake_session_policy.default.qualified_name
+}
@@ -0,0 +1,15 @@
+resource "snowflake_application" "application" {
+  name                = "application"
+  application_package = snowflake_application_package.package.name
+  version             = snowflake_application_package_version.v1.version
+  patch               = snowflake_application_package_version.v1.patch
+  comment             = "Internal data quality app"
+}
+
+# installed in development mode from the files on a stage
+resource "snowflake_application" "development" {
+  name                = "application_dev"
+  application_package = snowflake_application_package.package.name
+  using               = "@database.schema.stage/dev"
+  debug_mode          = true
+}
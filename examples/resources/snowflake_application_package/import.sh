@@ -0,0 +1,2 @@
+# format is application package name
+terraform import snowflake_application_package.example 'applicationPackageName'
@@ -0,0 +1,5 @@
+resource "snowflake_application_package" "package" {
+  name         = "application_package"
+  distribution = "INTERNAL"
+  comment      = "Application package of the internal data quality app"
+}
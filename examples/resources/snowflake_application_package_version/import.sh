@@ -0,0 +1,2 @@
+# format is application package name | version
+terraform import snowflake_application_package_version.example 'applicationPackageName|V1'
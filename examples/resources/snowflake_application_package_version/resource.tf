@@ -0,0 +1,7 @@
+resource "snowflake_application_package_version" "v1" {
+  application_package       = snowflake_application_package.package.name
+  version                   = "V1"
+  using                     = "@database.schema.stage/v1"
+  label                     = "Version 1.0"
+  default_release_directive = true
+}
@@ -0,0 +1,12 @@
+resource "snowflake_event_table" "event_table" {
+  database = "database"
+  schema   = "schema"
+  name     = "event_table"
+  comment  = "Event table for UDF and procedure logs and traces"
+
+  cluster_by                  = ["timestamp"]
+  data_retention_time_in_days = 1
+  change_tracking             = true
+
+  associate_with_account = true
+}
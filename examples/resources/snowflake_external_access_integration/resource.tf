@@ -0,0 +1,24 @@
+resource "snowflake_network_rule" "rule" {
+  database   = "db"
+  schema     = "schema"
+  name       = "external_api_rule"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com"]
+}
+
+resource "snowflake_secret" "api_key" {
+  database      = "db"
+  schema        = "schema"
+  name          = "api_key"
+  secret_type   = "GENERIC_STRING"
+  secret_string = var.api_key
+}
+
+resource "snowflake_external_access_integration" "integration" {
+  name                           = "external_api_access"
+  allowed_network_rules          = [snowflake_network_rule.rule.qualified_name]
+  allowed_authentication_secrets = [snowflake_secret.api_key.qualified_name]
+  enabled                        = true
+  comment                        = "Allows the procedures to call the external API"
+}
@@ -0,0 +1,2 @@
+# format is external volume name
+terraform import snowflake_external_volume.example 'externalVolumeName'
@@ -0,0 +1,12 @@
+resource "snowflake_external_volume" "volume" {
+  name    = "iceberg_volume"
+  comment = "External volume of the Iceberg tables"
+
+  storage_location {
+    name                 = "us-west-2"
+    storage_provider     = "S3"
+    storage_base_url     = "s3://iceberg-bucket/tables/"
+    storage_aws_role_arn = "arn:aws:iam::123456789012:role/iceberg"
+    encryption_type      = "AWS_SSE_S3"
+  }
+}
@@ -0,0 +1,2 @@
+# format is application name | application role name | ROLE or APPLICATION | grantee name
+terraform import snowflake_grant_application_role.example 'applicationName|applicationRoleName|ROLE|roleName'
@@ -0,0 +1,11 @@
+# grant the application role to an account role
+resource "snowflake_grant_application_role" "to_account_role" {
+  application_role_name    = "\"my_application\".\"app_viewer\""
+  parent_account_role_name = "ANALYST"
+}
+
+# grant the application role to another application
+resource "snowflake_grant_application_role" "to_application" {
+  application_role_name = "\"my_application\".\"app_viewer\""
+  application_name      = "my_other_application"
+}
@@ -0,0 +1,43 @@
+# Iceberg table that uses Snowflake as the catalog
+resource "snowflake_iceberg_table" "managed" {
+  database        = "database"
+  schema          = "schema"
+  name            = "orders"
+  external_volume = snowflake_external_volume.volume.name
+  catalog         = "SNOWFLAKE"
+  base_location   = "orders/"
+  comment         = "Orders stored in Iceberg format"
+
+  column {
+    name     = "id"
+    type     = "NUMBER(38,0)"
+    nullable = false
+  }
+  column {
+    name = "customer"
+    type = "STRING"
+  }
+}
+
+# Iceberg table that uses an AWS Glue catalog integration, converted to a table managed by Snowflake
+resource "snowflake_iceberg_table" "glue" {
+  database           = "database"
+  schema             = "schema"
+  name               = "customers"
+  external_volume    = snowflake_external_volume.volume.name
+  catalog            = "glue_catalog_integration"
+  catalog_table_name = "customers"
+  catalog_namespace  = "sales"
+  convert_to_managed = true
+  base_location      = "customers/"
+}
+
+# Iceberg table created from Iceberg metadata in object storage
+resource "snowflake_iceberg_table" "object_store" {
+  database           = "database"
+  schema             = "schema"
+  name               = "events"
+  external_volume    = snowflake_external_volume.volume.name
+  catalog            = "object_store_catalog_integration"
+  metadata_file_path = "events/metadata/v1.metadata.json"
+}
@@ -5,3 +5,17 @@ resource "snowflake_network_policy" "policy" {
   allowed_ip_list = ["192.168.0.100/24"]
   blocked_ip_list = ["192.168.0.101"]
 }
+
+resource "snowflake_network_rule" "vpc_endpoints" {
+  database   = "db"
+  schema     = "schema"
+  name       = "vpc_endpoints"
+  type       = "AWSVPCEID"
+  mode       = "INGRESS"
+  value_list = ["vpce-0fa383eb170331202"]
+}
+
+resource "snowflake_network_policy" "private_link_policy" {
+  name                      = "private_link_policy"
+  allowed_network_rule_list = [snowflake_network_rule.vpc_endpoints.qualified_name]
+}
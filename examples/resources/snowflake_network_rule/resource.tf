@@ -0,0 +1,9 @@
+resource "snowflake_network_rule" "rule" {
+  database   = "db"
+  schema     = "schema"
+  name       = "external_api_rule"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com", "auth.example.com:443"]
+  comment    = "Allows access to the external API"
+}
@@ -3,7 +3,7 @@ resource "snowflake_schema" "schema" {
   name     = "schema"
   comment  = "A schema."
 
-  is_transient        = false
-  is_managed          = false
-  data_retention_days = 1
+  is_transient                = false
+  is_managed                  = false
+  data_retention_time_in_days = 1
 }
@@ -0,0 +1,2 @@
+# format is database name | schema name | secret name
+terraform import snowflake_secret.example 'dbName|schemaName|secretName'
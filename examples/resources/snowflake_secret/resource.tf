@@ -0,0 +1,36 @@
+resource "snowflake_secret" "password" {
+  database    = "db"
+  schema      = "schema"
+  name        = "api_password"
+  secret_type = "PASSWORD"
+  username    = "api_user"
+  password    = var.api_password
+  comment     = "Basic authentication to the external API"
+}
+
+resource "snowflake_secret" "generic_string" {
+  database      = "db"
+  schema        = "schema"
+  name          = "api_key"
+  secret_type   = "GENERIC_STRING"
+  secret_string = var.api_key
+}
+
+resource "snowflake_secret" "oauth_client_credentials" {
+  database           = "db"
+  schema             = "schema"
+  name               = "api_oauth"
+  secret_type        = "OAUTH2"
+  api_authentication = "api_security_integration"
+  oauth_scopes       = ["read", "write"]
+}
+
+resource "snowflake_secret" "oauth_authorization_code" {
+  database                        = "db"
+  schema                          = "schema"
+  name                            = "api_oauth_refresh"
+  secret_type                     = "OAUTH2"
+  api_authentication              = "api_security_integration"
+  oauth_refresh_token             = var.oauth_refresh_token
+  oauth_refresh_token_expiry_time = "2025-01-01 12:00:00"
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | session policy name
+terraform import snowflake_session_policy.example 'dbName|schemaName|sessionPolicyName'
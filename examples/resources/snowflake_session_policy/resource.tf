@@ -0,0 +1,8 @@
+resource "snowflake_session_policy" "idle_timeout" {
+  database                     = "prod"
+  schema                       = "security"
+  name                         = "idle_timeout_policy"
+  session_idle_timeout_mins    = 30
+  session_ui_idle_timeout_mins = 15
+  comment                      = "Idle timeout mandated by the security team"
+}
@@ -0,0 +1,40 @@
+resource "snowflake_task_graph" "etl" {
+  database = "database"
+  schema   = "schema"
+  enabled  = true
+
+  root {
+    name          = "etl_root"
+    schedule      = "USING CRON 0 * * * * UTC"
+    sql_statement = "CALL start_batch()"
+    warehouse     = "warehouse"
+  }
+
+  task {
+    name          = "etl_load_orders"
+    after         = ["etl_root"]
+    sql_statement = "CALL load_orders()"
+    warehouse     = "warehouse"
+  }
+
+  task {
+    name          = "etl_load_customers"
+    after         = ["etl_root"]
+    sql_statement = "CALL load_customers()"
+    warehouse     = "warehouse"
+  }
+
+  task {
+    name          = "etl_build_marts"
+    after         = ["etl_load_orders", "etl_load_customers"]
+    sql_statement = "CALL build_marts()"
+    when          = "SYSTEM$STREAM_HAS_DATA('ORDERS_STREAM')"
+    warehouse     = "warehouse"
+  }
+
+  finalizer {
+    name          = "etl_cleanup"
+    sql_statement = "CALL end_batch()"
+    warehouse     = "warehouse"
+  }
+}
@@ -0,0 +1,2 @@
+# format is user name | policy database name | policy schema name | session policy name
+terraform import snowflake_user_session_policy_attachment.example 'userName|dbName|schemaName|sessionPolicyName'
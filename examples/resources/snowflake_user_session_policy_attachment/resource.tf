@@ -0,0 +1,15 @@
+resource "snowflake_user" "user" {
+  name = "USER_NAME"
+}
+
+resource "snowflake_session_policy" "sp" {
+  database                  = "prod"
+  schema                    = "security"
+  name                      = "user_policy"
+  session_idle_timeout_mins = 10
+}
+
+resource "snowflake_user_session_policy_attachment" "spa" {
+  user_name      = snowflake_user.user.name
+  session_policy = snowflake_session_policy.sp.qualified_name
+}
@@ -3,10 +3,12 @@ package stringplanmodifiers
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// useStateForUnknownModifier implements the plan modifier.
+// suppressDiffIfModifier implements the plan modifier.
 type suppressDiffIfModifier struct {
 	f func(old, new string) bool
 }
@@ -21,15 +23,64 @@ func (m suppressDiffIfModifier) MarkdownDescription(_ context.Context) string {
 	return "Suppresses diff if values based on function."
 }
 
-// PlanModifyBool implements the plan modification logic.
+// PlanModifyString implements the plan modification logic.
 func (m suppressDiffIfModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// nothing to compare on create, nor when the planned value is not known yet
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
 	if m.f(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
 		resp.PlanValue = req.StateValue
 	}
 }
 
+// SuppressDiffIf keeps the value from the state when f reports it as equal to the planned one,
+// like DiffSuppressFunc does for the SDKv2 resources.
 func SuppressDiffIf(f func(old, new string) bool) planmodifier.String {
 	return suppressDiffIfModifier{
 		f: f,
 	}
 }
+
+// useStateForUnknownUnlessChangedModifier implements the plan modifier.
+type useStateForUnknownUnlessChangedModifier struct {
+	paths []path.Path
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useStateForUnknownUnlessChangedModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change, unless one of the attributes it depends on changes."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useStateForUnknownUnlessChangedModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change, unless one of the attributes it depends on changes."
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m useStateForUnknownUnlessChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// nothing to keep on create and destroy
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, p := range m.paths {
+		var planValue, stateValue types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() || !planValue.Equal(stateValue) {
+			return
+		}
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// UseStateForUnknownUnlessChanged works like stringplanmodifier.UseStateForUnknown, but leaves the value unknown
+// when any of the string attributes at paths changes, e.g. for an id derived from the name of the object.
+func UseStateForUnknownUnlessChanged(paths ...path.Path) planmodifier.String {
+	return useStateForUnknownUnlessChangedModifier{
+		paths: paths,
+	}
+}
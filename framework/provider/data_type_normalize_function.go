@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &DataTypeNormalizeFunction{}
+
+func NewDataTypeNormalizeFunction() function.Function {
+	return &DataTypeNormalizeFunction{}
+}
+
+type DataTypeNormalizeFunction struct{}
+
+func (f *DataTypeNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "data_type_normalize"
+}
+
+func (f *DataTypeNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalizes a data type.",
+		Description: "Returns the data type Snowflake reports for the given data type or one of its synonyms, e.g. data_type_normalize(\"int\") returns NUMBER and data_type_normalize(\"text\") returns VARCHAR.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "data_type",
+				Description: "The data type to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DataTypeNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dataType string
+	resp.Error = req.Arguments.Get(ctx, &dataType)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := sdk.ToDataType(dataType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, string(normalized))
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &EncodeGrantIDFunction{}
+
+func NewEncodeGrantIDFunction() function.Function {
+	return &EncodeGrantIDFunction{}
+}
+
+type EncodeGrantIDFunction struct{}
+
+func (f *EncodeGrantIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "encode_grant_id"
+}
+
+func (f *EncodeGrantIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encodes the ID of a grant resource.",
+		Description: "Joins the given parts with | in the format of the IDs of the grant resources, e.g. to import them. " +
+			"Each part is either a string, a bool or a list of strings, which is joined with commas.",
+		VariadicParameter: function.DynamicParameter{
+			Name:        "parts",
+			Description: "The parts of the ID, in the order expected by the resource.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EncodeGrantIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &parts)
+	if resp.Error != nil {
+		return
+	}
+
+	attributes := make([]interface{}, len(parts))
+	for i, part := range parts {
+		attribute, err := grantIDAttribute(part.UnderlyingValue())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("invalid part at position %d: %s", i, err))
+			return
+		}
+		attributes[i] = attribute
+	}
+	resp.Error = resp.Result.Set(ctx, helpers.EncodeSnowflakeID(attributes...))
+}
+
+// grantIDAttribute converts the value to one of the types helpers.EncodeSnowflakeID supports.
+func grantIDAttribute(value attr.Value) (interface{}, error) {
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.List:
+		return grantIDListAttribute(v.Elements())
+	case types.Set:
+		return grantIDListAttribute(v.Elements())
+	case types.Tuple:
+		return grantIDListAttribute(v.Elements())
+	default:
+		return nil, fmt.Errorf("expected a string, a bool or a list of strings, got %s", value.Type(context.Background()))
+	}
+}
+
+func grantIDListAttribute(elements []attr.Value) ([]string, error) {
+	list := make([]string, len(elements))
+	for i, element := range elements {
+		s, ok := element.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("expected a list of strings, got an element of type %s", element.Type(context.Background()))
+		}
+		list[i] = s.ValueString()
+	}
+	return list, nil
+}
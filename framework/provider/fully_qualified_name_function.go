@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &FullyQualifiedNameFunction{}
+
+func NewFullyQualifiedNameFunction() function.Function {
+	return &FullyQualifiedNameFunction{}
+}
+
+type FullyQualifiedNameFunction struct{}
+
+func (f *FullyQualifiedNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fully_qualified_name"
+}
+
+func (f *FullyQualifiedNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the fully qualified name of an object.",
+		Description: "Quotes the given database, schema, object and column names and joins them with dots, e.g. fully_qualified_name(\"db\", \"schema\", \"table\") returns \"db\".\"schema\".\"table\". Between one and four names can be given.",
+		VariadicParameter: function.StringParameter{
+			Name:        "names",
+			Description: "The names of the parent objects followed by the name of the object.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FullyQualifiedNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var names []string
+	resp.Error = req.Arguments.Get(ctx, &names)
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := objectIdentifierFromNames(names)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, id.FullyQualifiedName())
+}
+
+// objectIdentifierFromNames returns the identifier of the object with the given names, from the name of
+// the database down to the name of the object.
+func objectIdentifierFromNames(names []string) (sdk.ObjectIdentifier, error) {
+	for i, name := range names {
+		if name == "" {
+			return nil, fmt.Errorf("name at position %d is empty", i)
+		}
+	}
+	switch len(names) {
+	case 1:
+		return sdk.NewAccountObjectIdentifier(names[0]), nil
+	case 2:
+		return sdk.NewDatabaseObjectIdentifier(names[0], names[1]), nil
+	case 3:
+		return sdk.NewSchemaObjectIdentifier(names[0], names[1], names[2]), nil
+	case 4:
+		return sdk.NewTableColumnIdentifier(names[0], names[1], names[2], names[3]), nil
+	default:
+		return nil, fmt.Errorf("expected between 1 and 4 names, got %d", len(names))
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runFunction calls the function the way the framework does, passing the variadic arguments as a tuple.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	definition := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definition)
+	require.False(t, definition.Diagnostics.HasError())
+
+	if definition.Definition.VariadicParameter != nil {
+		elementTypes := make([]attr.Type, len(arguments))
+		for i, a := range arguments {
+			elementTypes[i] = a.Type(ctx)
+		}
+		arguments = []attr.Value{types.TupleValueMust(elementTypes, arguments)}
+	}
+
+	returnType := definition.Definition.Return.GetType()
+	result, err := returnType.ValueFromTerraform(ctx, tftypes.NewValue(returnType.TerraformType(ctx), tftypes.UnknownValue))
+	require.NoError(t, err)
+
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestFullyQualifiedNameFunction(t *testing.T) {
+	testCases := []struct {
+		names    []string
+		expected string
+	}{
+		{names: []string{"warehouse"}, expected: `"warehouse"`},
+		{names: []string{"db", "schema"}, expected: `"db"."schema"`},
+		{names: []string{"db", "schema", "my.table"}, expected: `"db"."schema"."my.table"`},
+		{names: []string{`"db"`, "schema", "table", "column"}, expected: `"db"."schema"."table"."column"`},
+	}
+	for _, tc := range testCases {
+		arguments := make([]attr.Value, len(tc.names))
+		for i, name := range tc.names {
+			arguments[i] = types.StringValue(name)
+		}
+		result, funcErr := runFunction(t, NewFullyQualifiedNameFunction(), arguments...)
+		require.Nil(t, funcErr)
+		assert.Equal(t, types.StringValue(tc.expected), result)
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewFullyQualifiedNameFunction())
+		assert.ErrorContains(t, funcErr, "expected between 1 and 4 names, got 0")
+
+		_, funcErr = runFunction(t, NewFullyQualifiedNameFunction(), types.StringValue("db"), types.StringValue(""))
+		assert.ErrorContains(t, funcErr, "name at position 1 is empty")
+	})
+}
+
+func TestParseIdentifierFunction(t *testing.T) {
+	testCases := []struct {
+		identifier string
+		expected   parsedIdentifierModel
+	}{
+		{
+			identifier: "warehouse",
+			expected:   parsedIdentifierModel{Database: types.StringNull(), Schema: types.StringNull(), Name: types.StringValue("warehouse"), FullyQualifiedName: types.StringValue(`"warehouse"`)},
+		},
+		{
+			identifier: "db.schema",
+			expected:   parsedIdentifierModel{Database: types.StringValue("db"), Schema: types.StringNull(), Name: types.StringValue("schema"), FullyQualifiedName: types.StringValue(`"db"."schema"`)},
+		},
+		{
+			identifier: `"db"."schema"."my.table"`,
+			expected:   parsedIdentifierModel{Database: types.StringValue("db"), Schema: types.StringValue("schema"), Name: types.StringValue("my.table"), FullyQualifiedName: types.StringValue(`"db"."schema"."my.table"`)},
+		},
+		{
+			identifier: "db|schema|table",
+			expected:   parsedIdentifierModel{Database: types.StringValue("db"), Schema: types.StringValue("schema"), Name: types.StringValue("table"), FullyQualifiedName: types.StringValue(`"db"."schema"."table"`)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.identifier, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewParseIdentifierFunction(), types.StringValue(tc.identifier))
+			require.Nil(t, funcErr)
+			var parsed parsedIdentifierModel
+			require.False(t, result.(types.Object).As(context.Background(), &parsed, basetypes.ObjectAsOptions{}).HasError())
+			assert.Equal(t, tc.expected, parsed)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewParseIdentifierFunction(), types.StringValue("db.schema.table.column"))
+		assert.ErrorContains(t, funcErr, "expected an account, database or schema object identifier")
+
+		_, funcErr = runFunction(t, NewParseIdentifierFunction(), types.StringValue(""))
+		assert.NotNil(t, funcErr)
+	})
+}
+
+func TestQuoteIdentifierFunction(t *testing.T) {
+	for name, expected := range map[string]string{
+		"table":      `"table"`,
+		"my.table":   `"my.table"`,
+		`"MY_TABLE"`: `"MY_TABLE"`,
+	} {
+		result, funcErr := runFunction(t, NewQuoteIdentifierFunction(), types.StringValue(name))
+		require.Nil(t, funcErr)
+		assert.Equal(t, types.StringValue(expected), result)
+	}
+
+	_, funcErr := runFunction(t, NewQuoteIdentifierFunction(), types.StringValue(""))
+	assert.ErrorContains(t, funcErr, "name is empty")
+}
+
+func TestEncodeGrantIDFunction(t *testing.T) {
+	result, funcErr := runFunction(t, NewEncodeGrantIDFunction(),
+		types.DynamicValue(types.StringValue("ROLE")),
+		types.DynamicValue(types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("USAGE"), types.StringValue("MONITOR")})),
+		types.DynamicValue(types.BoolValue(false)),
+		types.DynamicValue(types.ListValueMust(types.StringType, nil)),
+		types.DynamicValue(types.StringValue("DATABASE")),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue("ROLE|USAGE,MONITOR|false||DATABASE"), result)
+
+	_, funcErr = runFunction(t, NewEncodeGrantIDFunction(), types.DynamicValue(types.Int64Value(1)))
+	assert.ErrorContains(t, funcErr, "invalid part at position 0")
+}
+
+func TestDataTypeNormalizeFunction(t *testing.T) {
+	for dataType, expected := range map[string]string{
+		"int":           "NUMBER",
+		"NUMBER(38, 0)": "NUMBER",
+		"text":          "VARCHAR",
+		"datetime":      "TIMESTAMP_NTZ",
+		"bool":          "BOOLEAN",
+	} {
+		result, funcErr := runFunction(t, NewDataTypeNormalizeFunction(), types.StringValue(dataType))
+		require.Nil(t, funcErr)
+		assert.Equal(t, types.StringValue(expected), result)
+	}
+
+	_, funcErr := runFunction(t, NewDataTypeNormalizeFunction(), types.StringValue("unknown"))
+	assert.ErrorContains(t, funcErr, "invalid data type: unknown")
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/gookit/color"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 )
 
 type tfOperation string
@@ -40,6 +41,11 @@ func formatSQLPreview(operation tfOperation, resourceName string, id string, com
 	return sb.String()
 }
 
+// isKnownValue reports whether the value is neither null nor unknown.
+func isKnownValue(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
 type sensitiveAttributes struct {
 	m map[string]bool
 }
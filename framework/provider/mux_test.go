@@ -0,0 +1,103 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	pkgprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getProviderSchema(t *testing.T, server tfprotov6.ProviderServer) *tfprotov6.GetProviderSchemaResponse {
+	t.Helper()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+	return resp
+}
+
+func TestNewMuxServer(t *testing.T) {
+	providerServer, err := provider.NewMuxServer(context.Background(), "test", pkgprovider.Provider())
+	require.NoError(t, err)
+
+	resp := getProviderSchema(t, providerServer())
+	for _, resourceType := range []string{"snowflake_resource_monitor", "snowflake_role", "snowflake_warehouse", "snowflake_database"} {
+		assert.Contains(t, resp.ResourceSchemas, resourceType)
+	}
+	for _, resourceType := range []string{"snowflake_privatelink_config", "snowflake_programmatic_access_token", "snowflake_rsa_key_pair", "snowflake_scim_access_token"} {
+		assert.Contains(t, resp.EphemeralResourceSchemas, resourceType)
+	}
+	for _, functionName := range []string{"data_type_normalize", "encode_grant_id", "fully_qualified_name", "parse_identifier", "quote_identifier"} {
+		assert.Contains(t, resp.Functions, functionName)
+	}
+}
+
+// TestMovedResourcesSchemas checks that the resources served by the plugin framework provider keep the schemas
+// of their SDKv2 implementations, so that their states stay compatible.
+func TestMovedResourcesSchemas(t *testing.T) {
+	muxServer, err := acc.TestAccProtoV6ProviderFactories["snowflake"]()
+	require.NoError(t, err)
+	sdkServer, err := acc.TestAccSDKv2ProtoV6ProviderFactories["snowflake"]()
+	require.NoError(t, err)
+
+	muxSchemas := getProviderSchema(t, muxServer).ResourceSchemas
+	sdkSchemas := getProviderSchema(t, sdkServer).ResourceSchemas
+
+	for _, resourceType := range []string{"snowflake_resource_monitor", "snowflake_role", "snowflake_warehouse"} {
+		t.Run(resourceType, func(t *testing.T) {
+			require.Contains(t, muxSchemas, resourceType)
+			require.Contains(t, sdkSchemas, resourceType)
+			assert.Equal(t, sdkSchemas[resourceType].Version, muxSchemas[resourceType].Version)
+			assertSameBlocks(t, resourceType, sdkSchemas[resourceType].Block, muxSchemas[resourceType].Block)
+		})
+	}
+}
+
+// assertSameBlocks compares the attributes and the nested blocks. The computed flags are not compared, as the plugin framework
+// requires the attributes with default values to be computed, and neither is the id attribute, which is implicit in SDKv2.
+func assertSameBlocks(t *testing.T, path string, expected *tfprotov6.SchemaBlock, actual *tfprotov6.SchemaBlock) {
+	t.Helper()
+	assert.Equal(t, expected.Description, actual.Description, path)
+	assert.Equal(t, expected.Deprecated, actual.Deprecated, path)
+
+	attributes := make(map[string]*tfprotov6.SchemaAttribute)
+	for _, a := range actual.Attributes {
+		attributes[a.Name] = a
+	}
+	for _, e := range expected.Attributes {
+		if e.Name == "id" {
+			continue
+		}
+		a, ok := attributes[e.Name]
+		if !assert.True(t, ok, "missing attribute %s.%s", path, e.Name) {
+			continue
+		}
+		delete(attributes, e.Name)
+		assert.Equal(t, e.Type, a.Type, "type of %s.%s", path, e.Name)
+		assert.Equal(t, e.Required, a.Required, "required flag of %s.%s", path, e.Name)
+		assert.Equal(t, e.Optional, a.Optional, "optional flag of %s.%s", path, e.Name)
+		assert.Equal(t, e.Description, a.Description, "description of %s.%s", path, e.Name)
+		assert.Equal(t, e.Deprecated, a.Deprecated, "deprecated flag of %s.%s", path, e.Name)
+	}
+	delete(attributes, "id")
+	assert.Empty(t, attributes, "unexpected attributes of %s", path)
+
+	blocks := make(map[string]*tfprotov6.SchemaNestedBlock)
+	for _, b := range actual.BlockTypes {
+		blocks[b.TypeName] = b
+	}
+	for _, e := range expected.BlockTypes {
+		a, ok := blocks[e.TypeName]
+		if !assert.True(t, ok, "missing block %s.%s", path, e.TypeName) {
+			continue
+		}
+		delete(blocks, e.TypeName)
+		assert.Equal(t, e.Nesting, a.Nesting, "nesting of %s.%s", path, e.TypeName)
+		assertSameBlocks(t, path+"."+e.TypeName, e.Block, a.Block)
+	}
+	assert.Empty(t, blocks, "unexpected blocks of %s", path)
+}
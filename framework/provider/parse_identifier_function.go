@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseIdentifierFunction{}
+
+func NewParseIdentifierFunction() function.Function {
+	return &ParseIdentifierFunction{}
+}
+
+type ParseIdentifierFunction struct{}
+
+type parsedIdentifierModel struct {
+	Database           types.String `tfsdk:"database"`
+	Schema             types.String `tfsdk:"schema"`
+	Name               types.String `tfsdk:"name"`
+	FullyQualifiedName types.String `tfsdk:"fully_qualified_name"`
+}
+
+func (f *ParseIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_identifier"
+}
+
+func (f *ParseIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the identifier of an object.",
+		Description: "Splits the identifier of an account, database or schema object into the names of its database, schema and object. " +
+			"The identifier can be given with dots, e.g. \"db\".\"schema\".\"table\" or db.schema.table (names containing dots must be quoted), " +
+			"or in the format of the resource IDs, e.g. db|schema|table. The database and schema are null when they are not part of the identifier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "identifier",
+				Description: "The identifier to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"database":             types.StringType,
+				"schema":               types.StringType,
+				"name":                 types.StringType,
+				"fully_qualified_name": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var identifier string
+	resp.Error = req.Arguments.Get(ctx, &identifier)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseIdentifier(identifier)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, parsed)
+}
+
+func parseIdentifier(identifier string) (*parsedIdentifierModel, error) {
+	var id sdk.ObjectIdentifier
+	if strings.Contains(identifier, helpers.IDDelimiter) {
+		id = helpers.DecodeSnowflakeID(identifier)
+	} else {
+		var err error
+		if id, err = helpers.DecodeSnowflakeParameterID(identifier); err != nil {
+			return nil, err
+		}
+	}
+
+	parsed := &parsedIdentifierModel{
+		Database: types.StringNull(),
+		Schema:   types.StringNull(),
+	}
+	switch v := id.(type) {
+	case sdk.AccountObjectIdentifier:
+		parsed.Name = types.StringValue(v.Name())
+	case sdk.DatabaseObjectIdentifier:
+		parsed.Database = types.StringValue(v.DatabaseName())
+		parsed.Name = types.StringValue(v.Name())
+	case sdk.SchemaObjectIdentifier:
+		parsed.Database = types.StringValue(v.DatabaseName())
+		parsed.Schema = types.StringValue(v.SchemaName())
+		parsed.Name = types.StringValue(v.Name())
+	default:
+		return nil, fmt.Errorf("unable to parse identifier %s: expected an account, database or schema object identifier", identifier)
+	}
+	if parsed.Name.ValueString() == "" {
+		return nil, fmt.Errorf("unable to parse identifier %s: the name is empty", identifier)
+	}
+	parsed.FullyQualifiedName = types.StringValue(id.FullyQualifiedName())
+	return parsed, nil
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &PrivateLinkConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &PrivateLinkConfigEphemeralResource{}
+)
+
+func NewPrivateLinkConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &PrivateLinkConfigEphemeralResource{}
+}
+
+type PrivateLinkConfigEphemeralResource struct {
+	client *sdk.Client
+}
+
+// privateLinkConfigModel has the attributes of the snowflake_system_get_privatelink_config data source.
+type privateLinkConfigModel struct {
+	AccountName            types.String `tfsdk:"account_name"`
+	AccountURL             types.String `tfsdk:"account_url"`
+	OCSPURL                types.String `tfsdk:"ocsp_url"`
+	AwsVpceID              types.String `tfsdk:"aws_vpce_id"`
+	AzurePlsID             types.String `tfsdk:"azure_pls_id"`
+	InternalStage          types.String `tfsdk:"internal_stage"`
+	SnowsightURL           types.String `tfsdk:"snowsight_url"`
+	RegionlessSnowsightURL types.String `tfsdk:"regionless_snowsight_url"`
+	RegionlessAccountURL   types.String `tfsdk:"regionless_account_url"`
+}
+
+func (r *PrivateLinkConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privatelink_config"
+}
+
+func (r *PrivateLinkConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the private link configuration of the account with SYSTEM$GET_PRIVATELINK_CONFIG, without persisting it in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"account_name": schema.StringAttribute{
+				Description: "The name of your Snowflake account.",
+				Computed:    true,
+			},
+			"account_url": schema.StringAttribute{
+				Description: "The URL used to connect to Snowflake through AWS PrivateLink or Azure Private Link.",
+				Computed:    true,
+			},
+			"ocsp_url": schema.StringAttribute{
+				Description: "The OCSP URL corresponding to your Snowflake account that uses AWS PrivateLink or Azure Private Link.",
+				Computed:    true,
+			},
+			"aws_vpce_id": schema.StringAttribute{
+				Description: "The AWS VPCE ID for your account.",
+				Computed:    true,
+			},
+			"azure_pls_id": schema.StringAttribute{
+				Description: "The Azure Private Link Service ID for your account.",
+				Computed:    true,
+			},
+			"internal_stage": schema.StringAttribute{
+				Description: "The endpoint to connect to your Snowflake internal stage using AWS PrivateLink or Azure Private Link.",
+				Computed:    true,
+			},
+			"snowsight_url": schema.StringAttribute{
+				Description: "The URL containing the cloud region to access Snowsight and the Snowflake Marketplace using Private Connectivity to the Snowflake Service.",
+				Computed:    true,
+			},
+			"regionless_snowsight_url": schema.StringAttribute{
+				Description: "The URL for your organization to access Snowsight using Private Connectivity to the Snowflake Service.",
+				Computed:    true,
+			},
+			"regionless_account_url": schema.StringAttribute{
+				Description: "The regionless URL to connect to your Snowflake account using AWS PrivateLink, Azure Private Link, or Google Cloud Private Service Connect.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PrivateLinkConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	providerData := getProviderData(req.ProviderData, &resp.Diagnostics)
+	if providerData == nil {
+		return
+	}
+	r.client = providerData.client
+}
+
+func (r *PrivateLinkConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	config, err := r.client.SystemFunctions.GetPrivateLinkConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get private link config, got error: %s", err))
+		return
+	}
+
+	// The attributes which do not apply to the cloud of the account are null, as in the data source.
+	data := &privateLinkConfigModel{
+		AccountName:            types.StringValue(config.AccountName),
+		AccountURL:             types.StringValue(config.AccountURL),
+		OCSPURL:                types.StringValue(config.OCSPURL),
+		AwsVpceID:              optionalStringValue(config.AwsVpceID),
+		AzurePlsID:             optionalStringValue(config.AzurePrivateLinkServiceID),
+		InternalStage:          optionalStringValue(config.InternalStage),
+		SnowsightURL:           optionalStringValue(config.SnowsightURL),
+		RegionlessSnowsightURL: optionalStringValue(config.RegionlessSnowsightURL),
+		RegionlessAccountURL:   optionalStringValue(config.RegionlessAccountURL),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
+
+func optionalStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ProgrammaticAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ProgrammaticAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &ProgrammaticAccessTokenEphemeralResource{}
+)
+
+// programmaticAccessTokenPrivateKey is the key of the private data Open passes to Close.
+const programmaticAccessTokenPrivateKey = "programmatic_access_token"
+
+func NewProgrammaticAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ProgrammaticAccessTokenEphemeralResource{}
+}
+
+// ProgrammaticAccessTokenEphemeralResource adds a programmatic access token to a user when it is opened. The token is
+// kept until it expires, unless revoke_on_close is set, in which case it is removed when the resource is closed.
+type ProgrammaticAccessTokenEphemeralResource struct {
+	client  *sdk.Client
+	sqlPlan *sqlPlan
+}
+
+type programmaticAccessTokenModel struct {
+	User                                 types.String `tfsdk:"user"`
+	Name                                 types.String `tfsdk:"name"`
+	RoleRestriction                      types.String `tfsdk:"role_restriction"`
+	DaysToExpiry                         types.Int64  `tfsdk:"days_to_expiry"`
+	MinsToBypassNetworkPolicyRequirement types.Int64  `tfsdk:"mins_to_bypass_network_policy_requirement"`
+	Comment                              types.String `tfsdk:"comment"`
+	RevokeOnClose                        types.Bool   `tfsdk:"revoke_on_close"`
+	Token                                types.String `tfsdk:"token"`
+}
+
+type programmaticAccessTokenPrivateData struct {
+	User string `json:"user"`
+	Name string `json:"name"`
+}
+
+func (r *ProgrammaticAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_programmatic_access_token"
+}
+
+func (r *ProgrammaticAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a programmatic access token to a user. The token is opened on every plan and apply using it, so a new token is added each time; it is kept until it expires after `days_to_expiry`, unless `revoke_on_close` is set. A token name can be used only once per user, so the name should be unique to the run while the previous tokens have not expired. The secret of the token is never persisted in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Description: "The name of the user the token is added to.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the token.",
+				Required:    true,
+			},
+			"role_restriction": schema.StringAttribute{
+				Description: "The role used for privilege evaluation and object creation when the token is used for authentication.",
+				Optional:    true,
+			},
+			"days_to_expiry": schema.Int64Attribute{
+				Description: "The number of days after which the token expires.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"mins_to_bypass_network_policy_requirement": schema.Int64Attribute{
+				Description: "The number of minutes during which the user can authenticate with the token without being subject to a network policy.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Specifies a comment for the token.",
+				Optional:    true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Removes the token from the user when Terraform no longer needs it, at the end of every plan and apply. Any provider or system the token was handed to cannot use it afterwards. Defaults to false, in which case the token is kept until it expires.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The secret of the token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ProgrammaticAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	providerData := getProviderData(req.ProviderData, &resp.Diagnostics)
+	if providerData == nil {
+		return
+	}
+	r.client = providerData.client
+	r.sqlPlan = providerData.sqlPlan
+}
+
+func programmaticAccessTokenOptions(data *programmaticAccessTokenModel) *sdk.AddProgrammaticAccessTokenOptions {
+	opts := &sdk.AddProgrammaticAccessTokenOptions{
+		Name: sdk.NewAccountObjectIdentifier(data.Name.ValueString()),
+	}
+	if !data.RoleRestriction.IsNull() {
+		opts.RoleRestriction = sdk.String(data.RoleRestriction.ValueString())
+	}
+	if !data.DaysToExpiry.IsNull() {
+		opts.DaysToExpiry = sdk.Int(int(data.DaysToExpiry.ValueInt64()))
+	}
+	if !data.MinsToBypassNetworkPolicyRequirement.IsNull() {
+		opts.MinsToBypassNetworkPolicyRequirement = sdk.Int(int(data.MinsToBypassNetworkPolicyRequirement.ValueInt64()))
+	}
+	if !data.Comment.IsNull() {
+		opts.Comment = sdk.String(data.Comment.ValueString())
+	}
+	return opts
+}
+
+func (r *ProgrammaticAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data *programmaticAccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := sdk.NewAccountObjectIdentifier(data.User.ValueString())
+	opts := programmaticAccessTokenOptions(data)
+	if r.sqlPlan.enabled {
+		client := sdk.NewDryRunClient()
+		_, _ = client.Users.AddProgrammaticAccessToken(ctx, userID, opts)
+		if data.RevokeOnClose.ValueBool() {
+			_ = client.Users.RemoveProgrammaticAccessToken(ctx, userID, opts.Name)
+		}
+		resp.Diagnostics.Append(r.sqlPlan.render(CreateOperation, "snowflake_programmatic_access_token", data.Name.ValueString(), client.TraceLogs())...)
+		return
+	}
+
+	token, err := r.client.Users.AddProgrammaticAccessToken(ctx, userID, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add programmatic access token %v to user %v, got error: %s", opts.Name.Name(), userID.Name(), err))
+		return
+	}
+
+	// the private data is only passed to Close when the token has to be removed
+	if data.RevokeOnClose.ValueBool() {
+		private, err := json.Marshal(programmaticAccessTokenPrivateData{User: userID.Name(), Name: opts.Name.Name()})
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data of programmatic access token %v, got error: %s", opts.Name.Name(), err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, programmaticAccessTokenPrivateKey, private)...)
+	}
+
+	data.Token = types.StringValue(token.Secret)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
+
+func (r *ProgrammaticAccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, programmaticAccessTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data programmaticAccessTokenPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode private data of programmatic access token, got error: %s", err))
+		return
+	}
+
+	userID := sdk.NewAccountObjectIdentifier(data.User)
+	if err := r.client.Users.RemoveProgrammaticAccessToken(ctx, userID, sdk.NewAccountObjectIdentifier(data.Name)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove programmatic access token %v from user %v, got error: %s", data.Name, data.User, err))
+	}
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"net"
 	"net/url"
 	"os"
@@ -9,20 +11,30 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/snowflakedb/gosnowflake"
 )
 
 // Ensure SnowflakeProvider satisfies various provider interfaces.
-var _ provider.Provider = new(SnowflakeProvider)
+var (
+	_ provider.Provider                       = new(SnowflakeProvider)
+	_ provider.ProviderWithEphemeralResources = new(SnowflakeProvider)
+	_ provider.ProviderWithFunctions          = new(SnowflakeProvider)
+)
 
 // SnowflakeProvider defines the provider implementation.
 type SnowflakeProvider struct {
@@ -30,6 +42,8 @@ type SnowflakeProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// sdkProvider is the SDKv2 provider served along with this one, if any.
+	sdkProvider *sdkschema.Provider
 }
 
 // SnowflakeProviderModel describes the provider data model.
@@ -68,6 +82,17 @@ type snowflakeProviderModelV0 struct {
 	ClientStoreTemporaryCredential types.Bool   `tfsdk:"client_store_temporary_credential"`
 	DisableQueryContextCache       types.Bool   `tfsdk:"disable_query_context_cache"`
 	Profile                        types.String `tfsdk:"profile"`
+	DryRun                         types.Bool   `tfsdk:"dry_run"`
+	SQLPlanOutput                  types.String `tfsdk:"sql_plan_output"`
+	MaxRetryAttempts               types.Int64  `tfsdk:"max_retry_attempts"`
+	RetryInitialBackoff            types.Int64  `tfsdk:"retry_initial_backoff"`
+	RetryMaxBackoff                types.Int64  `tfsdk:"retry_max_backoff"`
+	RetryableErrors                types.Set    `tfsdk:"retryable_errors"`
+	RetryNonIdempotentStatements   types.Bool   `tfsdk:"retry_non_idempotent_statements"`
+	GrantBatchMode                 types.String `tfsdk:"grant_batch_mode"`
+	GrantBatchSize                 types.Int64  `tfsdk:"grant_batch_size"`
+	GrantBatchWindow               types.Int64  `tfsdk:"grant_batch_window"`
+	CacheShowResults               types.Bool   `tfsdk:"cache_show_results"`
 	// Deprecated Attributes
 	Username          types.String `tfsdk:"username"`
 	OauthAccessToken  types.String `tfsdk:"oauth_access_token"`
@@ -106,7 +131,7 @@ func (p *SnowflakeProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 			},
 			"username": schema.StringAttribute{
-				Description:        "Username for username+password authentication. Can also be sourced from the `SNOWFLAKE_USER` environment variable. Required unless using `profile`.",
+				Description:        "Username for username+password authentication. Can also be sourced from the `SNOWFLAKE_USERNAME` environment variable. Required unless using `profile`.",
 				Optional:           true,
 				DeprecationMessage: "Use `user` instead",
 			},
@@ -252,6 +277,72 @@ func (p *SnowflakeProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Description: "Should HTAP query context cache be disabled. Can also be sourced from the `SNOWFLAKE_DISABLE_QUERY_CONTEXT_CACHE` environment variable.",
 				Optional:    true,
 			},
+			"dry_run": schema.BoolAttribute{
+				Description: "If true, the Create, Update and Delete operations of resources render the SQL statements changing objects they would run instead of executing them. Affected resources fail with an error, so nothing is changed in Snowflake nor in the state. The queries reading objects (e.g. SHOW and DESCRIBE) are still executed against Snowflake and are not rendered. Can also be sourced from the `SNOWFLAKE_DRY_RUN` environment variable.",
+				Optional:    true,
+			},
+			"sql_plan_output": schema.StringAttribute{
+				Description: "Path to a file the statements rendered with `dry_run` are appended to. If not set, the statements are reported as warnings. Can also be sourced from the `SNOWFLAKE_SQL_PLAN_OUTPUT` environment variable.",
+				Optional:    true,
+			},
+			"max_retry_attempts": schema.Int64Attribute{
+				Description: "Maximum number of attempts of a statement failing with a transient error (see `retryable_errors`). Set to 1 to disable retries. Default is 3. Can also be sourced from the `SNOWFLAKE_MAX_RETRY_ATTEMPTS` environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_initial_backoff": schema.Int64Attribute{
+				Description: "Time in seconds to wait before the first retry of a statement; it is doubled after every attempt up to `retry_max_backoff`. Default is 1 second. Can also be sourced from the `SNOWFLAKE_RETRY_INITIAL_BACKOFF` environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_backoff": schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait between the attempts of a statement. Default is 30 seconds. Can also be sourced from the `SNOWFLAKE_RETRY_MAX_BACKOFF` environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retryable_errors": schema.SetAttribute{
+				Description: "Classes of transient errors for which statements are retried. Valid values include: concurrency_conflict, internal_error, service_unavailable, session_expired, statement_timeout, warehouse_suspended. Default is internal_error, service_unavailable and concurrency_conflict.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("concurrency_conflict", "internal_error", "service_unavailable", "session_expired", "statement_timeout", "warehouse_suspended")),
+				},
+			},
+			"retry_non_idempotent_statements": schema.BoolAttribute{
+				Description: "If true, statements that may have been applied before failing (e.g. `CREATE` without `IF NOT EXISTS`) are retried as well. By default, only statements that can safely run twice are retried. Can also be sourced from the `SNOWFLAKE_RETRY_NON_IDEMPOTENT_STATEMENTS` environment variable.",
+				Optional:    true,
+			},
+			"grant_batch_mode": schema.StringAttribute{
+				Description: "If set, the grant statements of resources applied concurrently are sent to Snowflake in batches instead of one by one. Valid values include: MULTI_STATEMENT (one multi-statement request), EXECUTE_IMMEDIATE (one anonymous block running every statement in its own exception handler). Errors are reported by the resource that issued the failing statement. Can also be sourced from the `SNOWFLAKE_GRANT_BATCH_MODE` environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(sdk.StatementBatchModeMultiStatement), string(sdk.StatementBatchModeExecuteImmediate)),
+				},
+			},
+			"grant_batch_size": schema.Int64Attribute{
+				Description: "Maximum number of statements in a batch when `grant_batch_mode` is set. Default is 100. Can also be sourced from the `SNOWFLAKE_GRANT_BATCH_SIZE` environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"grant_batch_window": schema.Int64Attribute{
+				Description: "Time in milliseconds a grant statement waits for other statements to join its batch when `grant_batch_mode` is set. Default is 200 milliseconds. Can also be sourced from the `SNOWFLAKE_GRANT_BATCH_WINDOW` environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"cache_show_results": schema.BoolAttribute{
+				Description: "If true, the results of SHOW statements are cached for the duration of a Terraform command, so that resources looking up objects of the same container (e.g. tables of a schema or future grants in it) query Snowflake once. The cache is invalidated after every write. Can also be sourced from the `SNOWFLAKE_CACHE_SHOW_RESULTS` environment variable.",
+				Optional:    true,
+			},
 			"profile": schema.StringAttribute{
 				Description: "Sets the profile to read from ~/.snowflake/config file. Can also be sourced from the `SNOWFLAKE_PROFILE` environment variable.",
 				Optional:    true,
@@ -395,6 +486,24 @@ func (p *SnowflakeProvider) Configure(ctx context.Context, req provider.Configur
 
 	// Read configuration data into model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When served together with the SDKv2 provider, the client it configured is shared, so that both of them run
+	// on one connection (authenticated once) and follow the same retry policy, SHOW cache and statement batching.
+	if p.sdkProvider != nil {
+		db, ok := p.sdkProvider.Meta().(*sql.DB)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unconfigured SDKv2 Provider",
+				"The SDKv2 provider has to be configured before the plugin framework provider. Please report this issue to the provider developers.",
+			)
+			return
+		}
+		p.setProviderData(sdk.NewClientFromDB(db), data, resp)
+		return
+	}
 
 	config := &gosnowflake.Config{
 		Application: "terraform-provider-snowflake",
@@ -785,20 +894,60 @@ func (p *SnowflakeProvider) Configure(ctx context.Context, req provider.Configur
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	p.setProviderData(client, data, resp)
+}
+
+func (p *SnowflakeProvider) setProviderData(client *sdk.Client, data snowflakeProviderModelV0, resp *provider.ConfigureResponse) {
+	dryRun := getBoolEnv("SNOWFLAKE_DRY_RUN", false)
+	if !data.DryRun.IsNull() && !data.DryRun.IsUnknown() {
+		dryRun = data.DryRun.ValueBool()
+	}
+	sqlPlanOutput := os.Getenv("SNOWFLAKE_SQL_PLAN_OUTPUT")
+	if data.SQLPlanOutput.ValueString() != "" {
+		sqlPlanOutput = data.SQLPlanOutput.ValueString()
+	}
+
 	providerData := &ProviderData{
 		client: client,
+		sqlPlan: &sqlPlan{
+			enabled: dryRun,
+			output:  sqlPlanOutput,
+		},
 	}
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
+// ProviderData is shared with the resources, data sources and ephemeral resources on Configure.
 type ProviderData struct {
-	client *sdk.Client
+	client  *sdk.Client
+	sqlPlan *sqlPlan
+}
+
+// getProviderData extracts the ProviderData passed to Configure of the resources, data sources and ephemeral resources.
+// It returns nil if the provider has not been configured yet.
+func getProviderData(data any, diags *diag.Diagnostics) *ProviderData {
+	// Prevent panic if the provider has not been configured.
+	if data == nil {
+		return nil
+	}
+	providerData, ok := data.(*ProviderData)
+	if !ok {
+		diags.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", data),
+		)
+		return nil
+	}
+	return providerData
 }
 
 func (p *SnowflakeProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		// NewResourceMonitorResource,
+		NewResourceMonitorResource,
+		NewRoleResource,
+		NewWarehouseResource,
 	}
 }
 
@@ -806,10 +955,34 @@ func (p *SnowflakeProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{}
 }
 
-func New(version string) func() provider.Provider {
+// EphemeralResources returns the resources whose results, usually secrets, are never persisted in the plan or the state.
+func (p *SnowflakeProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewPrivateLinkConfigEphemeralResource,
+		NewProgrammaticAccessTokenEphemeralResource,
+		NewRSAKeyPairEphemeralResource,
+		NewSCIMAccessTokenEphemeralResource,
+	}
+}
+
+// Functions returns the functions available as provider::snowflake::<name> in the configurations.
+func (p *SnowflakeProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDataTypeNormalizeFunction,
+		NewEncodeGrantIDFunction,
+		NewFullyQualifiedNameFunction,
+		NewParseIdentifierFunction,
+		NewQuoteIdentifierFunction,
+	}
+}
+
+// New returns the plugin framework provider. When sdkProvider is given, the client it configures is shared
+// with the resources of the plugin framework provider instead of opening a new connection (see NewMuxServer).
+func New(version string, sdkProvider *sdkschema.Provider) func() provider.Provider {
 	return func() provider.Provider {
 		return &SnowflakeProvider{
-			version: version,
+			version:     version,
+			sdkProvider: sdkProvider,
 		}
 	}
 }
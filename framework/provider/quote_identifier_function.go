@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &QuoteIdentifierFunction{}
+
+func NewQuoteIdentifierFunction() function.Function {
+	return &QuoteIdentifierFunction{}
+}
+
+type QuoteIdentifierFunction struct{}
+
+func (f *QuoteIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quote_identifier"
+}
+
+func (f *QuoteIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Quotes an identifier.",
+		Description: "Wraps the name in double quotes, so that it is used as is by Snowflake. Names which are already quoted are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to quote.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QuoteIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	quoted := sdk.NewAccountObjectIdentifier(name).FullyQualifiedName()
+	if quoted == "" {
+		resp.Error = function.NewArgumentFuncError(0, "name is empty")
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, quoted)
+}
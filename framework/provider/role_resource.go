@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	stringplanmodifiers "github.com/Snowflake-Labs/terraform-provider-snowflake/framework/planmodifiers"
+	stringvalidators "github.com/Snowflake-Labs/terraform-provider-snowflake/framework/validators"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &RoleResource{}
+	_ resource.ResourceWithConfigure   = &RoleResource{}
+	_ resource.ResourceWithImportState = &RoleResource{}
+	_ resource.ResourceWithModifyPlan  = &RoleResource{}
+)
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+type RoleResource struct {
+	client  *sdk.Client
+	sqlPlan *sqlPlan
+}
+
+// roleModel mirrors the state of the SDKv2 snowflake_role resource.
+type roleModel struct {
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+	Tag     []tagModel   `tfsdk:"tag"`
+	Id      types.String `tfsdk:"id"`
+}
+
+// tagModel is an element of the deprecated tag block.
+type tagModel struct {
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Database types.String `tfsdk:"database"`
+	Schema   types.String `tfsdk:"schema"`
+}
+
+func (t tagModel) objectIdentifier() sdk.ObjectIdentifier {
+	switch {
+	case t.Database.ValueString() != "" && t.Schema.ValueString() != "":
+		return sdk.NewSchemaObjectIdentifier(t.Database.ValueString(), t.Schema.ValueString(), t.Name.ValueString())
+	case t.Database.ValueString() != "":
+		return sdk.NewDatabaseObjectIdentifier(t.Database.ValueString(), t.Name.ValueString())
+	default:
+		return sdk.NewAccountObjectIdentifier(t.Name.ValueString())
+	}
+}
+
+func (t tagModel) tagAssociation() sdk.TagAssociation {
+	return sdk.TagAssociation{
+		Name:  t.objectIdentifier(),
+		Value: t.Value.ValueString(),
+	}
+}
+
+// tagBlock is the framework counterpart of the tag block of the SDKv2 resources.
+func tagBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description:        "Definitions of a tag to associate with the resource.",
+		DeprecationMessage: "Use the 'snowflake_tag_association' resource instead.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Tag name, e.g. department.",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "Tag value, e.g. marketing_info.",
+					Required:    true,
+				},
+				"database": schema.StringAttribute{
+					Description: "Name of the database that the tag was created in.",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+				},
+				"schema": schema.StringAttribute{
+					Description: "Name of the schema that the tag was created in.",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+				},
+			},
+		},
+	}
+}
+
+// tagsDiff returns the tags to unset and the tags to set (added or with a changed value), matching them by name.
+func tagsDiff(oldTags, newTags []tagModel) (unset []sdk.ObjectIdentifier, set []sdk.TagAssociation) {
+	for _, o := range oldTags {
+		found := false
+		for _, n := range newTags {
+			if o.Name.Equal(n.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unset = append(unset, o.objectIdentifier())
+		}
+	}
+	for _, n := range newTags {
+		changed := true
+		for _, o := range oldTags {
+			if o == n {
+				changed = false
+				break
+			}
+		}
+		if changed {
+			set = append(set, n.tagAssociation())
+		}
+	}
+	return unset, set
+}
+
+func roleSchema() schema.Schema {
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifiers.UseStateForUnknownUnlessChanged(path.Root("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:  true,
+				Sensitive: isSensitive("snowflake_role.*.name"),
+				Validators: []validator.String{
+					stringvalidators.Identifier(".", " ", ":", "(", ")"),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				// the SDKv2 resource stores an empty string when the role has no comment
+				Computed:  true,
+				Default:   stringdefault.StaticString(""),
+				Sensitive: isSensitive("snowflake_role.*.comment"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag": tagBlock(),
+		},
+	}
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = roleSchema()
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData := getProviderData(req.ProviderData, &resp.Diagnostics)
+	if providerData == nil {
+		return
+	}
+	r.client = providerData.client
+	r.sqlPlan = providerData.sqlPlan
+}
+
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// we aren't really modifying the plan, just logging what the plan intends to do
+	var plan, state *roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resourceName := "snowflake_role"
+	switch {
+	// DELETE
+	case plan == nil:
+		_, logs, _ := r.delete(ctx, state, true)
+		tflog.Debug(ctx, formatSQLPreview(DeleteOperation, resourceName, state.Id.ValueString(), logs))
+	// CREATE
+	case state == nil:
+		_, logs, _ := r.create(ctx, plan, true)
+		tflog.Debug(ctx, formatSQLPreview(CreateOperation, resourceName, "", logs))
+	// UPDATE
+	default:
+		_, logs, _ := r.update(ctx, plan, state, true)
+		if len(logs) > 0 {
+			tflog.Debug(ctx, formatSQLPreview(UpdateOperation, resourceName, state.Id.ValueString(), logs))
+		}
+	}
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.sqlPlan.enabled {
+		_, logs, diags := r.create(ctx, data, true)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.sqlPlan.render(CreateOperation, "snowflake_role", data.Name.ValueString(), logs)...)
+		return
+	}
+	defer r.client.SuspendShowCache()()
+
+	data, _, diags := r.create(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *RoleResource) create(ctx context.Context, data *roleModel, dryRun bool) (*roleModel, []string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	client := r.client
+	if dryRun {
+		client = newDryRunClient(r.client)
+	}
+
+	name := data.Name.ValueString()
+	request := sdk.NewCreateRoleRequest(sdk.NewAccountObjectIdentifier(name))
+	if data.Comment.ValueString() != "" {
+		request.WithComment(data.Comment.ValueString())
+	}
+	if len(data.Tag) > 0 {
+		tags := make([]sdk.TagAssociation, 0, len(data.Tag))
+		for _, t := range data.Tag {
+			tags = append(tags, t.tagAssociation())
+		}
+		request.WithTag(tags)
+	}
+
+	err := client.Roles.Create(ctx, request)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create role %v, got error: %s", name, err))
+		return data, nil, diags
+	}
+	if dryRun {
+		return data, client.TraceLogs(), diags
+	}
+
+	data.Id = types.StringValue(name)
+	data, readDiags := r.read(ctx, data)
+	diags.Append(readDiags...)
+	if data == nil && !readDiags.HasError() {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read role %v after creation", name))
+	}
+	return data, nil, diags
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, diags := r.read(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data == nil {
+		tflog.Debug(ctx, "role not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// read returns nil data when the role does not exist anymore, and the error diagnostics when it cannot be read.
+func (r *RoleResource) read(ctx context.Context, data *roleModel) (*roleModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	// the name is not set on import, in which case the id is used
+	name := data.Name.ValueString()
+	if name == "" {
+		name = data.Id.ValueString()
+	}
+	role, err := r.client.Roles.ShowByID(ctx, sdk.NewShowByIdRoleRequest(sdk.NewAccountObjectIdentifier(name)))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			tflog.Debug(ctx, fmt.Sprintf("role %v not found: %s", name, err))
+			return nil, diags
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read role %v, got error: %s", name, err))
+		return nil, diags
+	}
+
+	data.Name = types.StringValue(role.Name)
+	data.Comment = types.StringValue(role.Comment)
+	return data, diags
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.sqlPlan.enabled {
+		_, logs, diags := r.update(ctx, plan, state, true)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.sqlPlan.render(UpdateOperation, "snowflake_role", state.Id.ValueString(), logs)...)
+		return
+	}
+	defer r.client.SuspendShowCache()()
+
+	data, _, diags := r.update(ctx, plan, state, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *RoleResource) update(ctx context.Context, plan *roleModel, state *roleModel, dryRun bool) (*roleModel, []string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	client := r.client
+	if dryRun {
+		client = newDryRunClient(r.client)
+	}
+
+	// ALTER ROLE accepts a single change at a time
+	var requests []*sdk.AlterRoleRequest
+	id := sdk.NewAccountObjectIdentifier(state.Name.ValueString())
+	if !plan.Name.Equal(state.Name) {
+		newId := sdk.NewAccountObjectIdentifier(plan.Name.ValueString())
+		requests = append(requests, sdk.NewAlterRoleRequest(id).WithRenameTo(newId))
+		id = newId
+	}
+	if !plan.Comment.Equal(state.Comment) {
+		if plan.Comment.ValueString() == "" {
+			requests = append(requests, sdk.NewAlterRoleRequest(id).WithUnsetComment(true))
+		} else {
+			requests = append(requests, sdk.NewAlterRoleRequest(id).WithSetComment(plan.Comment.ValueString()))
+		}
+	}
+	unsetTags, setTags := tagsDiff(state.Tag, plan.Tag)
+	if len(unsetTags) > 0 {
+		requests = append(requests, sdk.NewAlterRoleRequest(id).WithUnsetTags(unsetTags))
+	}
+	if len(setTags) > 0 {
+		requests = append(requests, sdk.NewAlterRoleRequest(id).WithSetTags(setTags))
+	}
+
+	for _, request := range requests {
+		if err := client.Roles.Alter(ctx, request); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update role %v, got error: %s", state.Name.ValueString(), err))
+			return plan, nil, diags
+		}
+	}
+	if dryRun {
+		return plan, client.TraceLogs(), diags
+	}
+
+	plan.Id = types.StringValue(plan.Name.ValueString())
+	data, readDiags := r.read(ctx, plan)
+	diags.Append(readDiags...)
+	if data == nil {
+		if !readDiags.HasError() {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read role %v after update", plan.Name.ValueString()))
+		}
+		return plan, nil, diags
+	}
+	return data, nil, diags
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.sqlPlan.enabled {
+		_, logs, diags := r.delete(ctx, data, true)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.sqlPlan.render(DeleteOperation, "snowflake_role", data.Id.ValueString(), logs)...)
+		return
+	}
+	defer r.client.SuspendShowCache()()
+
+	_, _, diags := r.delete(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoleResource) delete(ctx context.Context, data *roleModel, dryRun bool) (*roleModel, []string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	client := r.client
+	if dryRun {
+		client = newDryRunClient(r.client)
+	}
+
+	// the SDKv2 resource did not update the id on rename, so the name is used
+	id := sdk.NewAccountObjectIdentifier(data.Name.ValueString())
+	err := client.Roles.Drop(ctx, sdk.NewDropRoleRequest(id))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete role %v, got error: %s", id.Name(), err))
+	}
+	if dryRun {
+		return data, client.TraceLogs(), diags
+	}
+	return data, nil, diags
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
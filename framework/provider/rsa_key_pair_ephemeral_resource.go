@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &RSAKeyPairEphemeralResource{}
+
+const defaultRSAKeySize = 2048
+
+func NewRSAKeyPairEphemeralResource() ephemeral.EphemeralResource {
+	return &RSAKeyPairEphemeralResource{}
+}
+
+// RSAKeyPairEphemeralResource generates a key pair for key-pair authentication locally, without connecting to Snowflake.
+type RSAKeyPairEphemeralResource struct{}
+
+type rsaKeyPairModel struct {
+	KeySize              types.Int64  `tfsdk:"key_size"`
+	PrivateKeyPEM        types.String `tfsdk:"private_key_pem"`
+	PublicKeyPEM         types.String `tfsdk:"public_key_pem"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+}
+
+func (r *RSAKeyPairEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rsa_key_pair"
+}
+
+func (r *RSAKeyPairEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an RSA key pair for key-pair authentication. The private key is never persisted in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"key_size": schema.Int64Attribute{
+				Description: fmt.Sprintf("The size of the key in bits. Defaults to %d.", defaultRSAKeySize),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2048, 3072, 4096),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				Description: "The private key in the unencrypted PKCS#8 PEM format expected by the private_key attribute of the provider.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"public_key_pem": schema.StringAttribute{
+				Description: "The public key in the PEM format.",
+				Computed:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key without the PEM header, footer and line breaks, as expected by the rsa_public_key attribute of snowflake_user.",
+				Computed:    true,
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Description: "The fingerprint of the public key, as returned by Snowflake in the RSA_PUBLIC_KEY_FP property of the user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *RSAKeyPairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data *rsaKeyPairModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keySize := defaultRSAKeySize
+	if !data.KeySize.IsNull() {
+		keySize = int(data.KeySize.ValueInt64())
+	}
+	keyPair, err := generateRSAKeyPair(keySize)
+	if err != nil {
+		resp.Diagnostics.AddError("Key Generation Error", fmt.Sprintf("Unable to generate RSA key pair, got error: %s", err))
+		return
+	}
+
+	data.KeySize = types.Int64Value(int64(keySize))
+	data.PrivateKeyPEM = types.StringValue(keyPair.privateKeyPEM)
+	data.PublicKeyPEM = types.StringValue(keyPair.publicKeyPEM)
+	data.PublicKey = types.StringValue(keyPair.publicKey)
+	data.PublicKeyFingerprint = types.StringValue(keyPair.publicKeyFingerprint)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
+
+type rsaKeyPair struct {
+	privateKeyPEM        string
+	publicKeyPEM         string
+	publicKey            string
+	publicKeyFingerprint string
+}
+
+func generateRSAKeyPair(bits int) (*rsaKeyPair, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := sha256.Sum256(publicKeyDER)
+	return &rsaKeyPair{
+		privateKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDER})),
+		publicKeyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})),
+		// The body of the PEM block, on a single line.
+		publicKey:            base64.StdEncoding.EncodeToString(publicKeyDER),
+		publicKeyFingerprint: "SHA256:" + base64.StdEncoding.EncodeToString(fingerprint[:]),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRSAKeyPair(t *testing.T) {
+	keyPair, err := generateRSAKeyPair(2048)
+	require.NoError(t, err)
+
+	privateBlock, _ := pem.Decode([]byte(keyPair.privateKeyPEM))
+	require.NotNil(t, privateBlock)
+	assert.Equal(t, "PRIVATE KEY", privateBlock.Type)
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateBlock.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, 2048, privateKey.(*rsa.PrivateKey).N.BitLen())
+
+	publicBlock, _ := pem.Decode([]byte(keyPair.publicKeyPEM))
+	require.NotNil(t, publicBlock)
+	assert.Equal(t, "PUBLIC KEY", publicBlock.Type)
+	publicKey, err := x509.ParsePKIXPublicKey(publicBlock.Bytes)
+	require.NoError(t, err)
+	assert.True(t, privateKey.(*rsa.PrivateKey).PublicKey.Equal(publicKey))
+
+	assert.Equal(t, base64.StdEncoding.EncodeToString(publicBlock.Bytes), keyPair.publicKey)
+	fingerprint := sha256.Sum256(publicBlock.Bytes)
+	assert.Equal(t, "SHA256:"+base64.StdEncoding.EncodeToString(fingerprint[:]), keyPair.publicKeyFingerprint)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &SCIMAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &SCIMAccessTokenEphemeralResource{}
+)
+
+func NewSCIMAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &SCIMAccessTokenEphemeralResource{}
+}
+
+type SCIMAccessTokenEphemeralResource struct {
+	client *sdk.Client
+}
+
+type scimAccessTokenModel struct {
+	IntegrationName types.String `tfsdk:"integration_name"`
+	AccessToken     types.String `tfsdk:"access_token"`
+}
+
+func (r *SCIMAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_access_token"
+}
+
+func (r *SCIMAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a new SCIM access token for a SCIM integration with SYSTEM$GENERATE_SCIM_ACCESS_TOKEN. The token is never persisted in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"integration_name": schema.StringAttribute{
+				Description: "SCIM Integration Name",
+				Required:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "SCIM Access Token",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *SCIMAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	providerData := getProviderData(req.ProviderData, &resp.Diagnostics)
+	if providerData == nil {
+		return
+	}
+	r.client = providerData.client
+}
+
+func (r *SCIMAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data *scimAccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := sdk.NewAccountObjectIdentifier(data.IntegrationName.ValueString())
+	token, err := r.client.SystemFunctions.GenerateSCIMAccessToken(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate SCIM access token for integration %v, got error: %s", id.Name(), err))
+		return
+	}
+	data.AccessToken = types.StringValue(token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
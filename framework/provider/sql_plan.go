@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// newDryRunClient returns the client the operations run on with dryRun. It records the statements changing objects,
+// and runs the queries reading them on client, so the statements are computed from the current state of the objects.
+func newDryRunClient(client *sdk.Client) *sdk.Client {
+	if client == nil {
+		return sdk.NewDryRunClient()
+	}
+	return sdk.NewDryRunClientReadingFrom(client.GetConn().DB)
+}
+
+// sqlPlan renders the statements Create, Update and Delete operations would run instead of executing them,
+// when the provider is configured with dry_run. The resources render them by running their operations
+// against newDryRunClient (see the dryRun flag of their create, update and delete helpers).
+type sqlPlan struct {
+	enabled bool
+	output  string
+	mu      sync.Mutex
+}
+
+func (p *sqlPlan) render(operation tfOperation, resourceName string, id string, statements []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan := formatSQLPlan(operation, resourceName, id, statements)
+	if p.output == "" {
+		diags.AddWarning(fmt.Sprintf("SQL plan for %s %s", operation, resourceName), plan)
+		diags.AddError("dry_run is enabled", fmt.Sprintf("%d statement(s) for %s %s were rendered instead of being executed.", len(statements), resourceName, id))
+		return diags
+	}
+
+	if err := p.write(plan); err != nil {
+		diags.AddError("Unable to write SQL plan", fmt.Sprintf("could not write SQL plan to %s: %s", p.output, err))
+		return diags
+	}
+	diags.AddError("dry_run is enabled", fmt.Sprintf("%d statement(s) for %s %s were written to %s instead of being executed.", len(statements), resourceName, id, p.output))
+	return diags
+}
+
+func (p *sqlPlan) write(plan string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f, err := os.OpenFile(p.output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(plan); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// formatSQLPlan renders the statements in the format used by the SDKv2 provider, so that the plans of
+// the resources of both providers can be appended to the same sql_plan_output file.
+func formatSQLPlan(operation tfOperation, resourceName string, id string, statements []string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.TrimSpace(fmt.Sprintf("-- %s %s %s", operation, resourceName, id)))
+	sb.WriteString("\n")
+	for _, statement := range statements {
+		sb.WriteString(strings.TrimSuffix(strings.TrimSpace(statement), ";"))
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
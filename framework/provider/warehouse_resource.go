@@ -0,0 +1,673 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	stringplanmodifiers "github.com/Snowflake-Labs/terraform-provider-snowflake/framework/planmodifiers"
+	stringvalidators "github.com/Snowflake-Labs/terraform-provider-snowflake/framework/validators"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &WarehouseResource{}
+	_ resource.ResourceWithConfigure   = &WarehouseResource{}
+	_ resource.ResourceWithImportState = &WarehouseResource{}
+	_ resource.ResourceWithModifyPlan  = &WarehouseResource{}
+)
+
+func NewWarehouseResource() resource.Resource {
+	return &WarehouseResource{}
+}
+
+type WarehouseResource struct {
+	client  *sdk.Client
+	sqlPlan *sqlPlan
+}
+
+// warehouseModel mirrors the state of the SDKv2 snowflake_warehouse resource.
+type warehouseModel struct {
+	Name                            types.String `tfsdk:"name"`
+	Comment                         types.String `tfsdk:"comment"`
+	WarehouseSize                   types.String `tfsdk:"warehouse_size"`
+	MaxClusterCount                 types.Int64  `tfsdk:"max_cluster_count"`
+	MinClusterCount                 types.Int64  `tfsdk:"min_cluster_count"`
+	ScalingPolicy                   types.String `tfsdk:"scaling_policy"`
+	AutoSuspend                     types.Int64  `tfsdk:"auto_suspend"`
+	AutoResume                      types.Bool   `tfsdk:"auto_resume"`
+	InitiallySuspended              types.Bool   `tfsdk:"initially_suspended"`
+	ResourceMonitor                 types.String `tfsdk:"resource_monitor"`
+	WaitForProvisioning             types.Bool   `tfsdk:"wait_for_provisioning"`
+	StatementTimeoutInSeconds       types.Int64  `tfsdk:"statement_timeout_in_seconds"`
+	StatementQueuedTimeoutInSeconds types.Int64  `tfsdk:"statement_queued_timeout_in_seconds"`
+	MaxConcurrencyLevel             types.Int64  `tfsdk:"max_concurrency_level"`
+	EnableQueryAcceleration         types.Bool   `tfsdk:"enable_query_acceleration"`
+	QueryAccelerationMaxScaleFactor types.Int64  `tfsdk:"query_acceleration_max_scale_factor"`
+	WarehouseType                   types.String `tfsdk:"warehouse_type"`
+	Id                              types.String `tfsdk:"id"`
+}
+
+func warehouseSizesEqual(old, new string) bool {
+	oldSize, err := sdk.ToWarehouseSize(old)
+	if err != nil {
+		return false
+	}
+	newSize, err := sdk.ToWarehouseSize(new)
+	if err != nil {
+		return false
+	}
+	return oldSize == newSize
+}
+
+func warehouseSchema() schema.Schema {
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifiers.UseStateForUnknownUnlessChanged(path.Root("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Identifier for the virtual warehouse; must be unique for your account.",
+				Required:    true,
+				Sensitive:   isSensitive("snowflake_warehouse.*.name"),
+			},
+			"comment": schema.StringAttribute{
+				Optional:  true,
+				Computed:  true,
+				Default:   stringdefault.StaticString(""),
+				Sensitive: isSensitive("snowflake_warehouse.*.comment"),
+			},
+			"warehouse_size": schema.StringAttribute{
+				Description: "Specifies the size of the virtual warehouse. Larger warehouse sizes 5X-Large and 6X-Large are currently in preview and only available on Amazon Web Services (AWS).",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidators.WarehouseSize(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifiers.SuppressDiffIf(warehouseSizesEqual),
+				},
+			},
+			"max_cluster_count": schema.Int64Attribute{
+				Description: "Specifies the maximum number of server clusters for the warehouse.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_cluster_count": schema.Int64Attribute{
+				Description: "Specifies the minimum number of server clusters for the warehouse (only applies to multi-cluster warehouses).",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"scaling_policy": schema.StringAttribute{
+				Description: "Specifies the policy for automatically starting and shutting down clusters in a multi-cluster warehouse running in Auto-scale mode.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(string(sdk.ScalingPolicyStandard), string(sdk.ScalingPolicyEconomy)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifiers.SuppressDiffIf(strings.EqualFold),
+				},
+			},
+			"auto_suspend": schema.Int64Attribute{
+				Description: "Specifies the number of seconds of inactivity after which a warehouse is automatically suspended.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_resume": schema.BoolAttribute{
+				Description: "Specifies whether to automatically resume a warehouse when a SQL statement (e.g. query) is submitted to it.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initially_suspended": schema.BoolAttribute{
+				Description: "Specifies whether the warehouse is created initially in the ‘Suspended’ state.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_monitor": schema.StringAttribute{
+				Description: "Specifies the name of a resource monitor that is explicitly assigned to the warehouse.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_provisioning": schema.BoolAttribute{
+				Description:        "Specifies whether the warehouse, after being resized, waits for all the servers to provision before executing any queued or new queries.",
+				Optional:           true,
+				DeprecationMessage: "This field is deprecated and will be removed in the next major version of the provider. It doesn't do anything and should be removed from your configuration.",
+			},
+			"statement_timeout_in_seconds": schema.Int64Attribute{
+				Description: "Specifies the time, in seconds, after which a running SQL statement (query, DDL, DML, etc.) is canceled by the system",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(172800),
+			},
+			"statement_queued_timeout_in_seconds": schema.Int64Attribute{
+				Description: "Object parameter that specifies the time, in seconds, a SQL statement (query, DDL, DML, etc.) can be queued on a warehouse before it is canceled by the system.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"max_concurrency_level": schema.Int64Attribute{
+				Description: "Object parameter that specifies the concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(8),
+			},
+			"enable_query_acceleration": schema.BoolAttribute{
+				Description: "Specifies whether to enable the query acceleration service for queries that rely on this warehouse for compute resources.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"query_acceleration_max_scale_factor": schema.Int64Attribute{
+				Description: "Specifies the maximum scale factor for leasing compute resources for query acceleration. The scale factor is used as a multiplier based on warehouse size.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(8),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"warehouse_type": schema.StringAttribute{
+				Description: "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(sdk.WarehouseTypeStandard)),
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(string(sdk.WarehouseTypeStandard), string(sdk.WarehouseTypeSnowparkOptimized)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifiers.SuppressDiffIf(strings.EqualFold),
+				},
+			},
+		},
+	}
+}
+
+func (r *WarehouseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse"
+}
+
+func (r *WarehouseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = warehouseSchema()
+}
+
+func (r *WarehouseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData := getProviderData(req.ProviderData, &resp.Diagnostics)
+	if providerData == nil {
+		return
+	}
+	r.client = providerData.client
+	r.sqlPlan = providerData.sqlPlan
+}
+
+func (r *WarehouseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *warehouseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan != nil {
+		resp.Diagnostics.Append(validateWarehouseOnPlan(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the rest of the plan is not modified, just logging what it intends to do
+	resourceName := "snowflake_warehouse"
+	switch {
+	// DELETE
+	case plan == nil:
+		_, logs, _ := r.delete(ctx, state, true)
+		tflog.Debug(ctx, formatSQLPreview(DeleteOperation, resourceName, state.Id.ValueString(), logs))
+	// CREATE
+	case state == nil:
+		_, logs, _ := r.create(ctx, plan, true)
+		tflog.Debug(ctx, formatSQLPreview(CreateOperation, resourceName, "", logs))
+	// UPDATE
+	default:
+		_, logs, _ := r.update(ctx, plan, state, true)
+		if len(logs) > 0 {
+			tflog.Debug(ctx, formatSQLPreview(UpdateOperation, resourceName, state.Id.ValueString(), logs))
+		}
+	}
+}
+
+// validateWarehouseOnPlan runs the SDK validations of the warehouse creation against the plan when the cluster counts change,
+// like the CustomizeDiff of the SDKv2 resource.
+func validateWarehouseOnPlan(ctx context.Context, plan *warehouseModel, state *warehouseModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if !isKnownValue(plan.Name) {
+		return diags
+	}
+	changed := make([]string, 0)
+	if isKnownValue(plan.MinClusterCount) && (state == nil || !plan.MinClusterCount.Equal(state.MinClusterCount)) {
+		changed = append(changed, "min_cluster_count")
+	}
+	if isKnownValue(plan.MaxClusterCount) && (state == nil || !plan.MaxClusterCount.Equal(state.MaxClusterCount)) {
+		changed = append(changed, "max_cluster_count")
+	}
+	if len(changed) == 0 {
+		return diags
+	}
+	createOptions, err := warehouseCreateOptions(plan)
+	if err == nil {
+		err = sdk.NewDryRunClient().Warehouses.Create(ctx, sdk.NewAccountObjectIdentifier(plan.Name.ValueString()), createOptions)
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root(changed[0]), "Invalid Attribute Value", fmt.Sprintf("invalid value of %s: %s", strings.Join(changed, ", "), err))
+	}
+	return diags
+}
+
+// warehouseCreateOptions builds the options used to create the warehouse from the given plan.
+func warehouseCreateOptions(data *warehouseModel) (*sdk.CreateWarehouseOptions, error) {
+	whType := sdk.WarehouseType(data.WarehouseType.ValueString())
+	createOptions := &sdk.CreateWarehouseOptions{
+		Comment:                         sdk.String(data.Comment.ValueString()),
+		StatementTimeoutInSeconds:       sdk.Int(int(data.StatementTimeoutInSeconds.ValueInt64())),
+		StatementQueuedTimeoutInSeconds: sdk.Int(int(data.StatementQueuedTimeoutInSeconds.ValueInt64())),
+		MaxConcurrencyLevel:             sdk.Int(int(data.MaxConcurrencyLevel.ValueInt64())),
+		EnableQueryAcceleration:         sdk.Bool(data.EnableQueryAcceleration.ValueBool()),
+		WarehouseType:                   &whType,
+	}
+
+	if data.EnableQueryAcceleration.ValueBool() && data.QueryAccelerationMaxScaleFactor.ValueInt64() != 0 {
+		createOptions.QueryAccelerationMaxScaleFactor = sdk.Int(int(data.QueryAccelerationMaxScaleFactor.ValueInt64()))
+	}
+	if data.WarehouseSize.ValueString() != "" {
+		size, err := sdk.ToWarehouseSize(data.WarehouseSize.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		createOptions.WarehouseSize = &size
+	}
+	if data.MaxClusterCount.ValueInt64() != 0 {
+		createOptions.MaxClusterCount = sdk.Int(int(data.MaxClusterCount.ValueInt64()))
+	}
+	if data.MinClusterCount.ValueInt64() != 0 {
+		createOptions.MinClusterCount = sdk.Int(int(data.MinClusterCount.ValueInt64()))
+	}
+	if data.ScalingPolicy.ValueString() != "" {
+		scalingPolicy := sdk.ScalingPolicy(data.ScalingPolicy.ValueString())
+		createOptions.ScalingPolicy = &scalingPolicy
+	}
+	if data.AutoSuspend.ValueInt64() != 0 {
+		createOptions.AutoSuspend = sdk.Int(int(data.AutoSuspend.ValueInt64()))
+	}
+	if data.AutoResume.ValueBool() {
+		createOptions.AutoResume = sdk.Bool(true)
+	}
+	if data.InitiallySuspended.ValueBool() {
+		createOptions.InitiallySuspended = sdk.Bool(true)
+	}
+	if data.ResourceMonitor.ValueString() != "" {
+		createOptions.ResourceMonitor = sdk.String(data.ResourceMonitor.ValueString())
+	}
+
+	return createOptions, nil
+}
+
+func (r *WarehouseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *warehouseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.sqlPlan.enabled {
+		_, logs, diags := r.create(ctx, data, true)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.sqlPlan.render(CreateOperation, "snowflake_warehouse", data.Name.ValueString(), logs)...)
+		return
+	}
+	defer r.client.SuspendShowCache()()
+
+	data, _, diags := r.create(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *WarehouseResource) create(ctx context.Context, data *warehouseModel, dryRun bool) (*warehouseModel, []string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	client := r.client
+	if dryRun {
+		client = newDryRunClient(r.client)
+	}
+
+	id := sdk.NewAccountObjectIdentifier(data.Name.ValueString())
+	createOptions, err := warehouseCreateOptions(data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create warehouse %v, got error: %s", id.Name(), err))
+		return data, nil, diags
+	}
+
+	err = client.Warehouses.Create(ctx, id, createOptions)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create warehouse %v, got error: %s", id.Name(), err))
+		return data, nil, diags
+	}
+	if dryRun {
+		return data, client.TraceLogs(), diags
+	}
+
+	data.Id = types.StringValue(helpers.EncodeSnowflakeID(id))
+	data, readDiags := r.read(ctx, data)
+	diags.Append(readDiags...)
+	if data == nil && !readDiags.HasError() {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read warehouse %v after creation", id.Name()))
+	}
+	return data, nil, diags
+}
+
+func (r *WarehouseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *warehouseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, diags := r.read(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data == nil {
+		tflog.Debug(ctx, "warehouse not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// read returns nil data when the warehouse does not exist anymore, and the error diagnostics when it cannot be read.
+func (r *WarehouseResource) read(ctx context.Context, data *warehouseModel) (*warehouseModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	id := helpers.DecodeSnowflakeID(data.Id.ValueString()).(sdk.AccountObjectIdentifier)
+	w, err := r.client.Warehouses.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			tflog.Debug(ctx, fmt.Sprintf("warehouse %v not found: %s", id.Name(), err))
+			return nil, diags
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read warehouse %v, got error: %s", id.Name(), err))
+		return nil, diags
+	}
+
+	data.Name = types.StringValue(w.Name)
+	data.Comment = types.StringValue(w.Comment)
+	// the values equal to the configured ones (up to the case or the size aliases) are kept, like the SDKv2 resource suppresses their diffs
+	if !strings.EqualFold(data.WarehouseType.ValueString(), string(w.Type)) {
+		data.WarehouseType = types.StringValue(string(w.Type))
+	}
+	if !warehouseSizesEqual(data.WarehouseSize.ValueString(), string(w.Size)) {
+		data.WarehouseSize = types.StringValue(string(w.Size))
+	}
+	data.MaxClusterCount = types.Int64Value(int64(w.MaxClusterCount))
+	data.MinClusterCount = types.Int64Value(int64(w.MinClusterCount))
+	if !strings.EqualFold(data.ScalingPolicy.ValueString(), string(w.ScalingPolicy)) {
+		data.ScalingPolicy = types.StringValue(string(w.ScalingPolicy))
+	}
+	data.AutoSuspend = types.Int64Value(int64(w.AutoSuspend))
+	data.AutoResume = types.BoolValue(w.AutoResume)
+	data.ResourceMonitor = types.StringValue(w.ResourceMonitor)
+	data.EnableQueryAcceleration = types.BoolValue(w.EnableQueryAcceleration)
+	if w.EnableQueryAcceleration {
+		data.QueryAccelerationMaxScaleFactor = types.Int64Value(int64(w.QueryAccelerationMaxScaleFactor))
+	}
+	// on import, the parameters not read from Snowflake get their default values, like in the SDKv2 resource
+	if data.StatementTimeoutInSeconds.IsNull() {
+		data.StatementTimeoutInSeconds = types.Int64Value(172800)
+	}
+	if data.StatementQueuedTimeoutInSeconds.IsNull() {
+		data.StatementQueuedTimeoutInSeconds = types.Int64Value(0)
+	}
+	if data.MaxConcurrencyLevel.IsNull() {
+		data.MaxConcurrencyLevel = types.Int64Value(8)
+	}
+	if data.QueryAccelerationMaxScaleFactor.IsNull() {
+		data.QueryAccelerationMaxScaleFactor = types.Int64Value(8)
+	}
+	data.Id = types.StringValue(helpers.EncodeSnowflakeID(id))
+	return data, diags
+}
+
+func (r *WarehouseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *warehouseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.sqlPlan.enabled {
+		_, logs, diags := r.update(ctx, plan, state, true)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.sqlPlan.render(UpdateOperation, "snowflake_warehouse", state.Id.ValueString(), logs)...)
+		return
+	}
+	defer r.client.SuspendShowCache()()
+
+	data, _, diags := r.update(ctx, plan, state, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *WarehouseResource) update(ctx context.Context, plan *warehouseModel, state *warehouseModel, dryRun bool) (*warehouseModel, []string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	client := r.client
+	if dryRun {
+		client = newDryRunClient(r.client)
+	}
+
+	id := helpers.DecodeSnowflakeID(state.Id.ValueString()).(sdk.AccountObjectIdentifier)
+
+	// change name separately
+	if !plan.Name.Equal(state.Name) {
+		newId := sdk.NewAccountObjectIdentifier(plan.Name.ValueString())
+		err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{
+			NewName: &newId,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to rename warehouse %v, got error: %s", id.Name(), err))
+			return plan, nil, diags
+		}
+		id = newId
+	}
+
+	// batch SET operations
+	runSet := false
+	set := sdk.WarehouseSet{}
+	if !plan.Comment.Equal(state.Comment) {
+		runSet = true
+		set.Comment = sdk.String(plan.Comment.ValueString())
+	}
+	if isKnownValue(plan.WarehouseSize) && !plan.WarehouseSize.Equal(state.WarehouseSize) {
+		size, err := sdk.ToWarehouseSize(plan.WarehouseSize.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update warehouse %v, got error: %s", id.Name(), err))
+			return plan, nil, diags
+		}
+		runSet = true
+		set.WarehouseSize = &size
+	}
+	if isKnownValue(plan.MaxClusterCount) && !plan.MaxClusterCount.Equal(state.MaxClusterCount) {
+		runSet = true
+		set.MaxClusterCount = sdk.Int(int(plan.MaxClusterCount.ValueInt64()))
+	}
+	if isKnownValue(plan.MinClusterCount) && !plan.MinClusterCount.Equal(state.MinClusterCount) {
+		runSet = true
+		set.MinClusterCount = sdk.Int(int(plan.MinClusterCount.ValueInt64()))
+	}
+	if isKnownValue(plan.ScalingPolicy) && !plan.ScalingPolicy.Equal(state.ScalingPolicy) {
+		runSet = true
+		scalingPolicy := sdk.ScalingPolicy(plan.ScalingPolicy.ValueString())
+		set.ScalingPolicy = &scalingPolicy
+	}
+	if isKnownValue(plan.AutoSuspend) && !plan.AutoSuspend.Equal(state.AutoSuspend) {
+		runSet = true
+		set.AutoSuspend = sdk.Int(int(plan.AutoSuspend.ValueInt64()))
+	}
+	if isKnownValue(plan.AutoResume) && !plan.AutoResume.Equal(state.AutoResume) {
+		runSet = true
+		set.AutoResume = sdk.Bool(plan.AutoResume.ValueBool())
+	}
+	runUnset := false
+	unset := sdk.WarehouseUnset{}
+	if isKnownValue(plan.ResourceMonitor) && !plan.ResourceMonitor.Equal(state.ResourceMonitor) {
+		if plan.ResourceMonitor.ValueString() != "" {
+			runSet = true
+			set.ResourceMonitor = sdk.NewAccountObjectIdentifier(plan.ResourceMonitor.ValueString())
+		} else {
+			runUnset = true
+			unset.ResourceMonitor = sdk.Bool(true)
+		}
+	}
+	if !plan.StatementTimeoutInSeconds.Equal(state.StatementTimeoutInSeconds) {
+		runSet = true
+		set.StatementTimeoutInSeconds = sdk.Int(int(plan.StatementTimeoutInSeconds.ValueInt64()))
+	}
+	if !plan.StatementQueuedTimeoutInSeconds.Equal(state.StatementQueuedTimeoutInSeconds) {
+		runSet = true
+		set.StatementQueuedTimeoutInSeconds = sdk.Int(int(plan.StatementQueuedTimeoutInSeconds.ValueInt64()))
+	}
+	if !plan.MaxConcurrencyLevel.Equal(state.MaxConcurrencyLevel) {
+		runSet = true
+		set.MaxConcurrencyLevel = sdk.Int(int(plan.MaxConcurrencyLevel.ValueInt64()))
+	}
+	if !plan.EnableQueryAcceleration.Equal(state.EnableQueryAcceleration) {
+		runSet = true
+		set.EnableQueryAcceleration = sdk.Bool(plan.EnableQueryAcceleration.ValueBool())
+	}
+	if !plan.QueryAccelerationMaxScaleFactor.Equal(state.QueryAccelerationMaxScaleFactor) {
+		runSet = true
+		set.QueryAccelerationMaxScaleFactor = sdk.Int(int(plan.QueryAccelerationMaxScaleFactor.ValueInt64()))
+	}
+	if !plan.WarehouseType.Equal(state.WarehouseType) {
+		runSet = true
+		whType := sdk.WarehouseType(plan.WarehouseType.ValueString())
+		set.WarehouseType = &whType
+	}
+
+	// apply SET and UNSET changes
+	if runSet {
+		if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Set: &set}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update warehouse %v, got error: %s", id.Name(), err))
+			return plan, nil, diags
+		}
+	}
+	if runUnset {
+		if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Unset: &unset}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update warehouse %v, got error: %s", id.Name(), err))
+			return plan, nil, diags
+		}
+	}
+	if dryRun {
+		return plan, client.TraceLogs(), diags
+	}
+
+	plan.Id = types.StringValue(helpers.EncodeSnowflakeID(id))
+	data, readDiags := r.read(ctx, plan)
+	diags.Append(readDiags...)
+	if data == nil {
+		if !readDiags.HasError() {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read warehouse %v after update", id.Name()))
+		}
+		return plan, nil, diags
+	}
+	return data, nil, diags
+}
+
+func (r *WarehouseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *warehouseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.sqlPlan.enabled {
+		_, logs, diags := r.delete(ctx, data, true)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.sqlPlan.render(DeleteOperation, "snowflake_warehouse", data.Id.ValueString(), logs)...)
+		return
+	}
+	defer r.client.SuspendShowCache()()
+
+	_, _, diags := r.delete(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *WarehouseResource) delete(ctx context.Context, data *warehouseModel, dryRun bool) (*warehouseModel, []string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	client := r.client
+	if dryRun {
+		client = newDryRunClient(r.client)
+	}
+
+	id := helpers.DecodeSnowflakeID(data.Id.ValueString()).(sdk.AccountObjectIdentifier)
+	err := client.Warehouses.Drop(ctx, id, nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete warehouse %v, got error: %s", id.Name(), err))
+	}
+	if dryRun {
+		return data, client.TraceLogs(), diags
+	}
+	return data, nil, diags
+}
+
+func (r *WarehouseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
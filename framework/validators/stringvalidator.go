@@ -0,0 +1,74 @@
+package stringvalidators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// identifierValidator implements the validator.
+type identifierValidator struct {
+	exclusions []string
+}
+
+// Description returns a human-readable description of the validator.
+func (v identifierValidator) Description(_ context.Context) string {
+	return "value must be a valid Snowflake identifier"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v identifierValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v identifierValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	warns, errs := sdk.ValidateIdentifier(req.ConfigValue.ValueString(), v.exclusions)
+	for _, warn := range warns {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Identifier Warning", warn)
+	}
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Identifier", err.Error())
+	}
+}
+
+// Identifier checks that the value is a valid Snowflake identifier, allowing the characters from exclusions
+// in addition to the default ones (see sdk.ValidateIdentifier).
+func Identifier(exclusions ...string) validator.String {
+	return identifierValidator{
+		exclusions: exclusions,
+	}
+}
+
+// warehouseSizeValidator implements the validator.
+type warehouseSizeValidator struct{}
+
+// Description returns a human-readable description of the validator.
+func (v warehouseSizeValidator) Description(_ context.Context) string {
+	return "value must be a valid warehouse size"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v warehouseSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v warehouseSizeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	if !sdk.IsValidWarehouseSize(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Warehouse Size", fmt.Sprintf("not a valid warehouse size: %s", req.ConfigValue.ValueString()))
+	}
+}
+
+// WarehouseSize checks that the value is one of the warehouse sizes accepted by sdk.ToWarehouseSize.
+func WarehouseSize() validator.String {
+	return warehouseSizeValidator{}
+}
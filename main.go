@@ -4,13 +4,13 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
 	oldprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowgen"
 
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
 var version string = "dev" // goreleaser can pass other information to the main package, such as the specific commit
@@ -19,28 +19,20 @@ var version string = "dev" // goreleaser can pass other information to the main
 func main() {
 	ctx := context.Background()
 
+	// snowgen is a hidden subcommand generating configuration and import blocks for the objects of an existing account.
+	if len(os.Args) > 1 && os.Args[1] == "snowgen" {
+		if err := snowgen.Run(ctx, os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	upgradedSdkServer, err := tf5to6server.UpgradeServer(
-		ctx,
-		oldprovider.Provider().GRPCProvider,
-	)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	providers := []func() tfprotov6.ProviderServer{
-		// disabled until ready to start using
-		//	providerserver.NewProtocol6(provider.New(version)()),
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkServer
-		},
-	}
-
-	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	providerServer, err := provider.NewMuxServer(ctx, version, oldprovider.Provider())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -53,7 +45,7 @@ func main() {
 
 	err = tf6server.Serve(
 		"registry.terraform.io/Snowflake-Labs/snowflake",
-		muxServer.ProviderServer,
+		providerServer,
 		serveOpts...,
 	)
 
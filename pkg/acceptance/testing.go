@@ -7,7 +7,9 @@ import (
 	"sync"
 	"testing"
 
+	frameworkprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
@@ -23,11 +25,28 @@ const (
 
 var TestAccProvider *schema.Provider
 
+// TestAccProtoV6ProviderFactories serve the provider as it is released: the SDKv2 provider muxed with the plugin framework provider.
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"snowflake": func() (tfprotov6.ProviderServer, error) {
+		providerServer, err := frameworkprovider.NewMuxServer(context.Background(), "test", TestAccProvider)
+		if err != nil {
+			return nil, err
+		}
+		return providerServer(), nil
+	},
+}
+
+// TestAccSDKv2ProtoV6ProviderFactories serve the SDKv2 provider alone, with the SDKv2 implementations of the resources
+// that moved to the plugin framework provider. They are used to check that the moved resources keep the same state.
+var TestAccSDKv2ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"snowflake": func() (tfprotov6.ProviderServer, error) {
+		sdkProvider := provider.Provider()
+		sdkProvider.ResourcesMap["snowflake_resource_monitor"] = resources.ResourceMonitor()
+		sdkProvider.ResourcesMap["snowflake_role"] = resources.Role()
+		sdkProvider.ResourcesMap["snowflake_warehouse"] = resources.Warehouse()
 		return tf5to6server.UpgradeServer(
 			context.Background(),
-			TestAccProvider.GRPCProvider,
+			sdkProvider.GRPCProvider,
 		)
 	},
 }
@@ -36,12 +55,6 @@ func init() {
 	TestAccProvider = provider.Provider()
 }
 
-func TestAccProviders() map[string]*schema.Provider {
-	return map[string]*schema.Provider{
-		"snowflake": provider.Provider(),
-	}
-}
-
 var once sync.Once
 
 func TestAccPreCheck(t *testing.T) {
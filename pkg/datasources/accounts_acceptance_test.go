@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -12,8 +13,8 @@ func TestAcc_Accounts(t *testing.T) {
 		t.Skip("Skipping TestInt_Accounts")
 	}
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    providers(),
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: accountsConfig(),
@@ -0,0 +1,84 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationRolesSchema = map[string]*schema.Schema{
+	"application": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The installed application from which to return the application roles from.",
+	},
+	"application_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The application roles defined by the application",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"qualified_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner_role_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ApplicationRoles() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadApplicationRoles,
+		Schema: applicationRolesSchema,
+	}
+}
+
+func ReadApplicationRoles(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	ctx := context.Background()
+	client := sdk.NewClientFromDB(db)
+	applicationId := sdk.NewAccountObjectIdentifier(d.Get("application").(string))
+
+	applicationRoles, err := client.ApplicationRoles.Show(ctx, sdk.NewShowApplicationRoleRequest().WithApplicationName(applicationId))
+	if err != nil {
+		log.Printf("[DEBUG] failed when searching application roles in application (%s), err = %s", applicationId.FullyQualifiedName(), err.Error())
+		d.SetId("")
+		return nil
+	}
+
+	applicationRolesObjects := make([]map[string]any, len(applicationRoles))
+	for i, applicationRole := range applicationRoles {
+		applicationRolesObjects[i] = map[string]any{
+			"name":            applicationRole.Name,
+			"qualified_name":  sdk.NewDatabaseObjectIdentifier(applicationId.Name(), applicationRole.Name).FullyQualifiedName(),
+			"owner":           applicationRole.Owner,
+			"owner_role_type": applicationRole.OwnerRoleType,
+			"comment":         applicationRole.Comment,
+		}
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(applicationId))
+
+	return d.Set("application_roles", applicationRolesObjects)
+}
@@ -0,0 +1,42 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAcc_ApplicationRoles needs an installed application defining application roles in its setup script.
+// Set TEST_SF_TF_APPLICATION_NAME to run it.
+func TestAcc_ApplicationRoles(t *testing.T) {
+	applicationName := os.Getenv("TEST_SF_TF_APPLICATION_NAME")
+	if applicationName == "" {
+		t.Skip("Skipping TestAcc_ApplicationRoles")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationRolesConfig(applicationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_application_roles.ar", "application", applicationName),
+					resource.TestCheckResourceAttrSet("data.snowflake_application_roles.ar", "application_roles.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_application_roles.ar", "application_roles.0.name"),
+					resource.TestCheckResourceAttr("data.snowflake_application_roles.ar", "application_roles.0.owner_role_type", "APPLICATION"),
+				),
+			},
+		},
+	})
+}
+
+func applicationRolesConfig(applicationName string) string {
+	return fmt.Sprintf(`
+	data "snowflake_application_roles" "ar" {
+		application = "%s"
+	}
+	`, applicationName)
+}
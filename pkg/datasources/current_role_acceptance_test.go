@@ -3,12 +3,13 @@ package datasources_test
 import (
 	"testing"
 
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAcc_CurrentRole(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		Providers: providers(),
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: currentRole(),
@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the event tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the event tables from.",
+	},
+	"event_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The event tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func EventTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadEventTables,
+		Schema: eventTablesSchema,
+	}
+}
+
+func ReadEventTables(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	ctx := context.Background()
+	client := sdk.NewClientFromDB(db)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+
+	schemaId := sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)
+	eventTables, err := client.EventTables.Show(ctx, sdk.NewShowEventTableRequest().WithIn(&sdk.In{Schema: schemaId}))
+	if err != nil {
+		log.Printf("[DEBUG] failed when searching event tables in schema (%s), err = %s", schemaId.FullyQualifiedName(), err.Error())
+		d.SetId("")
+		return nil
+	}
+
+	eventTablesObjects := make([]map[string]any, len(eventTables))
+	for i, eventTable := range eventTables {
+		eventTablesObjects[i] = map[string]any{
+			"name":     eventTable.Name,
+			"database": eventTable.DatabaseName,
+			"schema":   eventTable.SchemaName,
+			"owner":    eventTable.Owner,
+			"comment":  eventTable.Comment,
+		}
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(schemaId))
+
+	return d.Set("event_tables", eventTablesObjects)
+}
@@ -0,0 +1,48 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_EventTables(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTablesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.et", "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.et", "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.et", "event_tables.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.et", "event_tables.0.name", name),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.et", "event_tables.0.comment", "Terraform acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func eventTablesConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_event_table" "et" {
+		database = "%[1]s"
+		schema   = "%[2]s"
+		name     = "%[3]s"
+		comment  = "Terraform acceptance test"
+	}
+
+	data "snowflake_event_tables" "et" {
+		database   = "%[1]s"
+		schema     = "%[2]s"
+		depends_on = [snowflake_event_table.et]
+	}
+	`, acc.TestDatabaseName, acc.TestSchemaName, name)
+}
@@ -1,13 +0,0 @@
-package datasources_test
-
-import (
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-)
-
-func providers() map[string]*schema.Provider {
-	p := provider.Provider()
-	return map[string]*schema.Provider{
-		"snowflake": p,
-	}
-}
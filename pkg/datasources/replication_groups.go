@@ -0,0 +1,184 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var replicationGroupsSchema = map[string]*schema.Schema{
+	"in_account": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the identifier for the account",
+	},
+	"replication_groups": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "List of all the replication groups available in the system.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the replication group.",
+				},
+				"region_group": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Region group where the account is located. Note: this column is only visible to organizations that span multiple Region Groups.",
+				},
+				"snowflake_region": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Snowflake Region where the account is located. A Snowflake Region is a distinct location within a cloud platform region that is isolated from other Snowflake Regions. A Snowflake Region can be either multi-tenant or single-tenant (for a Virtual Private Snowflake account).",
+				},
+				"created_on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Date and time replication group was created.",
+				},
+				"account_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the account.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of group. Valid value is REPLICATION.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment string.",
+				},
+				"is_primary": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the replication group is the primary group.",
+				},
+				"primary": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the primary group.",
+				},
+				"object_types": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "List of specified object types enabled for replication.",
+					Elem:        schema.TypeString,
+				},
+				"allowed_integration_types": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "A list of integration types that are enabled for replication.",
+					Elem:        schema.TypeString,
+				},
+				"allowed_accounts": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "List of accounts enabled for replication.",
+					Elem:        schema.TypeString,
+				},
+				"organization_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of your Snowflake organization.",
+				},
+				"account_locator": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Account locator in a region.",
+				},
+				"replication_schedule": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Scheduled interval for refresh; NULL if no replication schedule is set.",
+				},
+				"secondary_state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Current state of scheduled refresh. Valid values are started or suspended. NULL if no replication schedule is set.",
+				},
+				"next_scheduled_refresh": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Date and time of the next scheduled refresh.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the role with the OWNERSHIP privilege on the replication group. NULL if the replication group is in a different region.",
+				},
+			},
+		},
+	},
+}
+
+// ReplicationGroups Snowflake ReplicationGroups resource.
+func ReplicationGroups() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadReplicationGroups,
+		Schema: replicationGroupsSchema,
+	}
+}
+
+// ReadReplicationGroups lists replication groups.
+func ReadReplicationGroups(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	request := sdk.NewShowReplicationGroupRequest()
+	if inAccount := d.Get("in_account").(string); inAccount != "" {
+		request.WithInAccount(sdk.Pointer(sdk.NewAccountIdentifierFromAccountLocator(inAccount)))
+	}
+	replicationGroups, err := client.ReplicationGroups.Show(ctx, request)
+	if err != nil {
+		return err
+	}
+	d.SetId("replication_groups")
+	replicationGroupsFlatten := []map[string]interface{}{}
+	for _, replicationGroup := range replicationGroups {
+		m := map[string]interface{}{}
+		m["name"] = replicationGroup.Name
+		m["region_group"] = replicationGroup.RegionGroup
+		m["snowflake_region"] = replicationGroup.SnowflakeRegion
+		m["created_on"] = replicationGroup.CreatedOn.String()
+		m["account_name"] = replicationGroup.AccountName
+		m["type"] = replicationGroup.Type
+		m["comment"] = replicationGroup.Comment
+		m["is_primary"] = replicationGroup.IsPrimary
+		m["primary"] = replicationGroup.Primary.FullyQualifiedName()
+
+		ot := make([]string, len(replicationGroup.ObjectTypes))
+		for i, o := range replicationGroup.ObjectTypes {
+			ot[i] = string(o)
+		}
+		m["object_types"] = ot
+		ait := make([]string, len(replicationGroup.AllowedIntegrationTypes))
+		for i, a := range replicationGroup.AllowedIntegrationTypes {
+			ait[i] = string(a)
+		}
+		m["allowed_integration_types"] = ait
+		aa := make([]string, len(replicationGroup.AllowedAccounts))
+		for i, a := range replicationGroup.AllowedAccounts {
+			aa[i] = a.Name()
+		}
+		m["allowed_accounts"] = aa
+		m["organization_name"] = replicationGroup.OrganizationName
+		m["account_locator"] = replicationGroup.AccountLocator
+		m["replication_schedule"] = replicationGroup.ReplicationSchedule
+		m["secondary_state"] = string(replicationGroup.SecondaryState)
+		m["next_scheduled_refresh"] = replicationGroup.NextScheduledRefresh
+		m["owner"] = replicationGroup.Owner
+		replicationGroupsFlatten = append(replicationGroupsFlatten, m)
+	}
+	if err := d.Set("replication_groups", replicationGroupsFlatten); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ReplicationGroups(t *testing.T) {
+	if _, ok := os.LookupEnv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT"); !ok {
+		t.Skip("Skipping TestAcc_ReplicationGroups since there is no target account")
+	}
+	accountName := os.Getenv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT")
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: replicationGroupsConfig(name, accountName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_groups.d", "replication_groups.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_groups.d", "replication_groups.0.name", name),
+					resource.TestCheckResourceAttr("snowflake_replication_groups.d", "replication_groups.0.object_types.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_groups.d", "replication_groups.0.object_types.0", "ROLES"),
+					resource.TestCheckResourceAttr("snowflake_replication_groups.d", "replication_groups.0.allowed_accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_groups.d", "replication_groups.0.allowed_accounts.0", accountName),
+				),
+			},
+		},
+	})
+}
+
+func replicationGroupsConfig(replicationGroupName string, allowedAccount string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_replication_group" "source_replication_group" {
+		name                      = "%s"
+		object_types              = ["ROLES"]
+		allowed_accounts          = ["%s"]
+	}
+
+	data "snowflake_replication_groups" "d" {
+		depends_on = [snowflake_replication_group.source_replication_group]
+	}
+	`, replicationGroupName, allowedAccount)
+}
@@ -24,8 +24,9 @@ var systemGenerateSCIMAccesstokenSchema = map[string]*schema.Schema{
 
 func SystemGenerateSCIMAccessToken() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGenerateSCIMAccessToken,
-		Schema: systemGenerateSCIMAccesstokenSchema,
+		Read:               ReadSystemGenerateSCIMAccessToken,
+		Schema:             systemGenerateSCIMAccesstokenSchema,
+		DeprecationMessage: "This data source is deprecated and will be removed in a future major version release, as it persists the access token in the state. Please use the snowflake_scim_access_token ephemeral resource instead.",
 	}
 }
 
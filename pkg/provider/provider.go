@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/snowflakedb/gosnowflake"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
@@ -17,6 +19,7 @@ import (
 
 // Provider returns a Terraform Provider using configuration from https://pkg.go.dev/github.com/snowflakedb/gosnowflake#Config
 func Provider() *schema.Provider {
+	plan := &sqlPlan{}
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"account": {
@@ -283,6 +286,78 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DISABLE_QUERY_CONTEXT_CACHE", nil),
 			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Description: "If true, the Create, Update and Delete operations of resources render the SQL statements changing objects they would run instead of executing them. Affected resources fail with an error, so nothing is changed in Snowflake nor in the state. The queries reading objects (e.g. SHOW and DESCRIBE) are still executed against Snowflake and are not rendered. Can also be sourced from the `SNOWFLAKE_DRY_RUN` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DRY_RUN", false),
+			},
+			"sql_plan_output": {
+				Type:        schema.TypeString,
+				Description: "Path to a file the statements rendered with `dry_run` are appended to. If not set, the statements are reported as warnings. Can also be sourced from the `SNOWFLAKE_SQL_PLAN_OUTPUT` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_SQL_PLAN_OUTPUT", nil),
+			},
+			"max_retry_attempts": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of attempts of a statement failing with a transient error (see `retryable_errors`). Set to 1 to disable retries. Default is 3. Can also be sourced from the `SNOWFLAKE_MAX_RETRY_ATTEMPTS` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_RETRY_ATTEMPTS", nil),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_initial_backoff": {
+				Type:         schema.TypeInt,
+				Description:  "Time in seconds to wait before the first retry of a statement; it is doubled after every attempt up to `retry_max_backoff`. Default is 1 second. Can also be sourced from the `SNOWFLAKE_RETRY_INITIAL_BACKOFF` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_RETRY_INITIAL_BACKOFF", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum time in seconds to wait between the attempts of a statement. Default is 30 seconds. Can also be sourced from the `SNOWFLAKE_RETRY_MAX_BACKOFF` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_RETRY_MAX_BACKOFF", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retryable_errors": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(retryableErrorNames(), false)},
+				Description: "Classes of transient errors for which statements are retried. Valid values include: concurrency_conflict, internal_error, service_unavailable, session_expired, statement_timeout, warehouse_suspended. Default is internal_error, service_unavailable and concurrency_conflict.",
+				Optional:    true,
+			},
+			"retry_non_idempotent_statements": {
+				Type:        schema.TypeBool,
+				Description: "If true, statements that may have been applied before failing (e.g. `CREATE` without `IF NOT EXISTS`) are retried as well. By default, only statements that can safely run twice are retried. Can also be sourced from the `SNOWFLAKE_RETRY_NON_IDEMPOTENT_STATEMENTS` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_RETRY_NON_IDEMPOTENT_STATEMENTS", false),
+			},
+			"grant_batch_mode": {
+				Type:         schema.TypeString,
+				Description:  "If set, the grant statements of resources applied concurrently are sent to Snowflake in batches instead of one by one. Valid values include: MULTI_STATEMENT (one multi-statement request), EXECUTE_IMMEDIATE (one anonymous block running every statement in its own exception handler). Errors are reported by the resource that issued the failing statement. Can also be sourced from the `SNOWFLAKE_GRANT_BATCH_MODE` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_GRANT_BATCH_MODE", nil),
+				ValidateFunc: validation.StringInSlice([]string{string(sdk.StatementBatchModeMultiStatement), string(sdk.StatementBatchModeExecuteImmediate)}, false),
+			},
+			"grant_batch_size": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of statements in a batch when `grant_batch_mode` is set. Default is 100. Can also be sourced from the `SNOWFLAKE_GRANT_BATCH_SIZE` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_GRANT_BATCH_SIZE", nil),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"grant_batch_window": {
+				Type:         schema.TypeInt,
+				Description:  "Time in milliseconds a grant statement waits for other statements to join its batch when `grant_batch_mode` is set. Default is 200 milliseconds. Can also be sourced from the `SNOWFLAKE_GRANT_BATCH_WINDOW` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_GRANT_BATCH_WINDOW", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cache_show_results": {
+				Type:        schema.TypeBool,
+				Description: "If true, the results of SHOW statements are cached for the duration of a Terraform command, so that resources looking up objects of the same container (e.g. tables of a schema or future grants in it) query Snowflake once. The cache is invalidated after every write. Can also be sourced from the `SNOWFLAKE_CACHE_SHOW_RESULTS` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CACHE_SHOW_RESULTS", false),
+			},
 			/*
 				Feature not yet released as of latest gosnowflake release
 				https://github.com/snowflakedb/gosnowflake/blob/master/dsn.go#L103
@@ -389,9 +464,12 @@ func Provider() *schema.Provider {
 				Deprecated:    "use the [file Function](https://developer.hashicorp.com/terraform/language/functions/file) instead",
 			},
 		},
-		ResourcesMap:       getResources(),
-		DataSourcesMap:     getDataSources(),
-		ConfigureFunc:      ConfigureProvider,
+		ResourcesMap:   suspendShowCacheOnWrites(plan.wrapResources(getResources())),
+		DataSourcesMap: getDataSources(),
+		ConfigureFunc: func(s *schema.ResourceData) (interface{}, error) {
+			plan.configure(s)
+			return ConfigureProvider(s)
+		},
 		ProviderMetaSchema: map[string]*schema.Schema{},
 	}
 }
@@ -430,43 +508,54 @@ func getResources() map[string]*schema.Resource {
 	others := map[string]*schema.Resource{
 		"snowflake_account":                                 resources.Account(),
 		"snowflake_account_password_policy_attachment":      resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_session_policy_attachment":       resources.AccountSessionPolicyAttachment(),
 		"snowflake_account_parameter":                       resources.AccountParameter(),
 		"snowflake_alert":                                   resources.Alert(),
 		"snowflake_api_integration":                         resources.APIIntegration(),
+		"snowflake_application":                             resources.Application(),
+		"snowflake_application_package":                     resources.ApplicationPackage(),
+		"snowflake_application_package_version":             resources.ApplicationPackageVersion(),
 		"snowflake_compute_pool":                            resources.ComputePool(),
 		"snowflake_database":                                resources.Database(),
 		"snowflake_database_role":                           resources.DatabaseRole(),
 		"snowflake_dynamic_table":                           resources.DynamicTable(),
 		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                             resources.EventTable(),
+		"snowflake_external_access_integration":             resources.ExternalAccessIntegration(),
 		"snowflake_external_function":                       resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                          resources.ExternalTable(),
+		"snowflake_external_volume":                         resources.ExternalVolume(),
 		"snowflake_failover_group":                          resources.FailoverGroup(),
 		"snowflake_file_format":                             resources.FileFormat(),
 		"snowflake_function":                                resources.Function(),
+		"snowflake_grant_application_role":                  resources.GrantApplicationRole(),
 		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
+		"snowflake_iceberg_table":                           resources.IcebergTable(),
 		"snowflake_image_repository":                        resources.ImageRepository(),
 		"snowflake_managed_account":                         resources.ManagedAccount(),
 		"snowflake_masking_policy":                          resources.MaskingPolicy(),
 		"snowflake_materialized_view":                       resources.MaterializedView(),
 		"snowflake_network_policy":                          resources.NetworkPolicy(),
 		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                            resources.NetworkRule(),
 		"snowflake_notification_integration":                resources.NotificationIntegration(),
 		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
 		"snowflake_object_parameter":                        resources.ObjectParameter(),
 		"snowflake_password_policy":                         resources.PasswordPolicy(),
 		"snowflake_pipe":                                    resources.Pipe(),
 		"snowflake_procedure":                               resources.Procedure(),
-		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
-		"snowflake_role":                                    resources.Role(),
+		"snowflake_replication_group":                       resources.ReplicationGroup(),
 		"snowflake_role_grants":                             resources.RoleGrants(),
 		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
 		"snowflake_row_access_policy":                       resources.RowAccessPolicy(),
 		"snowflake_saml_integration":                        resources.SAMLIntegration(),
 		"snowflake_schema":                                  resources.Schema(),
 		"snowflake_scim_integration":                        resources.SCIMIntegration(),
+		"snowflake_secret":                                  resources.Secret(),
 		"snowflake_sequence":                                resources.Sequence(),
 		"snowflake_session_parameter":                       resources.SessionParameter(),
+		"snowflake_session_policy":                          resources.SessionPolicy(),
 		"snowflake_share":                                   resources.Share(),
 		"snowflake_stage":                                   resources.Stage(),
 		"snowflake_storage_integration":                     resources.StorageIntegration(),
@@ -478,12 +567,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_tag_association":                         resources.TagAssociation(),
 		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
 		"snowflake_task":                                    resources.Task(),
+		"snowflake_task_graph":                              resources.TaskGraph(),
 		"snowflake_unsafe_execute":                          resources.UnsafeExecute(),
 		"snowflake_user":                                    resources.User(),
 		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
 		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
+		"snowflake_user_session_policy_attachment":          resources.UserSessionPolicyAttachment(),
 		"snowflake_view":                                    resources.View(),
-		"snowflake_warehouse":                               resources.Warehouse(),
 	}
 
 	return mergeSchemas(
@@ -496,6 +586,7 @@ func getDataSources() map[string]*schema.Resource {
 	dataSources := map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_application_roles":                  datasources.ApplicationRoles(),
 		"snowflake_compute_pools":                      datasources.ComputePools(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
@@ -503,6 +594,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_event_tables":                       datasources.EventTables(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
@@ -515,6 +607,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_parameters":                         datasources.Parameters(),
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
+		"snowflake_replication_groups":                 datasources.ReplicationGroups(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
 		"snowflake_role":                               datasources.Role(),
 		"snowflake_roles":                              datasources.Roles(),
@@ -745,5 +838,63 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	client.SetRetryPolicy(retryPolicy(s))
+	if s.Get("cache_show_results").(bool) {
+		client.EnableShowCache()
+	}
+	if v, ok := s.GetOk("grant_batch_mode"); ok && v.(string) != "" {
+		client.SetStatementBatching(statementBatchOptions(s, sdk.StatementBatchMode(v.(string))))
+	}
 	return client.GetConn().DB, nil
 }
+
+var retryableErrors = map[string]error{
+	"internal_error":       sdk.ErrInternalError,
+	"service_unavailable":  sdk.ErrServiceUnavailable,
+	"concurrency_conflict": sdk.ErrConcurrencyConflict,
+	"statement_timeout":    sdk.ErrStatementTimeout,
+	"warehouse_suspended":  sdk.ErrWarehouseSuspended,
+	"session_expired":      sdk.ErrSessionExpired,
+}
+
+func retryableErrorNames() []string {
+	names := make([]string, 0, len(retryableErrors))
+	for name := range retryableErrors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func retryPolicy(s *schema.ResourceData) *sdk.RetryPolicy {
+	policy := sdk.DefaultRetryPolicy()
+	if v, ok := s.GetOk("max_retry_attempts"); ok {
+		policy.MaxAttempts = v.(int)
+	}
+	if v, ok := s.GetOk("retry_initial_backoff"); ok {
+		policy.InitialBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := s.GetOk("retry_max_backoff"); ok {
+		policy.MaxBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := s.GetOk("retryable_errors"); ok {
+		policy.RetryableErrors = make([]error, 0)
+		for _, name := range v.(*schema.Set).List() {
+			policy.RetryableErrors = append(policy.RetryableErrors, retryableErrors[name.(string)])
+		}
+	}
+	policy.RetryNonIdempotent = s.Get("retry_non_idempotent_statements").(bool)
+	return policy
+}
+
+func statementBatchOptions(s *schema.ResourceData, mode sdk.StatementBatchMode) *sdk.StatementBatchOptions {
+	options := sdk.DefaultStatementBatchOptions()
+	options.Mode = mode
+	if v, ok := s.GetOk("grant_batch_size"); ok {
+		options.MaxStatements = v.(int)
+	}
+	if v, ok := s.GetOk("grant_batch_window"); ok {
+		options.Window = time.Duration(v.(int)) * time.Millisecond
+	}
+	return options
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// suspendShowCacheOnWrites makes the Create, Update and Delete operations of the resources bypass the SHOW cache
+// (enabled with cache_show_results) and invalidate it once done, so that they and the reads following them see
+// their own writes, including the ones not made through sdk.Client. Resources are expected to be wrapped in context
+// aware operations already (see sqlPlan.wrapResources).
+func suspendShowCacheOnWrites(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, resource := range resources {
+		if resource.CreateContext != nil {
+			resource.CreateContext = schema.CreateContextFunc(suspendingShowCache(resourceOperationFunc(resource.CreateContext)))
+		}
+		if resource.UpdateContext != nil {
+			resource.UpdateContext = schema.UpdateContextFunc(suspendingShowCache(resourceOperationFunc(resource.UpdateContext)))
+		}
+		if resource.DeleteContext != nil {
+			resource.DeleteContext = schema.DeleteContextFunc(suspendingShowCache(resourceOperationFunc(resource.DeleteContext)))
+		}
+	}
+	return resources
+}
+
+func suspendingShowCache(f resourceOperationFunc) resourceOperationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if db, ok := meta.(*sql.DB); ok {
+			resume := sdk.NewClientFromDB(db).SuspendShowCache()
+			defer resume()
+		}
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type sqlPlanOperation string
+
+const (
+	sqlPlanCreateOperation sqlPlanOperation = "CREATE"
+	sqlPlanUpdateOperation sqlPlanOperation = "UPDATE"
+	sqlPlanDeleteOperation sqlPlanOperation = "DELETE"
+)
+
+type resourceOperationFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// sqlPlan renders the statements Create, Update and Delete operations would run instead of executing them,
+// when the provider is configured with dry_run. Every operation runs against its own sdk.NewDryRunClientReadingFrom
+// connection, so the rendered statements are exactly the ones changing objects the operation sends to Snowflake,
+// while its queries, like the Read at the end of Create and Update, still run against Snowflake.
+type sqlPlan struct {
+	enabled bool
+	output  string
+	mu      sync.Mutex
+}
+
+func (p *sqlPlan) configure(s *schema.ResourceData) {
+	p.enabled = s.Get("dry_run").(bool)
+	p.output = s.Get("sql_plan_output").(string)
+}
+
+func (p *sqlPlan) wrapResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		p.wrapResource(name, resource)
+	}
+	return resources
+}
+
+func (p *sqlPlan) wrapResource(resourceName string, resource *schema.Resource) {
+	if resource.Create != nil {
+		resource.CreateContext = schema.CreateContextFunc(withContext(resource.Create))
+		resource.Create = nil
+	}
+	if resource.Update != nil {
+		resource.UpdateContext = schema.UpdateContextFunc(withContext(resource.Update))
+		resource.Update = nil
+	}
+	if resource.Delete != nil {
+		resource.DeleteContext = schema.DeleteContextFunc(withContext(resource.Delete))
+		resource.Delete = nil
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = schema.CreateContextFunc(p.wrapOperation(sqlPlanCreateOperation, resourceName, resourceOperationFunc(resource.CreateContext)))
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = schema.UpdateContextFunc(p.wrapOperation(sqlPlanUpdateOperation, resourceName, resourceOperationFunc(resource.UpdateContext)))
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = schema.DeleteContextFunc(p.wrapOperation(sqlPlanDeleteOperation, resourceName, resourceOperationFunc(resource.DeleteContext)))
+	}
+}
+
+func withContext(f func(*schema.ResourceData, interface{}) error) resourceOperationFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, meta))
+	}
+}
+
+func (p *sqlPlan) wrapOperation(operation sqlPlanOperation, resourceName string, f resourceOperationFunc) resourceOperationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !p.enabled {
+			return f(ctx, d, meta)
+		}
+
+		id := d.Id()
+		db, _ := meta.(*sql.DB)
+		client := sdk.NewDryRunClientReadingFrom(db)
+		diags := f(ctx, d, client.GetConn().DB)
+		statements := client.TraceLogs()
+		if len(statements) == 0 && diags.HasError() {
+			return diags
+		}
+
+		// nothing was executed, so the state has to stay as it was before the operation
+		switch operation {
+		case sqlPlanCreateOperation:
+			id = d.Id()
+			d.SetId("")
+		case sqlPlanUpdateOperation:
+			d.Partial(true)
+			d.SetId(id)
+		case sqlPlanDeleteOperation:
+			d.SetId(id)
+		}
+		// the diagnostics of the operation, e.g. of its final Read, are reported with the plan
+		return append(p.render(operation, resourceName, id, statements), diags...)
+	}
+}
+
+func (p *sqlPlan) render(operation sqlPlanOperation, resourceName string, id string, statements []string) diag.Diagnostics {
+	plan := formatSQLPlan(operation, resourceName, id, statements)
+	if p.output == "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("SQL plan for %s %s", operation, resourceName),
+				Detail:   plan,
+			},
+			{
+				Severity: diag.Error,
+				Summary:  "dry_run is enabled",
+				Detail:   fmt.Sprintf("%d statement(s) for %s %s were rendered instead of being executed.", len(statements), resourceName, id),
+			},
+		}
+	}
+
+	if err := p.write(plan); err != nil {
+		return diag.Errorf("could not write SQL plan to %s: %s", p.output, err)
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "dry_run is enabled",
+			Detail:   fmt.Sprintf("%d statement(s) for %s %s were written to %s instead of being executed.", len(statements), resourceName, id, p.output),
+		},
+	}
+}
+
+func (p *sqlPlan) write(plan string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f, err := os.OpenFile(p.output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(plan); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func formatSQLPlan(operation sqlPlanOperation, resourceName string, id string, statements []string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.TrimSpace(fmt.Sprintf("-- %s %s %s", operation, resourceName, id)))
+	sb.WriteString("\n")
+	for _, statement := range statements {
+		sb.WriteString(strings.TrimSuffix(strings.TrimSpace(statement), ";"))
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func sqlPlanTestResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			db := meta.(*sql.DB)
+			id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+			if err := sdk.NewClientFromDB(db).Warehouses.Create(context.Background(), id, nil); err != nil {
+				return err
+			}
+			if _, err := db.Exec("GRANT USAGE ON WAREHOUSE " + id.FullyQualifiedName() + " TO ROLE \"analyst\""); err != nil {
+				return err
+			}
+			d.SetId(id.Name())
+			if _, err := sdk.NewClientFromDB(db).Warehouses.ShowByID(context.Background(), id); err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				return err
+			}
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			id := sdk.NewAccountObjectIdentifier(d.Id())
+			if err := sdk.NewClientFromDB(meta.(*sql.DB)).Warehouses.Drop(ctx, id, nil); err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId("")
+			return nil
+		},
+	}
+}
+
+func TestSQLPlan(t *testing.T) {
+	ctx := context.Background()
+
+	newResourceData := func(t *testing.T, resource *schema.Resource, id string) *schema.ResourceData {
+		t.Helper()
+		d := resource.TestResourceData()
+		require.NoError(t, d.Set("name", "WH"))
+		d.SetId(id)
+		return d
+	}
+
+	t.Run("disabled: operations receive the provider connection", func(t *testing.T) {
+		plan := &sqlPlan{}
+		resource := sqlPlanTestResource()
+		plan.wrapResource("snowflake_test", resource)
+		require.Nil(t, resource.Create)
+		client := sdk.NewDryRunClient()
+
+		diags := resource.CreateContext(ctx, newResourceData(t, resource, ""), client.GetConn().DB)
+
+		assert.Empty(t, diags)
+		assert.Len(t, client.TraceLogs(), 3)
+	})
+
+	t.Run("enabled: create statements reported as warnings", func(t *testing.T) {
+		plan := &sqlPlan{enabled: true}
+		resource := sqlPlanTestResource()
+		plan.wrapResource("snowflake_test", resource)
+		d := newResourceData(t, resource, "")
+		reads := sdk.NewDryRunClient()
+
+		diags := resource.CreateContext(ctx, d, reads.GetConn().DB)
+
+		require.Len(t, diags, 2)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "SQL plan for CREATE snowflake_test", diags[0].Summary)
+		assert.Equal(t, "-- CREATE snowflake_test WH\nCREATE WAREHOUSE \"WH\";\nGRANT USAGE ON WAREHOUSE \"WH\" TO ROLE \"analyst\";\n\n", diags[0].Detail)
+		assert.Equal(t, diag.Error, diags[1].Severity)
+		assert.Empty(t, d.Id())
+		// the query of the operation is run on the provider connection instead of being rendered
+		assert.Equal(t, []string{"SHOW WAREHOUSES LIKE 'WH'"}, reads.TraceLogs())
+	})
+
+	t.Run("enabled: delete statements written to file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "plan.sql")
+		plan := &sqlPlan{enabled: true, output: output}
+		resource := sqlPlanTestResource()
+		plan.wrapResource("snowflake_test", resource)
+		d := newResourceData(t, resource, "WH")
+
+		diags := resource.DeleteContext(ctx, d, nil)
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Error, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, output)
+		assert.Equal(t, "WH", d.Id())
+
+		diags = resource.DeleteContext(ctx, d, nil)
+		require.Len(t, diags, 1)
+
+		content, err := os.ReadFile(output)
+		require.NoError(t, err)
+		assert.Equal(t, "-- DELETE snowflake_test WH\nDROP WAREHOUSE \"WH\";\n\n-- DELETE snowflake_test WH\nDROP WAREHOUSE \"WH\";\n\n", string(content))
+	})
+
+	t.Run("enabled: errors before any statement are returned as is", func(t *testing.T) {
+		plan := &sqlPlan{enabled: true}
+		resource := sqlPlanTestResource()
+		plan.wrapResource("snowflake_test", resource)
+		d := newResourceData(t, resource, "")
+		require.NoError(t, d.Set("name", ""))
+
+		diags := resource.CreateContext(ctx, d, nil)
+
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Error, diags[0].Severity)
+	})
+
+	t.Run("enabled: errors after the statements are reported with the plan", func(t *testing.T) {
+		plan := &sqlPlan{enabled: true}
+		resource := sqlPlanTestResource()
+		resource.Create = nil
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := sdk.NewClientFromDB(meta.(*sql.DB)).Warehouses.Create(ctx, sdk.NewAccountObjectIdentifier("WH"), nil); err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.Errorf("unable to read warehouse")
+		}
+		plan.wrapResource("snowflake_test", resource)
+
+		diags := resource.CreateContext(ctx, newResourceData(t, resource, ""), nil)
+
+		require.Len(t, diags, 3)
+		assert.Equal(t, "SQL plan for CREATE snowflake_test", diags[0].Summary)
+		assert.Equal(t, diag.Error, diags[2].Severity)
+		assert.Equal(t, "unable to read warehouse", diags[2].Summary)
+	})
+}
@@ -90,6 +90,10 @@ func AccountGrant() *TerraformGrantResource {
 
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             accountGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(accountGrantSchema, "privilege", "with_grant_option", "roles"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
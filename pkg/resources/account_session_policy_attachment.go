@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountSessionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"session_policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the session policy to apply to the current account.",
+	},
+}
+
+// AccountSessionPolicyAttachment returns a pointer to the resource representing a session policy attached to the current account.
+func AccountSessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the session policy to use for the current account. To set the session policy of a different account, use a provider alias.",
+
+		Create: CreateAccountSessionPolicyAttachment,
+		Read:   ReadAccountSessionPolicyAttachment,
+		Delete: DeleteAccountSessionPolicyAttachment,
+
+		Schema: accountSessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAccountSessionPolicyAttachment implements schema.CreateFunc.
+func CreateAccountSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	sessionPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("session_policy").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("session_policy %s is not a valid session policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("session_policy"))
+	}
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Set: &sdk.AccountSet{
+			SessionPolicy: sessionPolicy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(sessionPolicy))
+
+	return nil
+}
+
+func ReadAccountSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	sessionPolicy := helpers.DecodeSnowflakeID(d.Id())
+	if err := d.Set("session_policy", sessionPolicy.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAccountSessionPolicyAttachment implements schema.DeleteFunc.
+func DeleteAccountSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			SessionPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AccountSessionPolicyAttachment(t *testing.T) {
+	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountSessionPolicyAttachmentConfig(acc.TestDatabaseName, acc.TestSchemaName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snowflake_account_session_policy_attachment.att", "id"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_account_session_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountSessionPolicyAttachmentConfig(databaseName, schemaName, prefix string) string {
+	s := `
+resource "snowflake_session_policy" "sp" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%v"
+}
+
+resource "snowflake_account_session_policy_attachment" "att" {
+	session_policy = snowflake_session_policy.sp.qualified_name
+}
+`
+	return fmt.Sprintf(s, databaseName, schemaName, prefix)
+}
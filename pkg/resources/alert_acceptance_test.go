@@ -96,9 +96,9 @@ var (
 
 func TestAcc_Alert(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: alertConfig(alertInitialState),
@@ -0,0 +1,242 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the application; must be unique for the account.",
+	},
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The application package the application is installed from.",
+	},
+	"version": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ConflictsWith:    []string{"using"},
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Specifies the version of the application package to install. When not set, the version from the default release directive of the application package is installed. Changing it upgrades the application.",
+	},
+	"patch": {
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"using"},
+		Description:   "Specifies the patch of the version to install. When not set, the latest patch of the version is installed. Changing it upgrades the application.",
+	},
+	"using": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"version", "patch"},
+		Description:   "Stage path with the application files to install the application from in development mode. Changing it upgrades the application.",
+	},
+	"debug_mode": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether debug mode is enabled for the application. Only applicable to applications installed in development mode or from a version.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the application.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Label of the installed version.",
+	},
+}
+
+// Application returns a pointer to the resource representing a Native App application.
+func Application() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplication,
+		Read:   ReadApplication,
+		Update: UpdateApplication,
+		Delete: DeleteApplication,
+
+		Schema: applicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateApplication implements schema.CreateFunc.
+func CreateApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+	packageId := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	request := sdk.NewCreateApplicationRequest(id, packageId)
+	if version := applicationVersion(d); version != nil {
+		request.WithVersion(version)
+	}
+	if v, ok := d.GetOk("debug_mode"); ok && v.(bool) {
+		request.WithDebugMode(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Applications.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating application %v from application package %v err = %w", id.Name(), packageId.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadApplication(d, meta)
+}
+
+// ReadApplication implements schema.ReadFunc.
+func ReadApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	application, err := client.Applications.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] application (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	properties, err := client.Applications.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":                application.Name,
+		"application_package": application.Source,
+		"comment":             application.Comment,
+		"owner":               application.Owner,
+		"label":               application.Label,
+	}
+	// applications installed from files on a stage don't have a version
+	if _, ok := d.GetOk("using"); !ok {
+		values["version"] = application.Version
+		values["patch"] = application.Patch
+	}
+	for _, property := range properties {
+		if strings.EqualFold(property.Property, "debug_mode") {
+			values["debug_mode"] = strings.EqualFold(property.Value, "true")
+		}
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateApplication implements schema.UpdateFunc.
+func UpdateApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChanges("version", "patch", "using") {
+		request := sdk.NewAlterApplicationRequest(id)
+		if version := applicationVersion(d); version != nil {
+			request.WithUpgradeVersion(version)
+		} else {
+			request.WithUpgrade(sdk.Bool(true))
+		}
+		if err := client.Applications.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error upgrading application %v err = %w", d.Id(), err)
+		}
+	}
+
+	set, unset := sdk.NewApplicationSetRequest(), sdk.NewApplicationUnsetRequest()
+	var runSet, runUnset bool
+	if d.HasChange("debug_mode") {
+		set.WithDebugMode(sdk.Bool(d.Get("debug_mode").(bool)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating application %v err = %w", d.Id(), err)
+		}
+	}
+	if runUnset {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating application %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadApplication(d, meta)
+}
+
+// DeleteApplication implements schema.DeleteFunc.
+func DeleteApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.Applications.Drop(ctx, sdk.NewDropApplicationRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// applicationVersion returns the version the application should be installed from or upgraded to;
+// nil means that the default release directive of the application package is used.
+func applicationVersion(d *schema.ResourceData) *sdk.ApplicationVersionRequest {
+	if v, ok := d.GetOk("using"); ok {
+		return sdk.NewApplicationVersionRequest().WithVersionDirectory(sdk.String(v.(string)))
+	}
+	v, ok := d.GetOk("version")
+	if !ok {
+		return nil
+	}
+	versionAndPatch := sdk.NewVersionAndPatchRequest(v.(string))
+	// patch is computed, so the raw config is checked to tell a configured patch 0 from the latest patch kept in the state
+	if !d.GetRawConfig().GetAttr("patch").IsNull() {
+		versionAndPatch.WithPatch(sdk.Int(d.Get("patch").(int)))
+	}
+	return sdk.NewApplicationVersionRequest().WithVersionAndPatch(versionAndPatch)
+}
@@ -0,0 +1,202 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationPackageSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the application package; must be unique for the account.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(0, 90),
+		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the application package.",
+	},
+	"distribution": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.DistributionInternal), string(sdk.DistributionExternal)}, false),
+		Description:  "Specifies whether the application package can be shared with consumers outside of the provider's organization (EXTERNAL) or only within it (INTERNAL).",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application package.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the application package.",
+	},
+	"tag": tagReferenceSchema,
+}
+
+// ApplicationPackage returns a pointer to the resource representing a Native App application package.
+func ApplicationPackage() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplicationPackage,
+		Read:   ReadApplicationPackage,
+		Update: UpdateApplicationPackage,
+		Delete: DeleteApplicationPackage,
+
+		Schema: applicationPackageSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateApplicationPackage implements schema.CreateFunc.
+func CreateApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+	request := sdk.NewCreateApplicationPackageRequest(id)
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		request.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	}
+	if v, ok := d.GetOk("distribution"); ok {
+		request.WithDistribution(sdk.Pointer(sdk.Distribution(v.(string))))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	if err := client.ApplicationPackages.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating application package %v err = %w", id.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadApplicationPackage(d, meta)
+}
+
+// ReadApplicationPackage implements schema.ReadFunc.
+func ReadApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	applicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] application package (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":                        applicationPackage.Name,
+		"data_retention_time_in_days": applicationPackage.RetentionTime,
+		"distribution":                applicationPackage.Distribution,
+		"comment":                     applicationPackage.Comment,
+		"owner":                       applicationPackage.Owner,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateApplicationPackage implements schema.UpdateFunc.
+func UpdateApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewApplicationPackageSetRequest(), sdk.NewApplicationPackageUnsetRequest()
+	var runSet, runUnset bool
+	if d.HasChange("data_retention_time_in_days") {
+		if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+			set.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+			runSet = true
+		}
+	}
+	if d.HasChange("distribution") {
+		if v, ok := d.GetOk("distribution"); ok {
+			set.WithDistribution(sdk.Pointer(sdk.Distribution(v.(string))))
+			runSet = true
+		} else {
+			unset.WithDistribution(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating application package %v err = %w", d.Id(), err)
+		}
+	}
+	if runUnset {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating application package %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error occurred when dropping tags on %v, err = %w", d.Id(), err)
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error occurred when setting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	return ReadApplicationPackage(d, meta)
+}
+
+// DeleteApplicationPackage implements schema.DeleteFunc.
+func DeleteApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ApplicationPackages.Drop(ctx, sdk.NewDropApplicationPackageRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,128 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ApplicationPackage(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_application_package.p"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageConfig(name, "INTERNAL", "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "distribution", "INTERNAL"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+				),
+			},
+			{
+				Config: applicationPackageConfig(name, "EXTERNAL", "other comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "distribution", "EXTERNAL"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAcc_Application needs a stage with the manifest.yml and the setup script of a Native App.
+// Set TEST_SF_TF_APPLICATION_STAGE to the stage path (e.g. @db.schema.stage) to run it.
+func TestAcc_Application(t *testing.T) {
+	using := os.Getenv("TEST_SF_TF_APPLICATION_STAGE")
+	if using == "" {
+		t.Skip("Skipping TestAcc_Application")
+	}
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	versionResourceName := "snowflake_application_package_version.v"
+	resourceName := "snowflake_application.a"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationConfig(name, using, "first", "V001"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(versionResourceName, "id", fmt.Sprintf("%s_PKG|V001", name)),
+					resource.TestCheckResourceAttr(versionResourceName, "label", "first"),
+					resource.TestCheckResourceAttr(versionResourceName, "patch", "0"),
+					resource.TestCheckResourceAttr(resourceName, "application_package", name+"_PKG"),
+					resource.TestCheckResourceAttr(resourceName, "version", "V001"),
+					resource.TestCheckResourceAttr(resourceName, "patch", "0"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+				),
+			},
+			// changing the label adds a patch, which the application is upgraded to
+			{
+				Config: applicationConfig(name, using, "second", "V001"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(versionResourceName, "label", "second"),
+					resource.TestCheckResourceAttr(versionResourceName, "patch", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "V001"),
+					resource.TestCheckResourceAttr(resourceName, "patch", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"debug_mode"},
+			},
+		},
+	})
+}
+
+func applicationPackageConfig(name string, distribution string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "p" {
+	name         = "%[1]s"
+	distribution = "%[2]s"
+	comment      = "%[3]s"
+}
+`, name, distribution, comment)
+}
+
+func applicationConfig(name string, using string, label string, version string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "p" {
+	name = "%[1]s_PKG"
+}
+
+resource "snowflake_application_package_version" "v" {
+	application_package       = snowflake_application_package.p.name
+	version                   = "%[4]s"
+	using                     = "%[2]s"
+	label                     = "%[3]s"
+	default_release_directive = true
+}
+
+resource "snowflake_application" "a" {
+	name                = "%[1]s"
+	application_package = snowflake_application_package.p.name
+	version             = snowflake_application_package_version.v.version
+	patch               = snowflake_application_package_version.v.patch
+	comment             = "some comment"
+}
+`, name, using, label, version)
+}
@@ -0,0 +1,238 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackageVersionSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The application package the version is added to.",
+	},
+	"version": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier of the version (e.g. `v1_0`). Unquoted identifiers are stored in uppercase by Snowflake.",
+	},
+	"using": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Stage path (e.g. `@db.schema.stage/v1`) containing the manifest.yml and setup script of the version. Changing it adds a new patch to the version.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Specifies a label for the version that is displayed to consumers; when not set, the label from the manifest is used. Changing it adds a new patch to the version.",
+	},
+	"default_release_directive": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the latest patch of the version is set as the default release directive of the application package. The default release directive can be moved to another version, but it cannot be removed, so setting this back to false leaves it in place.",
+	},
+	"patch": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of the latest patch of the version.",
+	},
+}
+
+// ApplicationPackageVersion returns a pointer to the resource representing a version of a Native App application package.
+func ApplicationPackageVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplicationPackageVersion,
+		Read:   ReadApplicationPackageVersion,
+		Update: UpdateApplicationPackageVersion,
+		Delete: DeleteApplicationPackageVersion,
+
+		Schema: applicationPackageVersionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// applicationPackageVersionID is decoded from the id in the form of application_package|version.
+type applicationPackageVersionID struct {
+	ApplicationPackage sdk.AccountObjectIdentifier
+	Version            string
+}
+
+func (v applicationPackageVersionID) String() string {
+	return helpers.EncodeSnowflakeID(v.ApplicationPackage.Name(), v.Version)
+}
+
+func applicationPackageVersionIDFromString(id string) (applicationPackageVersionID, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 2 {
+		return applicationPackageVersionID{}, fmt.Errorf("invalid application package version id %s, expected format: application_package|version", id)
+	}
+	return applicationPackageVersionID{
+		ApplicationPackage: sdk.NewAccountObjectIdentifier(parts[0]),
+		Version:            parts[1],
+	}, nil
+}
+
+// CreateApplicationPackageVersion implements schema.CreateFunc.
+func CreateApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := applicationPackageVersionID{
+		ApplicationPackage: sdk.NewAccountObjectIdentifier(d.Get("application_package").(string)),
+		Version:            d.Get("version").(string),
+	}
+	request := sdk.NewAddVersionRequest(d.Get("using").(string)).WithVersionIdentifier(sdk.String(id.Version))
+	if v, ok := d.GetOk("label"); ok {
+		request.WithLabel(sdk.String(v.(string)))
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id.ApplicationPackage).WithAddVersion(request)); err != nil {
+		return fmt.Errorf("error adding version %s to application package %v err = %w", id.Version, id.ApplicationPackage.Name(), err)
+	}
+	d.SetId(id.String())
+
+	if d.Get("default_release_directive").(bool) {
+		if err := setApplicationPackageVersionAsDefault(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	return ReadApplicationPackageVersion(d, meta)
+}
+
+// ReadApplicationPackageVersion implements schema.ReadFunc.
+func ReadApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id, err := applicationPackageVersionIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	latest, err := latestApplicationPackageVersionPatch(ctx, client, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] application package version (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the stage path is not returned by SHOW VERSIONS, so the configured value is kept in the state
+	values := map[string]any{
+		"application_package": id.ApplicationPackage.Name(),
+		"version":             id.Version,
+		"label":               latest.Label,
+		"patch":               latest.Patch,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateApplicationPackageVersion implements schema.UpdateFunc.
+func UpdateApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id, err := applicationPackageVersionIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	patched := d.HasChanges("using", "label")
+	if patched {
+		request := sdk.NewAddPatchForVersionRequest(id.Version, d.Get("using").(string))
+		if v, ok := d.GetOk("label"); ok {
+			request.WithLabel(sdk.String(v.(string)))
+		}
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id.ApplicationPackage).WithAddPatchForVersion(request)); err != nil {
+			return fmt.Errorf("error adding patch for version %s to application package %v err = %w", id.Version, id.ApplicationPackage.Name(), err)
+		}
+	}
+
+	// a new patch has to be released explicitly, so the directive follows it when the version is the default one
+	if d.Get("default_release_directive").(bool) && (patched || d.HasChange("default_release_directive")) {
+		if err := setApplicationPackageVersionAsDefault(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	return ReadApplicationPackageVersion(d, meta)
+}
+
+// DeleteApplicationPackageVersion implements schema.DeleteFunc.
+func DeleteApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id, err := applicationPackageVersionIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id.ApplicationPackage).WithDropVersion(sdk.NewDropVersionRequest(id.Version))); err != nil {
+		return fmt.Errorf("error dropping version %s from application package %v err = %w", id.Version, id.ApplicationPackage.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setApplicationPackageVersionAsDefault(ctx context.Context, client *sdk.Client, id applicationPackageVersionID) error {
+	latest, err := latestApplicationPackageVersionPatch(ctx, client, id)
+	if err != nil {
+		return err
+	}
+	request := sdk.NewSetDefaultReleaseDirectiveRequest(id.Version, latest.Patch)
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id.ApplicationPackage).WithSetDefaultReleaseDirective(request)); err != nil {
+		return fmt.Errorf("error setting default release directive of application package %v to version %s patch %d err = %w", id.ApplicationPackage.Name(), id.Version, latest.Patch, err)
+	}
+	return nil
+}
+
+// latestApplicationPackageVersionPatch returns the patch with the highest number of the given version;
+// unquoted version identifiers are returned in uppercase, so the version is compared case-insensitively.
+func latestApplicationPackageVersionPatch(ctx context.Context, client *sdk.Client, id applicationPackageVersionID) (*sdk.ApplicationPackageVersion, error) {
+	versions, err := client.ApplicationPackages.ShowVersions(ctx, id.ApplicationPackage)
+	if err != nil {
+		return nil, err
+	}
+	var latest *sdk.ApplicationPackageVersion
+	for i, version := range versions {
+		if !strings.EqualFold(version.Version, id.Version) {
+			continue
+		}
+		if latest == nil || version.Patch > latest.Patch {
+			latest = &versions[i]
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("version %s not found in application package %v: %w", id.Version, id.ApplicationPackage.Name(), sdk.ErrObjectNotExistOrAuthorized)
+	}
+	return latest, nil
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationPackageVersionIDFromString(t *testing.T) {
+	t.Run("valid id", func(t *testing.T) {
+		id, err := applicationPackageVersionIDFromString("package|V001")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewAccountObjectIdentifier("package"), id.ApplicationPackage)
+		require.Equal(t, "V001", id.Version)
+		require.Equal(t, "package|V001", id.String())
+	})
+
+	t.Run("invalid number of parts", func(t *testing.T) {
+		_, err := applicationPackageVersionIDFromString("package")
+		require.ErrorContains(t, err, "invalid application package version id package")
+	})
+}
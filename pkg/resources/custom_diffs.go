@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDataGetter is implemented by both schema.ResourceData and schema.ResourceDiff. It allows building
+// the SDK options once and using them both during apply and during plan-time validation.
+type resourceDataGetter interface {
+	Get(key string) any
+	GetOk(key string) (any, bool)
+}
+
+// sdkValidationCustomDiff returns a CustomizeDiffFunc that passes the planned state to the given SDK call made
+// with a dry-run client. This way the validations defined on the SDK options structs are run during plan,
+// but no statement is sent to Snowflake. The validation is run only when one of the given attributes changes
+// and the error is reported at the path of the first changed one, unless the call already returns a cty.PathError.
+// Values not known yet are left out of the SDK options.
+func sdkValidationCustomDiff(call func(ctx context.Context, client *sdk.Client, d *schema.ResourceDiff) error, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		changed := make([]string, 0)
+		for _, key := range keys {
+			if d.NewValueKnown(key) && d.HasChange(key) {
+				changed = append(changed, key)
+			}
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		err := call(ctx, sdk.NewDryRunClient(), d)
+		if err == nil {
+			return nil
+		}
+		var pathErr cty.PathError
+		if errors.As(err, &pathErr) {
+			return pathErr
+		}
+		return cty.GetAttrPath(changed[0]).NewError(err)
+	}
+}
@@ -0,0 +1,314 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// unknownVariableValue is how the SDK represents values that are not known until apply.
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func planResource(t *testing.T, r *schema.Resource, state *terraform.InstanceState, config map[string]any) error {
+	t.Helper()
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	return err
+}
+
+func requireErrorAtPath(t *testing.T, err error, attribute string, message string) {
+	t.Helper()
+	var pathErr cty.PathError
+	require.ErrorAs(t, err, &pathErr)
+	require.Equal(t, cty.GetAttrPath(attribute), pathErr.Path)
+	require.ErrorContains(t, err, message)
+}
+
+func TestWarehouseCustomizeDiff(t *testing.T) {
+	t.Run("min cluster count greater than max cluster count", func(t *testing.T) {
+		err := planResource(t, Warehouse(), nil, map[string]any{
+			"name":              "WH",
+			"min_cluster_count": 3,
+			"max_cluster_count": 2,
+		})
+		requireErrorAtPath(t, err, "min_cluster_count", "MinClusterCount must be less than or equal to MaxClusterCount")
+	})
+
+	t.Run("min cluster count greater than max cluster count on update", func(t *testing.T) {
+		state := &terraform.InstanceState{
+			ID: `"WH"`,
+			Attributes: map[string]string{
+				"name":              "WH",
+				"min_cluster_count": "1",
+				"max_cluster_count": "2",
+			},
+		}
+		err := planResource(t, Warehouse(), state, map[string]any{
+			"name":              "WH",
+			"min_cluster_count": 3,
+			"max_cluster_count": 2,
+		})
+		requireErrorAtPath(t, err, "min_cluster_count", "MinClusterCount must be less than or equal to MaxClusterCount")
+	})
+
+	t.Run("min cluster count with unknown max cluster count", func(t *testing.T) {
+		err := planResource(t, Warehouse(), nil, map[string]any{
+			"name":              "WH",
+			"min_cluster_count": 3,
+			"max_cluster_count": unknownVariableValue,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("valid cluster counts", func(t *testing.T) {
+		err := planResource(t, Warehouse(), nil, map[string]any{
+			"name":              "WH",
+			"min_cluster_count": 1,
+			"max_cluster_count": 2,
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestTaskCustomizeDiff(t *testing.T) {
+	t.Run("invalid session parameter value", func(t *testing.T) {
+		err := planResource(t, Task(), nil, map[string]any{
+			"name":          "TASK",
+			"database":      "DB",
+			"schema":        "SCHEMA",
+			"sql_statement": "SELECT 1",
+			"session_parameters": map[string]any{
+				"JSON_INDENT": "17",
+			},
+		})
+		requireErrorAtPath(t, err, "session_parameters", "JSONIndent must be between 0 and 16")
+	})
+
+	t.Run("schedule together with after", func(t *testing.T) {
+		err := planResource(t, Task(), nil, map[string]any{
+			"name":          "TASK",
+			"database":      "DB",
+			"schema":        "SCHEMA",
+			"sql_statement": "SELECT 1",
+			"schedule":      "10 MINUTE",
+			"after":         []any{"ROOT"},
+		})
+		requireErrorAtPath(t, err, "schedule", "fields: [Schedule After] are incompatible")
+	})
+
+	t.Run("valid session parameter value", func(t *testing.T) {
+		err := planResource(t, Task(), nil, map[string]any{
+			"name":          "TASK",
+			"database":      "DB",
+			"schema":        "SCHEMA",
+			"sql_statement": "SELECT 1",
+			"session_parameters": map[string]any{
+				"JSON_INDENT": "4",
+			},
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestTableCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "DB|SCHEMA|TABLE",
+		Attributes: map[string]string{
+			"name":                    "TABLE",
+			"database":                "DB",
+			"schema":                  "SCHEMA",
+			"change_tracking":         "false",
+			"column.#":                "2",
+			"column.0.name":           "A",
+			"column.0.renamed_from":   "",
+			"column.0.type":           "VARCHAR(16)",
+			"column.0.collate":        "",
+			"column.0.nullable":       "true",
+			"column.0.default.#":      "0",
+			"column.0.identity.#":     "0",
+			"column.0.comment":        "",
+			"column.0.masking_policy": "",
+			"column.0.tag.#":          "0",
+			"column.1.name":           "B",
+			"column.1.renamed_from":   "",
+			"column.1.type":           "NUMBER(10,2)",
+			"column.1.collate":        "",
+			"column.1.nullable":       "true",
+			"column.1.default.#":      "0",
+			"column.1.identity.#":     "0",
+			"column.1.comment":        "",
+			"column.1.masking_policy": "",
+			"column.1.tag.#":          "0",
+		},
+	}
+	config := func(columns ...map[string]any) map[string]any {
+		c := make([]any, len(columns))
+		for i, column := range columns {
+			c[i] = column
+		}
+		return map[string]any{
+			"name":     "TABLE",
+			"database": "DB",
+			"schema":   "SCHEMA",
+			"column":   c,
+		}
+	}
+
+	t.Run("rename and widening", func(t *testing.T) {
+		err := planResource(t, Table(), state, config(
+			map[string]any{"name": "A", "type": "VARCHAR(100)"},
+			map[string]any{"name": "C", "renamed_from": "B", "type": "NUMBER(10,2)", "nullable": false},
+		))
+		require.NoError(t, err)
+	})
+
+	t.Run("renamed from a missing column", func(t *testing.T) {
+		err := planResource(t, Table(), state, config(
+			map[string]any{"name": "A", "type": "VARCHAR(16)"},
+			map[string]any{"name": "C", "renamed_from": "X", "type": "NUMBER(10,2)"},
+		))
+		require.ErrorContains(t, err, "column C cannot be renamed from X, because the table has no column named X")
+	})
+
+	t.Run("narrowing", func(t *testing.T) {
+		err := planResource(t, Table(), state, config(
+			map[string]any{"name": "A", "type": "VARCHAR(8)"},
+			map[string]any{"name": "B", "type": "NUMBER(10,2)"},
+		))
+		require.ErrorContains(t, err, "column A: type cannot be changed from VARCHAR(16) to VARCHAR(8) in place")
+	})
+
+	t.Run("collation", func(t *testing.T) {
+		err := planResource(t, Table(), state, config(
+			map[string]any{"name": "A", "type": "VARCHAR(16)", "collate": "en-ci"},
+			map[string]any{"name": "B", "type": "NUMBER(10,2)"},
+		))
+		require.ErrorContains(t, err, `column A: collation cannot be changed from "" to "en-ci" in place`)
+	})
+
+	t.Run("added column with expression default", func(t *testing.T) {
+		err := planResource(t, Table(), state, config(
+			map[string]any{"name": "A", "type": "VARCHAR(16)"},
+			map[string]any{"name": "B", "type": "NUMBER(10,2)"},
+			map[string]any{"name": "C", "type": "TIMESTAMP_NTZ", "default": []any{map[string]any{"expression": "CURRENT_TIMESTAMP()"}}},
+		))
+		require.ErrorContains(t, err, "column C: only a constant default or an identity is supported by Snowflake when adding a column")
+	})
+
+	t.Run("masking policy not fully qualified", func(t *testing.T) {
+		err := planResource(t, Table(), nil, config(
+			map[string]any{"name": "A", "type": "VARCHAR(16)", "masking_policy": "POLICY"},
+		))
+		require.ErrorContains(t, err, "masking policy POLICY of column A must be a fully qualified name in the form of database.schema.policy")
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		err := planResource(t, Table(), state, config(
+			map[string]any{"name": "A", "type": unknownVariableValue},
+			map[string]any{"name": "B", "type": "NUMBER(10,2)"},
+		))
+		require.NoError(t, err)
+	})
+}
+
+func TestIcebergTableCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: `"DB"|"SCHEMA"|"TABLE"`,
+		Attributes: map[string]string{
+			"name":                        "TABLE",
+			"database":                    "DB",
+			"schema":                      "SCHEMA",
+			"external_volume":             "VOLUME",
+			"catalog":                     "GLUE",
+			"catalog_table_name":          "table",
+			"replace_invalid_characters":  "false",
+			"data_retention_time_in_days": "-1",
+			"change_tracking":             "false",
+			"convert_to_managed":          "false",
+		},
+	}
+	config := func(extra map[string]any) map[string]any {
+		c := map[string]any{
+			"name":               "TABLE",
+			"database":           "DB",
+			"schema":             "SCHEMA",
+			"external_volume":    "VOLUME",
+			"catalog":            "GLUE",
+			"catalog_table_name": "table",
+		}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+	requiresNew := func(t *testing.T, state *terraform.InstanceState, config map[string]any) bool {
+		t.Helper()
+		diff, err := IcebergTable().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+		return diff.RequiresNew()
+	}
+
+	t.Run("convert to managed with base location", func(t *testing.T) {
+		require.False(t, requiresNew(t, state, config(map[string]any{
+			"convert_to_managed":           true,
+			"base_location":                "path/",
+			"storage_serialization_policy": "COMPATIBLE",
+		})))
+	})
+
+	t.Run("base location without conversion", func(t *testing.T) {
+		require.True(t, requiresNew(t, state, config(map[string]any{
+			"base_location": "path/",
+		})))
+	})
+
+	t.Run("converted table", func(t *testing.T) {
+		converted := state.DeepCopy()
+		converted.Attributes["convert_to_managed"] = "true"
+		converted.Attributes["base_location"] = "path/"
+		require.False(t, requiresNew(t, converted, config(map[string]any{
+			"convert_to_managed": true,
+			"base_location":      "path/",
+			"comment":            "comment",
+		})))
+		require.True(t, requiresNew(t, converted, config(map[string]any{
+			"base_location": "path/",
+		})))
+	})
+}
+
+func TestStreamCustomizeDiff(t *testing.T) {
+	t.Run("on table not fully qualified", func(t *testing.T) {
+		err := planResource(t, Stream(), nil, map[string]any{
+			"name":     "STREAM",
+			"database": "DB",
+			"schema":   "SCHEMA",
+			"on_table": "TABLE",
+		})
+		requireErrorAtPath(t, err, "on_table", "expected a fully qualified identifier <database>.<schema>.<name>, got TABLE")
+	})
+
+	t.Run("on stage not fully qualified", func(t *testing.T) {
+		err := planResource(t, Stream(), nil, map[string]any{
+			"name":     "STREAM",
+			"database": "DB",
+			"schema":   "SCHEMA",
+			"on_stage": "DB.STAGE",
+		})
+		requireErrorAtPath(t, err, "on_stage", "expected a fully qualified identifier <database>.<schema>.<name>, got DB.STAGE")
+	})
+
+	t.Run("valid on table", func(t *testing.T) {
+		err := planResource(t, Stream(), nil, map[string]any{
+			"name":        "STREAM",
+			"database":    "DB",
+			"schema":      "SCHEMA",
+			"on_table":    "DB.SCHEMA.TABLE",
+			"append_only": true,
+		})
+		require.NoError(t, err)
+	})
+}
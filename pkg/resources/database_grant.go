@@ -86,7 +86,11 @@ func DatabaseGrant() *TerraformGrantResource {
 			Delete: DeleteDatabaseGrant,
 			Update: UpdateDatabaseGrant,
 
-			Schema: databaseGrantSchema,
+			Schema:        databaseGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(databaseGrantSchema, "database_name", "privilege", "with_grant_option", "roles", "shares"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
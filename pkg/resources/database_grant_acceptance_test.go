@@ -38,9 +38,9 @@ func TestAcc_DatabaseGrant(t *testing.T) {
 	shareName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: databaseGrantConfig(roleName, shareName, acc.TestDatabaseName),
@@ -73,7 +73,7 @@ func TestAcc_DatabaseGrant(t *testing.T) {
 // 	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 // 	resource.ParallelTest(t, resource.TestCase{
-// 		Providers: providers(),
+// 		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
 // 		Steps: []resource.TestStep{
 // 			{
 // 				// Note the DB we're trying to grant to doesn't exist
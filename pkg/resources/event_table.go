@@ -0,0 +1,400 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the event table; must be unique for the database and schema in which the event table is created.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the event table.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the event table.",
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the event table.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(0, 90),
+		Description:  "Specifies the retention period for the event table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the event table.",
+	},
+	"max_data_extension_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(0, 90),
+		Description:  "Specifies the maximum number of days for which Snowflake can extend the data retention period for the event table to prevent streams on the event table from becoming stale.",
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the event table.",
+	},
+	"default_ddl_collation": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies a default collation specification for any new columns added to the event table.",
+	},
+	"copy_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies to retain the access privileges from the original table when an event table is recreated using the CREATE OR REPLACE variant.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	"row_access_policy": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies a row access policy to attach to the event table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Fully qualified name of the row access policy in the form of database.schema.policy_name.",
+				},
+				"on": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "Defines which columns will be passed to the row access policy.",
+				},
+			},
+		},
+	},
+	"associate_with_account": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the event table is set as the active event table of the current account (ALTER ACCOUNT SET EVENT_TABLE). Only one event table can be associated with the account at a time.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the event table.",
+	},
+	"tag": tagReferenceSchema,
+}
+
+// EventTable returns a pointer to the resource representing an event table.
+func EventTable() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateEventTable,
+		Read:   ReadEventTable,
+		Update: UpdateEventTable,
+		Delete: DeleteEventTable,
+
+		Schema: eventTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateEventTable implements schema.CreateFunc.
+func CreateEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateEventTableRequest(id)
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]interface{})))
+	}
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		request.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	}
+	if v, ok := d.GetOk("max_data_extension_time_in_days"); ok {
+		request.WithMaxDataExtensionTimeInDays(sdk.Int(v.(int)))
+	}
+	if v, ok := d.GetOk("change_tracking"); ok && v.(bool) {
+		request.WithChangeTracking(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("default_ddl_collation"); ok {
+		request.WithDefaultDdlCollation(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("copy_grants"); ok && v.(bool) {
+		request.WithCopyGrants(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("row_access_policy"); ok {
+		policyName, on := eventTableRowAccessPolicy(v.([]interface{}))
+		request.WithRowAccessPolicy(&sdk.TableRowAccessPolicy{
+			Name: policyName,
+			On:   on,
+		})
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	if err := client.EventTables.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating event table %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if d.Get("associate_with_account").(bool) {
+		if err := client.Parameters.SetAccountParameter(ctx, sdk.AccountParameterEventTable, id.FullyQualifiedName()); err != nil {
+			return fmt.Errorf("error associating event table %v with the account err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	return ReadEventTable(d, meta)
+}
+
+// ReadEventTable implements schema.ReadFunc.
+func ReadEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	eventTable, err := client.EventTables.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] event table (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// clustering, retention and change tracking are only returned by SHOW TABLES, which lists event tables as well
+	table, err := client.Tables.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	maxDataExtensionTime, err := client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameterMaxDataExtensionTimeInDays, sdk.Object{
+		ObjectType: sdk.ObjectTypeTable,
+		Name:       id,
+	})
+	if err != nil {
+		return err
+	}
+	accountEventTable, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameterEventTable)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":                            eventTable.Name,
+		"database":                        eventTable.DatabaseName,
+		"schema":                          eventTable.SchemaName,
+		"owner":                           eventTable.Owner,
+		"comment":                         eventTable.Comment,
+		"cluster_by":                      snowflake.ClusterStatementToList(table.ClusterBy),
+		"data_retention_time_in_days":     table.RetentionTime,
+		"max_data_extension_time_in_days": sdk.ToInt(maxDataExtensionTime.Value),
+		"change_tracking":                 table.ChangeTracking,
+		"associate_with_account":          isAccountEventTable(accountEventTable.Value, id),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateEventTable implements schema.UpdateFunc.
+func UpdateEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewEventTableSetRequest(), sdk.NewEventTableUnsetRequest()
+	var runSet, runUnset bool
+	if d.HasChange("data_retention_time_in_days") {
+		if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+			set.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+			runSet = true
+		}
+	}
+	if d.HasChange("max_data_extension_time_in_days") {
+		if v, ok := d.GetOk("max_data_extension_time_in_days"); ok {
+			set.WithMaxDataExtensionTimeInDays(sdk.Int(v.(int)))
+			runSet = true
+		}
+	}
+	if d.HasChange("change_tracking") {
+		set.WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating event table %v err = %w", d.Id(), err)
+		}
+	}
+	if runUnset {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating event table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		clusteringAction := sdk.NewEventTableClusteringActionRequest()
+		if clusterBy := expandStringList(d.Get("cluster_by").([]interface{})); len(clusterBy) > 0 {
+			clusteringAction.WithClusterBy(&clusterBy)
+		} else {
+			clusteringAction.WithDropClusteringKey(sdk.Bool(true))
+		}
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithClusteringAction(clusteringAction)); err != nil {
+			return fmt.Errorf("error updating clustering key on event table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("row_access_policy") {
+		o, n := d.GetChange("row_access_policy")
+		oldPolicy, newPolicy := o.([]interface{}), n.([]interface{})
+		request := sdk.NewAlterEventTableRequest(id)
+		switch {
+		case len(oldPolicy) > 0 && len(newPolicy) > 0:
+			oldPolicyName, _ := eventTableRowAccessPolicy(oldPolicy)
+			newPolicyName, on := eventTableRowAccessPolicy(newPolicy)
+			request.WithDropAndAddRowAccessPolicy(sdk.NewEventTableDropAndAddRowAccessPolicyRequest(
+				*sdk.NewEventTableDropRowAccessPolicyRequest(oldPolicyName),
+				*sdk.NewEventTableAddRowAccessPolicyRequest(newPolicyName, on),
+			))
+		case len(oldPolicy) > 0:
+			oldPolicyName, _ := eventTableRowAccessPolicy(oldPolicy)
+			request.WithDropRowAccessPolicy(sdk.NewEventTableDropRowAccessPolicyRequest(oldPolicyName))
+		default:
+			newPolicyName, on := eventTableRowAccessPolicy(newPolicy)
+			request.WithAddRowAccessPolicy(sdk.NewEventTableAddRowAccessPolicyRequest(newPolicyName, on))
+		}
+		if err := client.EventTables.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error updating row access policy on event table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error occurred when dropping tags on %v, err = %w", d.Id(), err)
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error occurred when setting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("associate_with_account") {
+		var err error
+		if d.Get("associate_with_account").(bool) {
+			err = client.Parameters.SetAccountParameter(ctx, sdk.AccountParameterEventTable, id.FullyQualifiedName())
+		} else {
+			err = unsetAccountEventTable(ctx, client)
+		}
+		if err != nil {
+			return fmt.Errorf("error updating account association of event table %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadEventTable(d, meta)
+}
+
+// DeleteEventTable implements schema.DeleteFunc.
+func DeleteEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	// the account must not keep pointing to a dropped event table
+	if d.Get("associate_with_account").(bool) {
+		if err := unsetAccountEventTable(ctx, client); err != nil {
+			return fmt.Errorf("error removing account association of event table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if err := client.EventTables.Drop(ctx, sdk.NewDropEventTableRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func eventTableRowAccessPolicy(v []interface{}) (sdk.SchemaObjectIdentifier, []string) {
+	policy := v[0].(map[string]interface{})
+	policyName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy["policy_name"].(string))
+	return policyName, expandStringList(policy["on"].([]interface{}))
+}
+
+func unsetAccountEventTable(ctx context.Context, client *sdk.Client) error {
+	return client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			Parameters: &sdk.AccountLevelParametersUnset{
+				AccountParameters: &sdk.AccountParametersUnset{
+					EventTable: sdk.Bool(true),
+				},
+			},
+		},
+	})
+}
+
+// isAccountEventTable reports whether the EVENT_TABLE account parameter points to the given event table;
+// the parameter holds the name the way it was set, so quotes and case are ignored in the comparison.
+func isAccountEventTable(value string, id sdk.SchemaObjectIdentifier) bool {
+	return strings.EqualFold(strings.ReplaceAll(value, `"`, ""), strings.ReplaceAll(id.FullyQualifiedName(), `"`, ""))
+}
@@ -0,0 +1,87 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcc_EventTable(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_event_table.et"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckEventTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTableConfig(name, "some comment", 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+				),
+			},
+			{
+				Config: eventTableConfig(name, "other comment", 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "2"),
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "true"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_grants"},
+			},
+		},
+	})
+}
+
+func eventTableConfig(name string, comment string, dataRetentionTimeInDays int, changeTracking bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_event_table" "et" {
+	database                    = "%s"
+	schema                      = "%s"
+	name                        = "%s"
+	comment                     = "%s"
+	data_retention_time_in_days = %d
+	change_tracking             = %t
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, comment, dataRetentionTimeInDays, changeTracking)
+}
+
+func testAccCheckEventTableDestroy(s *terraform.State) error {
+	db := acc.TestAccProvider.Meta().(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_event_table" {
+			continue
+		}
+		ctx := context.Background()
+		id := sdk.NewSchemaObjectIdentifier(rs.Primary.Attributes["database"], rs.Primary.Attributes["schema"], rs.Primary.Attributes["name"])
+		eventTable, err := client.EventTables.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("event table %v still exists", eventTable.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAccountEventTable(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "events")
+
+	require.True(t, isAccountEventTable(`"db"."schema"."events"`, id))
+	require.True(t, isAccountEventTable("DB.SCHEMA.EVENTS", id))
+	require.False(t, isAccountEventTable("db.schema.other", id))
+	require.False(t, isAccountEventTable("", id))
+}
@@ -0,0 +1,242 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the external access integration; must be unique in the account.",
+	},
+	"allowed_network_rules": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Specifies the fully qualified names of the network rules with EGRESS mode that define the external network locations the integration allows access to.",
+	},
+	"allowed_api_authentication_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the names of the security integrations whose OAuth authorization server issued the secrets used by the code.",
+	},
+	"allowed_authentication_secrets": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the secrets that the code using this integration is allowed to use.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether the external access integration is enabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+}
+
+// ExternalAccessIntegration returns a pointer to the resource representing an external access integration.
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateExternalAccessIntegration,
+		Read:   ReadExternalAccessIntegration,
+		Update: UpdateExternalAccessIntegration,
+		Delete: DeleteExternalAccessIntegration,
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getSchemaObjectIdentifierList(from any) []sdk.SchemaObjectIdentifier {
+	names := expandStringList(from.(*schema.Set).List())
+	ids := make([]sdk.SchemaObjectIdentifier, len(names))
+	for i, name := range names {
+		ids[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name)
+	}
+	return ids
+}
+
+func getAccountObjectIdentifierList(from any) []sdk.AccountObjectIdentifier {
+	names := expandStringList(from.(*schema.Set).List())
+	ids := make([]sdk.AccountObjectIdentifier, len(names))
+	for i, name := range names {
+		ids[i] = sdk.NewAccountObjectIdentifier(name)
+	}
+	return ids
+}
+
+// CreateExternalAccessIntegration implements schema.CreateFunc.
+func CreateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+	request := sdk.NewCreateExternalAccessIntegrationRequest(id, getSchemaObjectIdentifierList(d.Get("allowed_network_rules")), d.Get("enabled").(bool))
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		request.WithAllowedApiAuthenticationIntegrations(getAccountObjectIdentifierList(v))
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		request.WithAllowedAuthenticationSecrets(getSchemaObjectIdentifierList(v))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.ExternalAccessIntegrations.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating external access integration %v err = %w", id.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// ReadExternalAccessIntegration implements schema.ReadFunc.
+func ReadExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] external access integration (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error describing external access integration %v err = %w", d.Id(), err)
+	}
+
+	// identifiers are returned unquoted, so they are normalized to the form produced by the qualified_name attributes
+	allowedNetworkRules := make([]string, 0)
+	allowedApiAuthenticationIntegrations := make([]string, 0)
+	allowedAuthenticationSecrets := make([]string, 0)
+	for _, property := range properties {
+		switch property.Property {
+		case "ALLOWED_NETWORK_RULES":
+			for _, name := range sdk.ParseCommaSeparatedStringArray(property.PropertyValue, false) {
+				allowedNetworkRules = append(allowedNetworkRules, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name).FullyQualifiedName())
+			}
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			allowedApiAuthenticationIntegrations = append(allowedApiAuthenticationIntegrations, sdk.ParseCommaSeparatedStringArray(property.PropertyValue, false)...)
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			for _, name := range sdk.ParseCommaSeparatedStringArray(property.PropertyValue, false) {
+				allowedAuthenticationSecrets = append(allowedAuthenticationSecrets, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name).FullyQualifiedName())
+			}
+		}
+	}
+
+	values := map[string]any{
+		"name":                  integration.Name,
+		"allowed_network_rules": allowedNetworkRules,
+		"allowed_api_authentication_integrations": allowedApiAuthenticationIntegrations,
+		"allowed_authentication_secrets":          allowedAuthenticationSecrets,
+		"enabled":                                 integration.Enabled,
+		"comment":                                 integration.Comment,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateExternalAccessIntegration implements schema.UpdateFunc.
+func UpdateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewExternalAccessIntegrationSetRequest(), sdk.NewExternalAccessIntegrationUnsetRequest()
+	var runSet, runUnset bool
+	if d.HasChange("allowed_network_rules") {
+		set.WithAllowedNetworkRules(getSchemaObjectIdentifierList(d.Get("allowed_network_rules")))
+		runSet = true
+	}
+	if d.HasChange("allowed_api_authentication_integrations") {
+		if integrations := getAccountObjectIdentifierList(d.Get("allowed_api_authentication_integrations")); len(integrations) > 0 {
+			set.WithAllowedApiAuthenticationIntegrations(integrations)
+			runSet = true
+		} else {
+			unset.WithAllowedApiAuthenticationIntegrations(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("allowed_authentication_secrets") {
+		if secrets := getSchemaObjectIdentifierList(d.Get("allowed_authentication_secrets")); len(secrets) > 0 {
+			set.WithAllowedAuthenticationSecrets(secrets)
+			runSet = true
+		} else {
+			unset.WithAllowedAuthenticationSecrets(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("enabled") {
+		set.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			set.WithComment(sdk.String(comment))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating external access integration %v err = %w", d.Id(), err)
+		}
+	}
+	if runUnset {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating external access integration %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// DeleteExternalAccessIntegration implements schema.DeleteFunc.
+func DeleteExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
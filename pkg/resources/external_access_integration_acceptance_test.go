@@ -0,0 +1,87 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ExternalAccessIntegration(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_external_access_integration.eai"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: externalAccessIntegrationConfig(name, false, true, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a test resource"),
+				),
+			},
+			{
+				Config: externalAccessIntegrationConfig(name, true, false, "this is a changed test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_authentication_secrets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a changed test resource"),
+				),
+			},
+			// unsets the secrets
+			{
+				Config: externalAccessIntegrationConfig(name, false, false, "this is a changed test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_authentication_secrets.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func externalAccessIntegrationConfig(name string, withSecret bool, enabled bool, comment string) string {
+	secrets := ""
+	if withSecret {
+		secrets = "allowed_authentication_secrets = [snowflake_secret.s.qualified_name]"
+	}
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "nr" {
+	database   = "%[1]s"
+	schema     = "%[2]s"
+	name       = "%[3]s"
+	type       = "HOST_PORT"
+	mode       = "EGRESS"
+	value_list = ["example.com"]
+}
+
+resource "snowflake_secret" "s" {
+	database      = "%[1]s"
+	schema        = "%[2]s"
+	name          = "%[3]s"
+	secret_type   = "GENERIC_STRING"
+	secret_string = "secret"
+}
+
+resource "snowflake_external_access_integration" "eai" {
+	name                  = "%[3]s"
+	allowed_network_rules = [snowflake_network_rule.nr.qualified_name]
+	%[4]s
+	enabled               = %[5]t
+	comment               = "%[6]s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, secrets, enabled, comment)
+}
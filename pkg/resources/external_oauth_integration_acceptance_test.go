@@ -18,9 +18,9 @@ func TestAcc_ExternalOauthIntegration(t *testing.T) {
 	issuer := fmt.Sprintf("https://sts.windows.net/%s", uuid.NewString())
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: externalOauthIntegrationConfig(oauthIntName, integrationType, issuer, "test resource"),
@@ -51,9 +51,9 @@ func TestAcc_ExternalOauthIntegrationEmptyComment(t *testing.T) {
 	issuer := fmt.Sprintf("https://sts.windows.net/%s", uuid.NewString())
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: externalOauthIntegrationConfig(oauthIntName, integrationType, issuer, ""),
@@ -84,9 +84,9 @@ func TestAcc_ExternalOauthIntegrationLowercaseName(t *testing.T) {
 	issuer := fmt.Sprintf("https://sts.windows.net/%s", uuid.NewString())
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: externalOauthIntegrationConfig(oauthIntName, integrationType, issuer, "test resource"),
@@ -117,9 +117,9 @@ func TestAcc_ExternalOauthIntegrationCustom(t *testing.T) {
 	issuer := fmt.Sprintf("https://sts.windows.net/%s", uuid.NewString())
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
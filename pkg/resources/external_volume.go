@@ -0,0 +1,390 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var externalVolumeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the external volume; must be unique for the account.",
+	},
+	"storage_location": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "Set of named cloud storage locations in different regions and, optionally, cloud platforms. Locations are matched by name: a new name adds a storage location, a removed name removes it and a changed location is removed and added again, so the only storage location of the external volume cannot be changed in place; add the new location under another name instead.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the storage location; must be unique for the external volume.",
+				},
+				"storage_provider": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(sdk.ExternalVolumeStorageProviderS3),
+						string(sdk.ExternalVolumeStorageProviderS3Gov),
+						string(sdk.ExternalVolumeStorageProviderGCS),
+						string(sdk.ExternalVolumeStorageProviderAzure),
+					}, true),
+					DiffSuppressFunc: ignoreCaseSuppressFunc,
+					Description:      "Specifies the cloud storage provider that stores the data files (S3, S3GOV, GCS or AZURE).",
+				},
+				"storage_base_url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the base URL for the cloud storage location.",
+				},
+				"storage_aws_role_arn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the Amazon Resource Name (ARN) of the AWS IAM role that grants privileges on the S3 bucket containing the data files.",
+				},
+				"storage_aws_external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies an external ID that Snowflake uses to establish a trust relationship with AWS. When not set, Snowflake generates one.",
+				},
+				"azure_tenant_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the ID for the Office 365 tenant that the allowed and blocked storage accounts belong to.",
+				},
+				"encryption_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the type of server-side encryption used for the data files (e.g. AWS_SSE_S3, AWS_SSE_KMS, GCS_SSE_KMS or NONE).",
+				},
+				"encryption_kms_key_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the ID of the cloud provider key used to encrypt the data files.",
+				},
+			},
+		},
+	},
+	"allow_writes": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether write operations are allowed for the external volume; must be set to true for Iceberg tables that use Snowflake as the catalog.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external volume.",
+	},
+}
+
+// ExternalVolume returns a pointer to the resource representing an external volume.
+func ExternalVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateExternalVolume,
+		Read:   ReadExternalVolume,
+		Update: UpdateExternalVolume,
+		Delete: DeleteExternalVolume,
+
+		Schema:        externalVolumeSchema,
+		CustomizeDiff: customizeExternalVolumeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type externalVolumeStorageLocation struct {
+	Name                 string `json:"NAME"`
+	StorageProvider      string `json:"STORAGE_PROVIDER"`
+	StorageBaseUrl       string `json:"STORAGE_BASE_URL"`
+	StorageAwsRoleArn    string `json:"STORAGE_AWS_ROLE_ARN"`
+	StorageAwsExternalId string `json:"STORAGE_AWS_EXTERNAL_ID"`
+	AzureTenantId        string `json:"AZURE_TENANT_ID"`
+	EncryptionType       string `json:"ENCRYPTION_TYPE"`
+	EncryptionKmsKeyId   string `json:"ENCRYPTION_KMS_KEY_ID"`
+}
+
+func (l externalVolumeStorageLocation) toRequest() *sdk.ExternalVolumeStorageLocationRequest {
+	request := sdk.NewExternalVolumeStorageLocationRequest(l.Name, sdk.ExternalVolumeStorageProvider(l.StorageProvider), l.StorageBaseUrl)
+	if l.StorageAwsRoleArn != "" {
+		request.WithStorageAwsRoleArn(sdk.String(l.StorageAwsRoleArn))
+	}
+	if l.StorageAwsExternalId != "" {
+		request.WithStorageAwsExternalId(sdk.String(l.StorageAwsExternalId))
+	}
+	if l.AzureTenantId != "" {
+		request.WithAzureTenantId(sdk.String(l.AzureTenantId))
+	}
+	if l.EncryptionType != "" {
+		encryption := sdk.NewExternalVolumeStorageLocationEncryptionRequest(l.EncryptionType)
+		if l.EncryptionKmsKeyId != "" {
+			encryption.WithKmsKeyId(sdk.String(l.EncryptionKmsKeyId))
+		}
+		request.WithEncryption(encryption)
+	}
+	return request
+}
+
+type externalVolumeStorageLocations []externalVolumeStorageLocation
+
+func (locations externalVolumeStorageLocations) byName(name string) (externalVolumeStorageLocation, bool) {
+	for _, l := range locations {
+		if l.Name == name {
+			return l, true
+		}
+	}
+	return externalVolumeStorageLocation{}, false
+}
+
+// diffs returns the storage locations that have to be removed from and added to the external volume to reach new.
+// A changed location is both removed and added, because Snowflake cannot alter a storage location in place.
+func (locations externalVolumeStorageLocations) diffs(new externalVolumeStorageLocations) (removed []string, added externalVolumeStorageLocations) {
+	for _, l := range locations {
+		if n, ok := new.byName(l.Name); !ok || n != l {
+			removed = append(removed, l.Name)
+		}
+	}
+	for _, n := range new {
+		if l, ok := locations.byName(n.Name); !ok || l != n {
+			added = append(added, n)
+		}
+	}
+	return removed, added
+}
+
+// validateUpdate returns an error when the update from the locations to new would leave the external volume without
+// any storage location. The changed locations keep their names, so they are removed before being added again, and
+// Snowflake neither renames a storage location nor removes the last one.
+func (locations externalVolumeStorageLocations) validateUpdate(new externalVolumeStorageLocations) error {
+	removed, added := locations.diffs(new)
+	remaining := len(locations) - len(removed)
+	var changed []string
+	for _, l := range added {
+		if _, ok := locations.byName(l.Name); ok {
+			changed = append(changed, l.Name)
+		} else {
+			remaining++
+		}
+	}
+	if remaining > 0 || len(changed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("storage location %s cannot be changed in place, because the external volume would be left without any storage location; add the new location under another name instead", strings.Join(changed, ", "))
+}
+
+// customizeExternalVolumeDiff rejects during plan the storage location changes that UpdateExternalVolume cannot apply.
+func customizeExternalVolumeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange("storage_location") || !d.NewValueKnown("storage_location") {
+		return nil
+	}
+	o, n := d.GetChange("storage_location")
+	return getExternalVolumeStorageLocations(o).validateUpdate(getExternalVolumeStorageLocations(n))
+}
+
+func getExternalVolumeStorageLocations(from any) externalVolumeStorageLocations {
+	locations := from.([]any)
+	to := make(externalVolumeStorageLocations, len(locations))
+	for i, l := range locations {
+		v := l.(map[string]any)
+		to[i] = externalVolumeStorageLocation{
+			Name:                 v["name"].(string),
+			StorageProvider:      strings.ToUpper(v["storage_provider"].(string)),
+			StorageBaseUrl:       v["storage_base_url"].(string),
+			StorageAwsRoleArn:    v["storage_aws_role_arn"].(string),
+			StorageAwsExternalId: v["storage_aws_external_id"].(string),
+			AzureTenantId:        v["azure_tenant_id"].(string),
+			EncryptionType:       v["encryption_type"].(string),
+			EncryptionKmsKeyId:   v["encryption_kms_key_id"].(string),
+		}
+	}
+	return to
+}
+
+// flattenExternalVolumeStorageLocations maps the STORAGE_LOCATION_<n> properties returned by DESCRIBE EXTERNAL VOLUME to the
+// storage_location list. Values which Snowflake fills in on its own (the external id and encryption) are only read back
+// when they are already tracked in the state.
+func flattenExternalVolumeStorageLocations(properties []sdk.ExternalVolumeProperty, current externalVolumeStorageLocations) ([]map[string]any, error) {
+	var locations []map[string]any
+	for _, p := range properties {
+		if !strings.HasPrefix(p.Property, "STORAGE_LOCATION_") {
+			continue
+		}
+		var l externalVolumeStorageLocation
+		if err := json.Unmarshal([]byte(p.PropertyValue), &l); err != nil {
+			return nil, fmt.Errorf("error parsing external volume property %s: %w", p.Property, err)
+		}
+		location := map[string]any{
+			"name":                    l.Name,
+			"storage_provider":        l.StorageProvider,
+			"storage_base_url":        l.StorageBaseUrl,
+			"storage_aws_role_arn":    l.StorageAwsRoleArn,
+			"storage_aws_external_id": "",
+			"azure_tenant_id":         l.AzureTenantId,
+			"encryption_type":         "",
+			"encryption_kms_key_id":   "",
+		}
+		if c, ok := current.byName(l.Name); ok {
+			if c.StorageAwsExternalId != "" {
+				location["storage_aws_external_id"] = l.StorageAwsExternalId
+			}
+			if c.EncryptionType != "" {
+				location["encryption_type"] = l.EncryptionType
+				location["encryption_kms_key_id"] = l.EncryptionKmsKeyId
+			}
+		}
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
+
+// CreateExternalVolume implements schema.CreateFunc.
+func CreateExternalVolume(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+	locations := getExternalVolumeStorageLocations(d.Get("storage_location"))
+	storageLocations := make([]sdk.ExternalVolumeStorageLocationRequest, len(locations))
+	for i, l := range locations {
+		storageLocations[i] = *l.toRequest()
+	}
+	request := sdk.NewCreateExternalVolumeRequest(id, storageLocations).
+		WithAllowWrites(sdk.Bool(d.Get("allow_writes").(bool)))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.ExternalVolumes.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating external volume %v err = %w", id.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadExternalVolume(d, meta)
+}
+
+// ReadExternalVolume implements schema.ReadFunc.
+func ReadExternalVolume(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] external volume (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	properties, err := client.ExternalVolumes.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error describing external volume %v err = %w", d.Id(), err)
+	}
+	storageLocations, err := flattenExternalVolumeStorageLocations(properties, getExternalVolumeStorageLocations(d.Get("storage_location")))
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":             externalVolume.Name,
+		"storage_location": storageLocations,
+		"allow_writes":     externalVolume.AllowWrites,
+		"comment":          externalVolume.Comment,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateExternalVolume implements schema.UpdateFunc.
+func UpdateExternalVolume(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChange("storage_location") {
+		o, n := d.GetChange("storage_location")
+		oldLocations := getExternalVolumeStorageLocations(o)
+		removed, added := oldLocations.diffs(getExternalVolumeStorageLocations(n))
+		// new locations are added before the old ones are removed, because an external volume cannot be left without any storage location
+		for _, l := range added {
+			if _, ok := oldLocations.byName(l.Name); ok {
+				continue
+			}
+			if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithAddStorageLocation(l.toRequest())); err != nil {
+				return fmt.Errorf("error adding storage location %s to external volume %v err = %w", l.Name, d.Id(), err)
+			}
+		}
+		for _, name := range removed {
+			if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithRemoveStorageLocation(sdk.String(name))); err != nil {
+				return fmt.Errorf("error removing storage location %s from external volume %v err = %w", name, d.Id(), err)
+			}
+		}
+		for _, l := range added {
+			if _, ok := oldLocations.byName(l.Name); !ok {
+				continue
+			}
+			if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithAddStorageLocation(l.toRequest())); err != nil {
+				return fmt.Errorf("error adding storage location %s to external volume %v err = %w", l.Name, d.Id(), err)
+			}
+		}
+	}
+
+	set := sdk.NewExternalVolumeSetRequest()
+	var runSet bool
+	if d.HasChange("allow_writes") {
+		set.WithAllowWrites(sdk.Bool(d.Get("allow_writes").(bool)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		set.WithComment(sdk.String(d.Get("comment").(string)))
+		runSet = true
+	}
+	if runSet {
+		if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating external volume %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadExternalVolume(d, meta)
+}
+
+// DeleteExternalVolume implements schema.DeleteFunc.
+func DeleteExternalVolume(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ExternalVolumes.Drop(ctx, sdk.NewDropExternalVolumeRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
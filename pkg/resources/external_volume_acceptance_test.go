@@ -0,0 +1,101 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// externalVolumeTestBucket returns the bucket and role used by the external table tests, which the external volume
+// and Iceberg table tests share.
+func externalVolumeTestBucket(t *testing.T) (string, string) {
+	t.Helper()
+	bucketURL := os.Getenv("AWS_EXTERNAL_BUCKET_URL")
+	roleName := os.Getenv("AWS_EXTERNAL_ROLE_NAME")
+	if bucketURL == "" || roleName == "" {
+		t.Skip("Skipping test, AWS_EXTERNAL_BUCKET_URL and AWS_EXTERNAL_ROLE_NAME have to be set")
+	}
+	return bucketURL, roleName
+}
+
+func TestAcc_ExternalVolume(t *testing.T) {
+	bucketURL, roleName := externalVolumeTestBucket(t)
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_external_volume.v"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: externalVolumeConfig(name, bucketURL, roleName, false, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.storage_provider", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.storage_base_url", bucketURL),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.storage_aws_role_arn", roleName),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+				),
+			},
+			// adds a second storage location
+			{
+				Config: externalVolumeConfig(name, bucketURL, roleName, true, "other comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "storage_location.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.1.name", "second"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.1.storage_base_url", bucketURL+"second/"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+				),
+			},
+			// removes it again
+			{
+				Config: externalVolumeConfig(name, bucketURL, roleName, false, "other comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "storage_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.name", "first"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func externalVolumeConfig(name string, bucketURL string, roleName string, secondLocation bool, comment string) string {
+	second := ""
+	if secondLocation {
+		second = fmt.Sprintf(`
+	storage_location {
+		name                 = "second"
+		storage_provider     = "S3"
+		storage_base_url     = "%[1]ssecond/"
+		storage_aws_role_arn = "%[2]s"
+	}`, bucketURL, roleName)
+	}
+	return fmt.Sprintf(`
+resource "snowflake_external_volume" "v" {
+	name    = "%[1]s"
+	comment = "%[4]s"
+
+	storage_location {
+		name                 = "first"
+		storage_provider     = "S3"
+		storage_base_url     = "%[2]s"
+		storage_aws_role_arn = "%[3]s"
+	}
+%[5]s
+}
+`, name, bucketURL, roleName, comment, second)
+}
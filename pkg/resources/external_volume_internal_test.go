@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalVolumeStorageLocationsDiffs(t *testing.T) {
+	s3 := externalVolumeStorageLocation{Name: "s3", StorageProvider: "S3", StorageBaseUrl: "s3://bucket/"}
+	gcs := externalVolumeStorageLocation{Name: "gcs", StorageProvider: "GCS", StorageBaseUrl: "gcs://bucket/"}
+	movedS3 := externalVolumeStorageLocation{Name: "s3", StorageProvider: "S3", StorageBaseUrl: "s3://other-bucket/"}
+
+	t.Run("unchanged", func(t *testing.T) {
+		removed, added := externalVolumeStorageLocations{s3, gcs}.diffs(externalVolumeStorageLocations{s3, gcs})
+		require.Empty(t, removed)
+		require.Empty(t, added)
+	})
+
+	t.Run("added and removed", func(t *testing.T) {
+		removed, added := externalVolumeStorageLocations{s3}.diffs(externalVolumeStorageLocations{gcs})
+		require.Equal(t, []string{"s3"}, removed)
+		require.Equal(t, externalVolumeStorageLocations{gcs}, added)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		removed, added := externalVolumeStorageLocations{s3, gcs}.diffs(externalVolumeStorageLocations{movedS3, gcs})
+		require.Equal(t, []string{"s3"}, removed)
+		require.Equal(t, externalVolumeStorageLocations{movedS3}, added)
+	})
+}
+
+func TestExternalVolumeStorageLocationsValidateUpdate(t *testing.T) {
+	s3 := externalVolumeStorageLocation{Name: "s3", StorageProvider: "S3", StorageBaseUrl: "s3://bucket/"}
+	gcs := externalVolumeStorageLocation{Name: "gcs", StorageProvider: "GCS", StorageBaseUrl: "gcs://bucket/"}
+	movedS3 := externalVolumeStorageLocation{Name: "s3", StorageProvider: "S3", StorageBaseUrl: "s3://other-bucket/"}
+
+	require.NoError(t, externalVolumeStorageLocations{s3}.validateUpdate(externalVolumeStorageLocations{gcs}))
+	require.NoError(t, externalVolumeStorageLocations{s3, gcs}.validateUpdate(externalVolumeStorageLocations{movedS3, gcs}))
+	require.NoError(t, externalVolumeStorageLocations{s3}.validateUpdate(externalVolumeStorageLocations{movedS3, gcs}))
+	require.EqualError(t, externalVolumeStorageLocations{s3}.validateUpdate(externalVolumeStorageLocations{movedS3}), "storage location s3 cannot be changed in place, because the external volume would be left without any storage location; add the new location under another name instead")
+	require.ErrorContains(t, externalVolumeStorageLocations{s3, gcs}.validateUpdate(externalVolumeStorageLocations{movedS3}), "storage location s3 cannot be changed in place")
+}
+
+func TestFlattenExternalVolumeStorageLocations(t *testing.T) {
+	properties := []sdk.ExternalVolumeProperty{
+		{Property: "ALLOW_WRITES", PropertyValue: "true"},
+		{
+			ParentProperty: "STORAGE_LOCATIONS",
+			Property:       "STORAGE_LOCATION_1",
+			PropertyValue:  `{"NAME":"s3","STORAGE_PROVIDER":"S3","STORAGE_BASE_URL":"s3://bucket/","STORAGE_ALLOWED_LOCATIONS":["s3://bucket/*"],"STORAGE_AWS_ROLE_ARN":"arn:aws:iam::123456789012:role/role","STORAGE_AWS_IAM_USER_ARN":"arn:aws:iam::123456789012:user/user","STORAGE_AWS_EXTERNAL_ID":"generated","ENCRYPTION_TYPE":"NONE","ENCRYPTION_KMS_KEY_ID":""}`,
+		},
+		{
+			ParentProperty: "STORAGE_LOCATIONS",
+			Property:       "STORAGE_LOCATION_2",
+			PropertyValue:  `{"NAME":"gcs","STORAGE_PROVIDER":"GCS","STORAGE_BASE_URL":"gcs://bucket/","ENCRYPTION_TYPE":"GCS_SSE_KMS","ENCRYPTION_KMS_KEY_ID":"key"}`,
+		},
+		{Property: "ACTIVE", PropertyValue: "s3"},
+	}
+	current := externalVolumeStorageLocations{
+		{Name: "s3", StorageProvider: "S3", StorageBaseUrl: "s3://bucket/"},
+		{Name: "gcs", StorageProvider: "GCS", StorageBaseUrl: "gcs://bucket/", EncryptionType: "GCS_SSE_KMS"},
+	}
+
+	locations, err := flattenExternalVolumeStorageLocations(properties, current)
+	require.NoError(t, err)
+	require.Len(t, locations, 2)
+
+	require.Equal(t, "s3", locations[0]["name"])
+	require.Equal(t, "arn:aws:iam::123456789012:role/role", locations[0]["storage_aws_role_arn"])
+	require.Equal(t, "", locations[0]["storage_aws_external_id"])
+	require.Equal(t, "", locations[0]["encryption_type"])
+
+	require.Equal(t, "gcs", locations[1]["name"])
+	require.Equal(t, "GCS_SSE_KMS", locations[1]["encryption_type"])
+	require.Equal(t, "key", locations[1]["encryption_kms_key_id"])
+}
@@ -77,6 +77,10 @@ func FailoverGroupGrant() *TerraformGrantResource {
 			Delete:             DeleteFailoverGroupGrant,
 			Update:             UpdateFailoverGroupGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.", Schema: failoverGroupGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(failoverGroupGrantSchema, "failover_group_name", "privilege", "with_grant_option", "roles"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -8,12 +9,13 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var languages = []string{"javascript", "java", "sql", "python"}
+var languages = []string{"javascript", "java", "sql", "python", "scala"}
 
 var functionSchema = map[string]*schema.Schema{
 	"name": {
@@ -144,6 +146,35 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The target path for the Java / Python functions. For Java, it is the path of compiled jar files and for the Python it is the path of the Python files.",
 	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of the external access integrations that allow the Java / Python function to access external networks.",
+	},
+	"secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the variable that the handler code uses to retrieve the secret.",
+				},
+				"secret_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The fully qualified name of the secret, e.g. the qualified_name of a snowflake_secret resource.",
+				},
+			},
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Assigns the secrets, allowed by the external access integrations, to variables that the Java / Python function handler uses to retrieve them.",
+	},
 }
 
 // Function returns a pointer to the resource representing a stored function.
@@ -164,140 +195,360 @@ func Function() *schema.Resource {
 // CreateFunction implements schema.CreateFunc.
 func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
-	s := d.Get("statement").(string)
-	ret := d.Get("return_type").(string)
-
-	builder := snowflake.NewFunctionBuilder(database, schema, name, []string{}).WithStatement(s).WithReturnType(ret)
-
-	// Set optionals, args
-	if _, ok := d.GetOk("arguments"); ok {
-		args := []map[string]string{}
-		for _, arg := range d.Get("arguments").([]interface{}) {
-			argDef := map[string]string{}
-			for key, val := range arg.(map[string]interface{}) {
-				argDef[key] = val.(string)
-			}
-			args = append(args, argDef)
-		}
-		builder.WithArgs(args)
-	}
 
-	// Set optionals, default is false
-	if v, ok := d.GetOk("return_behavior"); ok {
-		builder.WithReturnBehavior(v.(string))
+	arguments, argumentTypes := getFunctionArguments(d)
+	functionID := &functionID{
+		DatabaseName: database,
+		SchemaName:   schema,
+		FunctionName: name,
+		ArgTypes:     argumentTypes,
+	}
+	id := functionID.SchemaObjectIdentifier()
+
+	var err error
+	switch language := strings.ToLower(d.Get("language").(string)); language {
+	case "java":
+		err = createJavaFunction(ctx, client, d, id, arguments)
+	case "javascript":
+		err = createJavascriptFunction(ctx, client, d, id, arguments)
+	case "python":
+		err = createPythonFunction(ctx, client, d, id, arguments)
+	case "scala":
+		err = createScalaFunction(ctx, client, d, id, arguments)
+	default:
+		err = createSQLFunction(ctx, client, d, id, arguments)
 	}
+	if err != nil {
+		return fmt.Errorf("error creating function %v err = %w", name, err)
+	}
+
+	d.SetId(functionID.String())
+
+	return ReadFunction(d, meta)
+}
 
-	// Set optionals, default is false
+func createJavaFunction(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, arguments []sdk.FunctionArgumentRequest) error {
+	handler, err := getFunctionHandler(d)
+	if err != nil {
+		return err
+	}
+	returns, err := getFunctionReturns(d)
+	if err != nil {
+		return err
+	}
+	request := sdk.NewCreateForJavaFunctionRequest(id.WithoutArguments(), *returns, handler).
+		WithOrReplace(sdk.Bool(true)).
+		WithArguments(arguments).
+		WithFunctionDefinition(sdk.String(d.Get("statement").(string)))
+	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
+		request.WithSecure(sdk.Bool(true))
+	}
 	if v, ok := d.GetOk("null_input_behavior"); ok {
-		builder.WithNullInputBehavior(v.(string))
+		request.WithNullInputBehavior(sdk.NullInputBehaviorPointer(sdk.NullInputBehavior(v.(string))))
 	}
-
-	// Set optionals, default is OWNER
-	if v, ok := d.GetOk("language"); ok {
-		builder.WithLanguage(v.(string))
+	if v, ok := d.GetOk("return_behavior"); ok {
+		request.WithReturnResultsBehavior(sdk.ReturnResultsBehaviorPointer(sdk.ReturnResultsBehavior(v.(string))))
 	}
-
-	// Set optionals, runtime version for Python
 	if v, ok := d.GetOk("runtime_version"); ok {
-		builder.WithRuntimeVersion(v.(string))
+		request.WithRuntimeVersion(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if _, ok := d.GetOk("imports"); ok {
+		request.WithImports(getFunctionImports(d))
+	}
+	if _, ok := d.GetOk("packages"); ok {
+		request.WithPackages(getFunctionPackages(d))
+	}
+	if v, ok := d.GetOk("target_path"); ok {
+		request.WithTargetPath(sdk.String(v.(string)))
 	}
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		request.WithExternalAccessIntegrations(getFunctionExternalAccessIntegrations(v))
+	}
+	if v, ok := d.GetOk("secrets"); ok {
+		request.WithSecrets(getFunctionSecrets(v))
+	}
+	return client.Functions.CreateForJava(ctx, request)
+}
 
-	// Set optionals, default is false
+func createJavascriptFunction(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, arguments []sdk.FunctionArgumentRequest) error {
+	returns, err := getFunctionReturns(d)
+	if err != nil {
+		return err
+	}
+	request := sdk.NewCreateForJavascriptFunctionRequest(id.WithoutArguments(), *returns, d.Get("statement").(string)).
+		WithOrReplace(sdk.Bool(true)).
+		WithArguments(arguments)
 	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
-		builder.WithSecure()
+		request.WithSecure(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("null_input_behavior"); ok {
+		request.WithNullInputBehavior(sdk.NullInputBehaviorPointer(sdk.NullInputBehavior(v.(string))))
+	}
+	if v, ok := d.GetOk("return_behavior"); ok {
+		request.WithReturnResultsBehavior(sdk.ReturnResultsBehaviorPointer(sdk.ReturnResultsBehavior(v.(string))))
 	}
-
 	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+		request.WithComment(sdk.String(v.(string)))
 	}
+	return client.Functions.CreateForJavascript(ctx, request)
+}
 
-	// Set optionals, packages for Java / Python
+func createPythonFunction(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, arguments []sdk.FunctionArgumentRequest) error {
+	handler, err := getFunctionHandler(d)
+	if err != nil {
+		return err
+	}
+	runtimeVersion, ok := d.GetOk("runtime_version")
+	if !ok {
+		return errors.New("runtime_version is required for Python functions")
+	}
+	returns, err := getFunctionReturns(d)
+	if err != nil {
+		return err
+	}
+	request := sdk.NewCreateForPythonFunctionRequest(id.WithoutArguments(), *returns, runtimeVersion.(string), handler).
+		WithOrReplace(sdk.Bool(true)).
+		WithArguments(arguments).
+		WithFunctionDefinition(sdk.String(d.Get("statement").(string)))
+	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
+		request.WithSecure(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("null_input_behavior"); ok {
+		request.WithNullInputBehavior(sdk.NullInputBehaviorPointer(sdk.NullInputBehavior(v.(string))))
+	}
+	if v, ok := d.GetOk("return_behavior"); ok {
+		request.WithReturnResultsBehavior(sdk.ReturnResultsBehaviorPointer(sdk.ReturnResultsBehavior(v.(string))))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if _, ok := d.GetOk("imports"); ok {
+		request.WithImports(getFunctionImports(d))
+	}
 	if _, ok := d.GetOk("packages"); ok {
-		packages := []string{}
-		for _, pack := range d.Get("packages").([]interface{}) {
-			packages = append(packages, pack.(string))
-		}
-		builder.WithPackages(packages)
+		request.WithPackages(getFunctionPackages(d))
 	}
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		request.WithExternalAccessIntegrations(getFunctionExternalAccessIntegrations(v))
+	}
+	if v, ok := d.GetOk("secrets"); ok {
+		request.WithSecrets(getFunctionSecrets(v))
+	}
+	return client.Functions.CreateForPython(ctx, request)
+}
 
-	// Set optionals, imports for Java / Python
+func createScalaFunction(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, arguments []sdk.FunctionArgumentRequest) error {
+	handler, err := getFunctionHandler(d)
+	if err != nil {
+		return err
+	}
+	returnType := sdk.DataType(strings.ToUpper(d.Get("return_type").(string)))
+	request := sdk.NewCreateForScalaFunctionRequest(id.WithoutArguments(), returnType, handler).
+		WithOrReplace(sdk.Bool(true)).
+		WithArguments(arguments).
+		WithFunctionDefinition(sdk.String(d.Get("statement").(string)))
+	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
+		request.WithSecure(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("null_input_behavior"); ok {
+		request.WithNullInputBehavior(sdk.NullInputBehaviorPointer(sdk.NullInputBehavior(v.(string))))
+	}
+	if v, ok := d.GetOk("return_behavior"); ok {
+		request.WithReturnResultsBehavior(sdk.ReturnResultsBehaviorPointer(sdk.ReturnResultsBehavior(v.(string))))
+	}
+	if v, ok := d.GetOk("runtime_version"); ok {
+		request.WithRuntimeVersion(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
 	if _, ok := d.GetOk("imports"); ok {
-		imports := []string{}
-		for _, imp := range d.Get("imports").([]interface{}) {
-			imports = append(imports, imp.(string))
-		}
-		builder.WithImports(imports)
+		request.WithImports(getFunctionImports(d))
 	}
-
-	// handler for Java / Python
-	if v, ok := d.GetOk("handler"); ok {
-		builder.WithHandler(v.(string))
+	if _, ok := d.GetOk("packages"); ok {
+		request.WithPackages(getFunctionPackages(d))
 	}
-
-	// target path for Java / Python
 	if v, ok := d.GetOk("target_path"); ok {
-		builder.WithTargetPath(v.(string))
+		request.WithTargetPath(sdk.String(v.(string)))
 	}
+	return client.Functions.CreateForScala(ctx, request)
+}
 
-	q, err := builder.Create()
+func createSQLFunction(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, arguments []sdk.FunctionArgumentRequest) error {
+	returns, err := getFunctionReturns(d)
 	if err != nil {
 		return err
 	}
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error creating function %v err = %w", name, err)
+	request := sdk.NewCreateForSQLFunctionRequest(id.WithoutArguments(), *returns, d.Get("statement").(string)).
+		WithOrReplace(sdk.Bool(true)).
+		WithArguments(arguments)
+	if v, ok := d.GetOk("is_secure"); ok && v.(bool) {
+		request.WithSecure(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("return_behavior"); ok {
+		request.WithReturnResultsBehavior(sdk.ReturnResultsBehaviorPointer(sdk.ReturnResultsBehavior(v.(string))))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
 	}
+	return client.Functions.CreateForSQL(ctx, request)
+}
 
-	functionID := &functionID{
-		DatabaseName: database,
-		SchemaName:   schema,
-		FunctionName: name,
-		ArgTypes:     builder.ArgTypes(),
+// getFunctionArguments returns the arguments of the function together with their types, which identify the function.
+func getFunctionArguments(d *schema.ResourceData) ([]sdk.FunctionArgumentRequest, []string) {
+	arguments := []sdk.FunctionArgumentRequest{}
+	argumentTypes := []string{}
+	for _, arg := range d.Get("arguments").([]interface{}) {
+		argDef := arg.(map[string]interface{})
+		argType := strings.ToUpper(argDef["type"].(string))
+		arguments = append(arguments, *sdk.NewFunctionArgumentRequest(argDef["name"].(string), sdk.DataType(argType)))
+		argumentTypes = append(argumentTypes, argType)
+	}
+	return arguments, argumentTypes
+}
+
+func getFunctionHandler(d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("handler"); ok {
+		return v.(string), nil
 	}
+	return "", fmt.Errorf("handler is required for %v functions", d.Get("language"))
+}
 
-	d.SetId(functionID.String())
+var functionReturnsTable = regexp.MustCompile(`(?is)^TABLE\s*\((.*)\)$`)
+
+// getFunctionReturns translates the return type, which is either a data type or a TABLE (<col_name> <col_data_type>, ...).
+func getFunctionReturns(d *schema.ResourceData) (*sdk.FunctionReturnsRequest, error) {
+	returnType := strings.TrimSpace(d.Get("return_type").(string))
+	match := functionReturnsTable.FindStringSubmatch(returnType)
+	if match == nil {
+		dataType := sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataType(strings.ToUpper(returnType)))
+		return sdk.NewFunctionReturnsRequest().WithResultDataType(dataType), nil
+	}
+	columns := []sdk.FunctionColumnRequest{}
+	for _, column := range splitTopLevel(match[1]) {
+		parts := strings.SplitN(strings.TrimSpace(column), " ", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid column %q of the returned table %v", column, returnType)
+		}
+		columns = append(columns, *sdk.NewFunctionColumnRequest(parts[0], sdk.DataType(strings.ToUpper(strings.TrimSpace(parts[1])))))
+	}
+	return sdk.NewFunctionReturnsRequest().WithTable(sdk.NewFunctionReturnsTableRequest().WithColumns(columns)), nil
+}
 
-	return ReadFunction(d, meta)
+// splitTopLevel splits the list on the commas outside of parentheses, e.g. "a NUMBER(38, 0), b VARCHAR".
+func splitTopLevel(list string) []string {
+	var parts []string
+	depth, from := 0, 0
+	for i, r := range list {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, list[from:i])
+				from = i + 1
+			}
+		}
+	}
+	if strings.TrimSpace(list[from:]) != "" {
+		parts = append(parts, list[from:])
+	}
+	return parts
+}
+
+func getFunctionImports(d *schema.ResourceData) []sdk.FunctionImportRequest {
+	imports := []sdk.FunctionImportRequest{}
+	for _, imp := range d.Get("imports").([]interface{}) {
+		imports = append(imports, *sdk.NewFunctionImportRequest(imp.(string)))
+	}
+	return imports
+}
+
+func getFunctionExternalAccessIntegrations(from any) []sdk.AccountObjectIdentifier {
+	integrations := []sdk.AccountObjectIdentifier{}
+	for _, name := range expandStringList(from.(*schema.Set).List()) {
+		integrations = append(integrations, sdk.NewAccountObjectIdentifier(name))
+	}
+	return integrations
+}
+
+func getFunctionSecrets(from any) []sdk.SecretReference {
+	secrets := []sdk.SecretReference{}
+	for _, secret := range from.(*schema.Set).List() {
+		secretDef := secret.(map[string]interface{})
+		secrets = append(secrets, sdk.SecretReference{
+			VariableName: secretDef["variable_name"].(string),
+			Name:         secretDef["secret_id"].(string),
+		})
+	}
+	return secrets
+}
+
+func getFunctionPackages(d *schema.ResourceData) []sdk.FunctionPackageRequest {
+	packages := []sdk.FunctionPackageRequest{}
+	for _, pack := range d.Get("packages").([]interface{}) {
+		packages = append(packages, *sdk.NewFunctionPackageRequest(pack.(string)))
+	}
+	return packages
 }
 
 // ReadFunction implements schema.ReadFunc.
 func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
 	functionID, err := splitFunctionID(d.Id())
 	if err != nil {
 		return err
 	}
-	funct := snowflake.NewFunctionBuilder(
-		functionID.DatabaseName,
-		functionID.SchemaName,
-		functionID.FunctionName,
-		functionID.ArgTypes,
-	)
+	id := functionID.SchemaObjectIdentifier()
 
-	// some attributes can be retrieved only by Describe and some only by Show
-	stmt, err := funct.Describe()
+	// some attributes can be retrieved only by Describe and some only by Show;
+	// the function is looked up by its signature, as function names can be overloaded
+	function, err := client.Functions.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] function (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
-	rows, err := snowflake.Query(db, stmt)
-	if err != nil && snowflake.IsResourceNotExistOrNotAuthorized(err.Error(), "Function") {
+	if err := d.Set("comment", function.Description); err != nil {
+		return err
+	}
+	if err := d.Set("is_secure", function.IsSecure); err != nil {
+		return err
+	}
+
+	details, err := client.Functions.Describe(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] function (%s) not found or we are not authorized.Err:\n%s", d.Id(), err.Error())
+		log.Printf("[DEBUG] function (%s) not found or we are not authorized. Err: %v", d.Id(), err)
 		d.SetId("")
 		return nil
 	}
-	defer rows.Close()
-	descPropValues, err := snowflake.ScanFunctionDescription(rows)
 	if err != nil {
 		return err
 	}
-	for _, desc := range descPropValues {
-		switch desc.Property.String {
+	for _, desc := range details {
+		switch desc.Property {
 		case "signature":
 			// Format in Snowflake DB is: (argName argType, argName argType, ...)
-			args := strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "(", ""), ")", "")
+			args := strings.ReplaceAll(strings.ReplaceAll(desc.Value, "(", ""), ")", "")
 
 			if args != "" { // Do nothing for functions without arguments
 				argPairs := strings.Split(args, ", ")
@@ -317,22 +568,22 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		case "null handling":
-			if err := d.Set("null_input_behavior", desc.Value.String); err != nil {
+			if err := d.Set("null_input_behavior", desc.Value); err != nil {
 				return err
 			}
 		case "volatility":
-			if err := d.Set("return_behavior", desc.Value.String); err != nil {
+			if err := d.Set("return_behavior", desc.Value); err != nil {
 				return err
 			}
 		case "body":
-			if err := d.Set("statement", desc.Value.String); err != nil {
+			if err := d.Set("statement", desc.Value); err != nil {
 				return err
 			}
 		case "returns":
 			// Format in Snowflake DB is returnType(<some number>)
 			re := regexp.MustCompile(`^(.*)\([0-9]*\)$`)
-			match := re.FindStringSubmatch(desc.Value.String)
-			rt := desc.Value.String
+			match := re.FindStringSubmatch(desc.Value)
+			rt := desc.Value
 			if match != nil {
 				rt = match[1]
 			}
@@ -340,13 +591,13 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 				return err
 			}
 		case "language":
-			if snowflake.Contains(languages, desc.Value.String) {
-				if err := d.Set("language", desc.Value.String); err != nil {
+			if snowflake.Contains(languages, desc.Value) {
+				if err := d.Set("language", desc.Value); err != nil {
 					return err
 				}
 			}
 		case "packages":
-			packagesString := strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "[", ""), "]", ""), "'", "")
+			packagesString := strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(desc.Value, "[", ""), "]", ""), "'", "")
 			if packagesString != "" { // Do nothing for Java / Python functions without packages
 				packages := strings.Split(packagesString, ",")
 				if err := d.Set("packages", packages); err != nil {
@@ -354,7 +605,7 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		case "imports":
-			importsString := strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "[", ""), "]", ""), "'", "")
+			importsString := strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(desc.Value, "[", ""), "]", ""), "'", "")
 			if importsString != "" { // Do nothing for Java functions without imports
 				imports := strings.Split(importsString, ",")
 				if err := d.Set("imports", imports); err != nil {
@@ -362,56 +613,27 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		case "handler":
-			if err := d.Set("handler", desc.Value.String); err != nil {
+			if err := d.Set("handler", desc.Value); err != nil {
 				return err
 			}
 		case "target_path":
-			if err := d.Set("target_path", desc.Value.String); err != nil {
+			if err := d.Set("target_path", desc.Value); err != nil {
 				return err
 			}
 		case "runtime_version":
-			if err := d.Set("runtime_version", desc.Value.String); err != nil {
+			if err := d.Set("runtime_version", desc.Value); err != nil {
 				return err
 			}
-		default:
-			log.Printf("[WARN] unexpected function property %v returned from Snowflake", desc.Property.String)
-		}
-	}
-
-	q := funct.Show()
-	showRows, err := snowflake.Query(db, q)
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] function (%s) not found", d.Id())
-		d.SetId("")
-		return nil
-	}
-	if err != nil {
-		return err
-	}
-	defer showRows.Close()
-
-	foundFunctions, err := snowflake.ScanFunctions(showRows)
-	if err != nil {
-		return err
-	}
-	// function names can be overloaded with different argument types so we
-	// iterate over and find the correct one
-	argSig, _ := funct.ArgumentsSignature()
-
-	functionIsSecure := map[string]bool{
-		"Y": true,
-		"N": false,
-	}
-
-	for _, v := range foundFunctions {
-		if v.Arguments.String == argSig {
-			if err := d.Set("comment", v.Comment.String); err != nil {
+		case "external_access_integrations":
+			if err := setExternalAccessIntegrations(d, desc.Value); err != nil {
 				return err
 			}
-			if err = d.Set("is_secure", functionIsSecure[v.IsSecure.String]); err != nil {
+		case "secrets":
+			if err := setSecrets(d, desc.Value); err != nil {
 				return err
 			}
+		default:
+			log.Printf("[WARN] unexpected function property %v returned from Snowflake", desc.Property)
 		}
 	}
 
@@ -420,53 +642,42 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateFunction implements schema.UpdateFunction.
 func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
 	pID, err := splitFunctionID(d.Id())
 	if err != nil {
 		return err
 	}
-	builder := snowflake.NewFunctionBuilder(
-		pID.DatabaseName,
-		pID.SchemaName,
-		pID.FunctionName,
-		pID.ArgTypes,
-	)
+	id := pID.SchemaObjectIdentifier()
 
-	db := meta.(*sql.DB)
 	if d.HasChange("name") {
-		name := d.Get("name")
-		q, err := builder.Rename(name.(string))
-		if err != nil {
-			return err
-		}
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error renaming function %v", d.Id())
-		}
+		name := d.Get("name").(string)
 		newID := &functionID{
 			DatabaseName: pID.DatabaseName,
 			SchemaName:   pID.SchemaName,
-			FunctionName: name.(string),
+			FunctionName: name,
 			ArgTypes:     pID.ArgTypes,
 		}
+		newName := newID.SchemaObjectIdentifier().WithoutArguments()
+		if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithRenameTo(&newName)); err != nil {
+			return fmt.Errorf("error renaming function %v err = %w", d.Id(), err)
+		}
 		d.SetId(newID.String())
+		id = newID.SchemaObjectIdentifier()
 	}
+
 	if d.HasChange("is_secure") {
 		secure := d.Get("is_secure")
 
 		if secure.(bool) {
-			q, err := builder.Secure()
-			if err != nil {
-				return err
-			}
-			if err = snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error setting secure for function %v", d.Id())
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithSetSecure(sdk.Bool(true))); err != nil {
+				return fmt.Errorf("error setting secure for function %v err = %w", d.Id(), err)
 			}
 		} else {
-			q, err := builder.Unsecure()
-			if err != nil {
-				return err
-			}
-			if err = snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error unsetting secure for function %v", d.Id())
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithUnsetSecure(sdk.Bool(true))); err != nil {
+				return fmt.Errorf("error unsetting secure for function %v err = %w", d.Id(), err)
 			}
 		}
 	}
@@ -475,19 +686,11 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 		comment := d.Get("comment")
 
 		if c := comment.(string); c == "" {
-			q, err := builder.RemoveComment()
-			if err != nil {
-				return err
-			}
-			if err := snowflake.Exec(db, q); err != nil {
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithUnsetComment(sdk.Bool(true))); err != nil {
 				return fmt.Errorf("error unsetting comment for function %v err = %w", d.Id(), err)
 			}
 		} else {
-			q, err := builder.ChangeComment(c)
-			if err != nil {
-				return err
-			}
-			if err := snowflake.Exec(db, q); err != nil {
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithSetComment(sdk.String(c))); err != nil {
 				return fmt.Errorf("error updating comment for function %v err = %w", d.Id(), err)
 			}
 		}
@@ -499,22 +702,15 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 // DeleteFunction implements schema.DeleteFunc.
 func DeleteFunction(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
 	pID, err := splitFunctionID(d.Id())
 	if err != nil {
 		return err
 	}
-	builder := snowflake.NewFunctionBuilder(
-		pID.DatabaseName,
-		pID.SchemaName,
-		pID.FunctionName,
-		pID.ArgTypes,
-	)
 
-	q, err := builder.Drop()
-	if err != nil {
-		return err
-	}
-	if err := snowflake.Exec(db, q); err != nil {
+	if err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(pID.SchemaObjectIdentifier())); err != nil {
 		return fmt.Errorf("error deleting function %v err = %w", d.Id(), err)
 	}
 
@@ -544,11 +740,15 @@ func splitFunctionID(v string) (*functionID, error) {
 		return nil, fmt.Errorf("ID %v is invalid", v)
 	}
 
+	argTypes := []string{}
+	if arr[3] != "" {
+		argTypes = strings.Split(arr[3], "-")
+	}
 	return &functionID{
 		DatabaseName: arr[0],
 		SchemaName:   arr[1],
 		FunctionName: arr[2],
-		ArgTypes:     strings.Split(arr[3], "-"),
+		ArgTypes:     argTypes,
 	}, nil
 }
 
@@ -560,3 +760,13 @@ func (pi *functionID) String() string {
 		pi.FunctionName,
 		strings.Join(pi.ArgTypes, "-"))
 }
+
+// SchemaObjectIdentifier returns the identifier of the function including its argument types,
+// which tell apart overloaded functions.
+func (pi *functionID) SchemaObjectIdentifier() sdk.SchemaObjectIdentifier {
+	argTypes := make([]sdk.DataType, len(pi.ArgTypes))
+	for i, argType := range pi.ArgTypes {
+		argTypes[i] = sdk.DataType(argType)
+	}
+	return sdk.NewSchemaObjectIdentifierWithArguments(pi.DatabaseName, pi.SchemaName, pi.FunctionName, argTypes)
+}
@@ -117,6 +117,10 @@ func FunctionGrant() *TerraformGrantResource {
 			Update:             UpdateFunctionGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             functionGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(functionGrantSchema, "database_name", "schema_name", "function_name", "argument_data_types", "privilege", "with_grant_option", "on_future", "on_all", "roles", "shares"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFunctionReturns(t *testing.T) {
+	returns := func(t *testing.T, returnType string) *sdk.FunctionReturnsRequest {
+		t.Helper()
+		d := schema.TestResourceDataRaw(t, functionSchema, map[string]interface{}{"return_type": returnType})
+		r, err := getFunctionReturns(d)
+		require.NoError(t, err)
+		return r
+	}
+
+	t.Run("data type", func(t *testing.T) {
+		r := returns(t, "varchar")
+		require.Nil(t, r.Table)
+		require.Equal(t, sdk.DataType("VARCHAR"), r.ResultDataType.ResultDataType)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		r := returns(t, "TABLE (id NUMBER(38, 0), name varchar)")
+		require.Nil(t, r.ResultDataType)
+		require.Equal(t, []sdk.FunctionColumnRequest{
+			*sdk.NewFunctionColumnRequest("id", "NUMBER(38, 0)"),
+			*sdk.NewFunctionColumnRequest("name", "VARCHAR"),
+		}, r.Table.Columns)
+	})
+}
+
+func TestFunctionIDSchemaObjectIdentifier(t *testing.T) {
+	id, err := splitFunctionID("MYDB|PUBLIC|FUNC1|VARCHAR-DATE")
+	require.NoError(t, err)
+	require.Equal(t, `"MYDB"."PUBLIC"."FUNC1"(VARCHAR, DATE)`, id.SchemaObjectIdentifier().FullyQualifiedName())
+
+	id, err = splitFunctionID("MYDB|PUBLIC|FUNC1|")
+	require.NoError(t, err)
+	require.Equal(t, `"MYDB"."PUBLIC"."FUNC1"()`, id.SchemaObjectIdentifier().FullyQualifiedName())
+	require.Equal(t, "MYDB|PUBLIC|FUNC1|", id.String())
+}
+
+func TestSetExternalAccessIntegrationsAndSecrets(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, functionSchema, map[string]interface{}{
+		"external_access_integrations": []interface{}{`"eai_1"`},
+		"secrets": []interface{}{
+			map[string]interface{}{"variable_name": "cred", "secret_id": "db.schema.secret"},
+		},
+	})
+
+	require.NoError(t, setExternalAccessIntegrations(d, `[eai_1, EAI_2]`))
+	require.ElementsMatch(t, []interface{}{`"eai_1"`, "EAI_2"}, d.Get("external_access_integrations").(*schema.Set).List())
+
+	require.NoError(t, setSecrets(d, `{"cred":"\"DB\".\"SCHEMA\".\"SECRET\"","other":"\"DB\".\"SCHEMA\".\"OTHER\""}`))
+	require.ElementsMatch(t, []interface{}{
+		map[string]interface{}{"variable_name": "cred", "secret_id": "db.schema.secret"},
+		map[string]interface{}{"variable_name": "other", "secret_id": `"DB"."SCHEMA"."OTHER"`},
+	}, d.Get("secrets").(*schema.Set).List())
+
+	require.NoError(t, setSecrets(d, ""))
+	require.Empty(t, d.Get("secrets").(*schema.Set).List())
+}
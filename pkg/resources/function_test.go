@@ -48,7 +48,7 @@ func TestFunctionCreate(t *testing.T) {
 	d := prepDummyFunctionResource(t)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		mock.ExpectExec(`CREATE OR REPLACE FUNCTION "my_db"."my_schema"."my_funct"\(data VARCHAR, event_dt DATE\) RETURNS VARCHAR LANGUAGE PYTHON CALLED ON NULL INPUT VOLATILE RUNTIME_VERSION = '3.8' PACKAGES = \('numpy', 'pandas'\) COMMENT = 'user-defined function' HANDLER = 'add_py' AS \$\$def add_py\(i, j\)\: return i\+j\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`CREATE OR REPLACE FUNCTION "my_db"."my_schema"."my_funct" \(data VARCHAR, event_dt DATE\) RETURNS VARCHAR LANGUAGE PYTHON CALLED ON NULL INPUT VOLATILE RUNTIME_VERSION = '3.8' COMMENT = 'user-defined function' PACKAGES = \('numpy', 'pandas'\) HANDLER = 'add_py' AS 'def add_py\(i, j\)\: return i\+j'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectFunctionRead(mock)
 		err := resources.CreateFunction(d, db)
 		r.NoError(err)
@@ -60,7 +60,7 @@ func TestFunctionCreate(t *testing.T) {
 
 func expectFunctionRead(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure"}).
-		AddRow("now", "my_funct", "my_schema", "N", "N", "N", "1", "1", "MY_TEST_FUNCTION(VARCHAR) RETURN VARCHAR", "mock comment", "my_db", "N", "N", "N")
+		AddRow("now", "my_funct", "my_schema", "N", "N", "N", "1", "1", "MY_FUNCT(VARCHAR, DATE) RETURN VARCHAR", "user-defined function", "my_db", "N", "N", "N")
 	mock.ExpectQuery(`SHOW USER FUNCTIONS LIKE 'my_funct' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
 
 	describeRows := sqlmock.NewRows([]string{"property", "value"}).
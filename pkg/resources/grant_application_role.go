@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantApplicationRoleSchema = map[string]*schema.Schema{
+	"application_role_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Qualified name (`\"application\".\"role\"`) of the application role to grant.",
+	},
+	"parent_account_role_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The account role that the application role is granted to.",
+		ExactlyOneOf: []string{"parent_account_role_name", "application_name"},
+	},
+	"application_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The application that the application role is granted to.",
+		ExactlyOneOf: []string{"parent_account_role_name", "application_name"},
+	},
+}
+
+// GrantApplicationRole returns a pointer to the resource representing an application role granted to an account role or an application.
+func GrantApplicationRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Grants an application role of an installed Native App to an account role or to another application. Privileges cannot be granted to application roles from the consumer account, because only the setup script of the application can grant them, so they are not managed by the provider.",
+
+		Create: CreateGrantApplicationRole,
+		Read:   ReadGrantApplicationRole,
+		Delete: DeleteGrantApplicationRole,
+
+		Schema: grantApplicationRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// grantApplicationRoleID is decoded from the id in the form of application_name|application_role_name|grantee_type|grantee_name.
+type grantApplicationRoleID struct {
+	ApplicationRole sdk.DatabaseObjectIdentifier
+	GranteeType     sdk.ObjectType
+	GranteeName     sdk.AccountObjectIdentifier
+}
+
+func (v grantApplicationRoleID) String() string {
+	granteeType := strings.ReplaceAll(string(v.GranteeType), " ", "_")
+	return helpers.EncodeSnowflakeID(v.ApplicationRole.DatabaseName(), v.ApplicationRole.Name(), granteeType, v.GranteeName.Name())
+}
+
+func (v grantApplicationRoleID) kindOfRole() *sdk.KindOfRoleRequest {
+	if v.GranteeType == sdk.ObjectTypeApplication {
+		return sdk.NewKindOfRoleRequest().WithApplicationName(sdk.Pointer(v.GranteeName))
+	}
+	return sdk.NewKindOfRoleRequest().WithRoleName(sdk.Pointer(v.GranteeName))
+}
+
+func grantApplicationRoleIDFromString(id string) (grantApplicationRoleID, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return grantApplicationRoleID{}, fmt.Errorf("invalid grant application role id %s, expected format: application_name|application_role_name|ROLE|role_name or application_name|application_role_name|APPLICATION|application_name", id)
+	}
+	granteeType := sdk.ObjectType(strings.ReplaceAll(parts[2], "_", " "))
+	if granteeType != sdk.ObjectTypeRole && granteeType != sdk.ObjectTypeApplication {
+		return grantApplicationRoleID{}, fmt.Errorf("invalid grantee type %s in grant application role id %s, expected ROLE or APPLICATION", parts[2], id)
+	}
+	return grantApplicationRoleID{
+		ApplicationRole: sdk.NewDatabaseObjectIdentifier(parts[0], parts[1]),
+		GranteeType:     granteeType,
+		GranteeName:     sdk.NewAccountObjectIdentifier(parts[3]),
+	}, nil
+}
+
+// CreateGrantApplicationRole implements schema.CreateFunc.
+func CreateGrantApplicationRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	applicationRole := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(d.Get("application_role_name").(string))
+	id := grantApplicationRoleID{ApplicationRole: applicationRole}
+	if v, ok := d.GetOk("parent_account_role_name"); ok {
+		id.GranteeType = sdk.ObjectTypeRole
+		id.GranteeName = sdk.NewAccountObjectIdentifier(v.(string))
+	} else {
+		id.GranteeType = sdk.ObjectTypeApplication
+		id.GranteeName = sdk.NewAccountObjectIdentifier(d.Get("application_name").(string))
+	}
+
+	if err := client.ApplicationRoles.Grant(ctx, sdk.NewGrantApplicationRoleRequest(applicationRole, *id.kindOfRole())); err != nil {
+		return fmt.Errorf("error granting application role %s to %s %s err = %w", applicationRole.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName(), err)
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantApplicationRole(d, meta)
+}
+
+// ReadGrantApplicationRole implements schema.ReadFunc.
+func ReadGrantApplicationRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id, err := grantApplicationRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	grants, err := client.ApplicationRoles.ShowGrantsOf(ctx, id.ApplicationRole)
+	if err != nil {
+		log.Printf("[DEBUG] application role (%s) not found", id.ApplicationRole.FullyQualifiedName())
+		d.SetId("")
+		return nil
+	}
+	found := false
+	for _, grant := range grants {
+		if grant.GrantedTo == id.GranteeType && grant.GranteeName.Name() == id.GranteeName.Name() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] application role (%s) is not granted to %s %s", id.ApplicationRole.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("application_role_name", id.ApplicationRole.FullyQualifiedName()); err != nil {
+		return err
+	}
+	switch id.GranteeType {
+	case sdk.ObjectTypeRole:
+		if err := d.Set("parent_account_role_name", id.GranteeName.Name()); err != nil {
+			return err
+		}
+	case sdk.ObjectTypeApplication:
+		if err := d.Set("application_name", id.GranteeName.Name()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteGrantApplicationRole implements schema.DeleteFunc.
+func DeleteGrantApplicationRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id, err := grantApplicationRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.ApplicationRoles.Revoke(ctx, sdk.NewRevokeApplicationRoleRequest(id.ApplicationRole, *id.kindOfRole())); err != nil {
+		return fmt.Errorf("error revoking application role %s from %s %s err = %w", id.ApplicationRole.FullyQualifiedName(), id.GranteeType, id.GranteeName.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
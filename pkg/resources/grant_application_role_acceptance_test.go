@@ -0,0 +1,58 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAcc_GrantApplicationRole needs an installed application, because application roles can only be created
+// by the setup script of a Native App. Set TEST_SF_TF_APPLICATION_NAME and TEST_SF_TF_APPLICATION_ROLE_NAME to run it.
+func TestAcc_GrantApplicationRole(t *testing.T) {
+	applicationName := os.Getenv("TEST_SF_TF_APPLICATION_NAME")
+	applicationRoleName := os.Getenv("TEST_SF_TF_APPLICATION_ROLE_NAME")
+	if applicationName == "" || applicationRoleName == "" {
+		t.Skip("Skipping TestAcc_GrantApplicationRole")
+	}
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_grant_application_role.g"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantApplicationRoleConfig(applicationName, applicationRoleName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "application_role_name", fmt.Sprintf(`"%s"."%s"`, applicationName, applicationRoleName)),
+					resource.TestCheckResourceAttr(resourceName, "parent_account_role_name", roleName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|%s|ROLE|%s", applicationName, applicationRoleName, roleName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func grantApplicationRoleConfig(applicationName string, applicationRoleName string, roleName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_role" "r" {
+	name = "%[3]s"
+}
+
+resource "snowflake_grant_application_role" "g" {
+	application_role_name    = "\"%[1]s\".\"%[2]s\""
+	parent_account_role_name = snowflake_role.r.name
+}
+`, applicationName, applicationRoleName, roleName)
+}
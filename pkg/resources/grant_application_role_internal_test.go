@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantApplicationRoleIDFromString(t *testing.T) {
+	t.Run("granted to account role", func(t *testing.T) {
+		id, err := grantApplicationRoleIDFromString("app|app_role|ROLE|parent")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDatabaseObjectIdentifier("app", "app_role"), id.ApplicationRole)
+		require.Equal(t, sdk.ObjectTypeRole, id.GranteeType)
+		require.Equal(t, sdk.NewAccountObjectIdentifier("parent"), id.GranteeName)
+		require.Equal(t, "app|app_role|ROLE|parent", id.String())
+	})
+
+	t.Run("granted to application", func(t *testing.T) {
+		id, err := grantApplicationRoleIDFromString("app|app_role|APPLICATION|other_app")
+		require.NoError(t, err)
+		require.Equal(t, sdk.ObjectTypeApplication, id.GranteeType)
+		require.Equal(t, "app|app_role|APPLICATION|other_app", id.String())
+	})
+
+	t.Run("invalid number of parts", func(t *testing.T) {
+		_, err := grantApplicationRoleIDFromString("app|app_role|parent")
+		require.ErrorContains(t, err, "invalid grant application role id")
+	})
+
+	t.Run("invalid grantee type", func(t *testing.T) {
+		_, err := grantApplicationRoleIDFromString("app|app_role|USER|parent")
+		require.ErrorContains(t, err, "invalid grantee type USER")
+	})
+}
@@ -1,15 +1,16 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jmoiron/sqlx"
-	"github.com/snowflakedb/gosnowflake"
 )
 
 // TerraformGrantResource augments terraform's *schema.Resource with extra context.
@@ -76,18 +77,15 @@ func createGenericGrantRolesAndShares(
 	shares []string,
 ) error {
 	db := meta.(*sql.DB)
+	statements := make([]string, 0, len(roles)+len(shares))
 	for _, role := range roles {
-		if err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption)); err != nil {
-			return err
-		}
+		statements = append(statements, builder.Role(role).Grant(priv, grantOption))
 	}
-
 	for _, share := range shares {
-		if err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption)); err != nil {
-			return err
-		}
+		statements = append(statements, builder.Share(share).Grant(priv, grantOption))
 	}
-	return nil
+	// grants are batched with the ones of other resources when the provider is configured with grant_batch_mode
+	return sdk.NewClientFromDB(db).ExecBatch(context.Background(), statements...)
 }
 
 func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
@@ -136,13 +134,9 @@ func readGenericGrant(
 		grants, err = readGenericCurrentGrants(db, builder)
 	}
 	if err != nil {
-		// HACK HACK: If the object doesn't exist or not authorized then we can assume someone deleted it
-		// We also check the error number matches
+		// If the object doesn't exist or not authorized then we can assume someone deleted it
 		// We set the tf id == blank and return.
-		// I don't know of a better way to work around this issue
-		if snowflakeErr, ok := err.(*gosnowflake.SnowflakeError); ok && //nolint:errorlint // todo: should be fixed
-			snowflakeErr.Number == 2003 &&
-			strings.Contains(err.Error(), "does not exist or not authorized") {
+		if errors.Is(sdk.DecodeDriverError(err), sdk.ErrObjectNotExistOrAuthorized) {
 			log.Printf("[WARN] resource (%s) not found, removing from state file", d.Id())
 			d.SetId("")
 			return nil
@@ -250,19 +244,13 @@ func readGenericGrant(
 }
 
 func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
-	stmt := builder.Show()
-	rows, err := snowflake.Query(db, stmt)
-	if err != nil {
+	var currentGrants []currentGrant
+	if err := sdk.NewClientFromDB(db).QueryShow(context.Background(), &currentGrants, builder.Show()); err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
 	var grants []*grant
-	for rows.Next() {
-		currentGrant := &currentGrant{}
-		if err := rows.StructScan(currentGrant); err != nil {
-			return nil, err
-		}
+	for _, currentGrant := range currentGrants {
 		if currentGrant.GrantedBy == "" {
 			// If GrantedBy is empty string, terraform can't
 			// manage the grant because the grant is a default
@@ -286,21 +274,13 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 }
 
 func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
-	conn := sqlx.NewDb(db, "snowflake")
-
-	stmt := builder.Show()
-	rows, err := conn.Queryx(stmt)
-	if err != nil {
+	var futureGrants []futureGrant
+	if err := sdk.NewClientFromDB(db).QueryShow(context.Background(), &futureGrants, builder.Show()); err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
 	var grants []*grant
-	for rows.Next() {
-		futureGrant := &futureGrant{}
-		if err := rows.StructScan(futureGrant); err != nil {
-			return nil, err
-		}
+	for _, futureGrant := range futureGrants {
 		grant := &grant{
 			CreatedOn:   futureGrant.CreatedOn,
 			Privilege:   futureGrant.Privilege,
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -35,6 +36,10 @@ func ignoreTrimSpaceSuppressFunc(_, old, new string, _ *schema.ResourceData) boo
 	return strings.TrimSpace(old) == strings.TrimSpace(new)
 }
 
+func ignoreCaseSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
 func setIntProperty(d *schema.ResourceData, key string, property *sdk.IntProperty) error {
 	if property != nil && property.Value != nil {
 		if err := d.Set(key, *property.Value); err != nil {
@@ -142,3 +147,52 @@ func IsDataType() schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// setExternalAccessIntegrations sets external_access_integrations of a function or a procedure from the value returned
+// by DESCRIBE, e.g. [EAI_1, "eai 2"]. The configured names referring to the same integrations are kept.
+func setExternalAccessIntegrations(d *schema.ResourceData, value string) error {
+	configured := map[string]string{}
+	for _, name := range expandStringList(d.Get("external_access_integrations").(*schema.Set).List()) {
+		configured[strings.Trim(name, `"`)] = name
+	}
+	integrations := []string{}
+	for _, name := range strings.Split(strings.Trim(strings.TrimSpace(value), "[]"), ",") {
+		name = strings.Trim(strings.TrimSpace(name), `"`)
+		if name == "" {
+			continue
+		}
+		if configuredName, ok := configured[name]; ok {
+			name = configuredName
+		}
+		integrations = append(integrations, name)
+	}
+	return d.Set("external_access_integrations", integrations)
+}
+
+// setSecrets sets secrets of a function or a procedure from the value returned by DESCRIBE, which maps the variable
+// names to the secrets, e.g. {"cred":"\"DB\".\"SCHEMA\".\"SECRET\""}. The configured secret_id is kept when it refers
+// to the same secret.
+func setSecrets(d *schema.ResourceData, value string) error {
+	secretsByVariable := map[string]string{}
+	if value = strings.TrimSpace(value); value != "" && value != "null" {
+		if err := json.Unmarshal([]byte(value), &secretsByVariable); err != nil {
+			return fmt.Errorf("unable to parse secrets %s: %w", value, err)
+		}
+	}
+	configured := map[string]string{}
+	for _, secret := range d.Get("secrets").(*schema.Set).List() {
+		secretDef := secret.(map[string]interface{})
+		configured[secretDef["variable_name"].(string)] = secretDef["secret_id"].(string)
+	}
+	secrets := []map[string]interface{}{}
+	for variableName, secretID := range secretsByVariable {
+		if configuredID, ok := configured[variableName]; ok && strings.EqualFold(strings.ReplaceAll(configuredID, `"`, ""), strings.ReplaceAll(secretID, `"`, "")) {
+			secretID = configuredID
+		}
+		secrets = append(secrets, map[string]interface{}{
+			"variable_name": variableName,
+			"secret_id":     secretID,
+		})
+	}
+	return d.Set("secrets", secrets)
+}
@@ -0,0 +1,471 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const snowflakeIcebergCatalog = "SNOWFLAKE"
+
+var icebergTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the Iceberg table; must be unique for the database and schema in which the table is created.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the Iceberg table.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the Iceberg table.",
+	},
+	"external_volume": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Specifies the external volume for the Iceberg table. When not set, the EXTERNAL_VOLUME parameter of the schema, database or account is used.",
+	},
+	"catalog": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Specifies the catalog for the Iceberg table: SNOWFLAKE, or the name of a catalog integration for an AWS Glue or object storage catalog. When not set, the CATALOG parameter of the schema, database or account is used.",
+	},
+	"column": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"catalog_table_name", "metadata_file_path"},
+		Description:   "Definitions of the columns of an Iceberg table that uses Snowflake as the catalog.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Column name",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Column type, e.g. NUMBER. For the supported types see the Snowflake documentation on data types for Iceberg tables.",
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					ForceNew:    true,
+					Description: "Whether this column can contain null values.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Column comment",
+				},
+			},
+		},
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for an Iceberg table that uses Snowflake as the catalog.",
+	},
+	"base_location": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The path to a directory where Snowflake can write data and metadata files for the table, relative to the active storage location of the external volume. Required when Snowflake is the catalog, including when the table is converted to a managed table. Changing it recreates the table, unless the change happens together with the conversion.",
+	},
+	"storage_serialization_policy": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.IcebergTableStorageSerializationPolicyCompatible), string(sdk.IcebergTableStorageSerializationPolicyOptimized)}, true),
+		Description:  "Specifies the storage serialization policy (COMPATIBLE or OPTIMIZED) of a table that uses Snowflake as the catalog. Changing it recreates the table, unless the change happens together with the conversion to a managed table.",
+	},
+	"catalog_table_name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"metadata_file_path"},
+		Description:   "Specifies the name of the table as recognized by the AWS Glue Data Catalog. Creates an Iceberg table that uses the Glue catalog integration set in catalog.",
+	},
+	"catalog_namespace": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"catalog_table_name"},
+		Description:  "Specifies the AWS Glue Data Catalog namespace (database) of the table. When not set, the namespace of the catalog integration is used.",
+	},
+	"metadata_file_path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the relative path of the Iceberg metadata file to use for the column definitions. Creates an Iceberg table from files in object storage; a change of the path refreshes the table metadata from the new file.",
+	},
+	"replace_invalid_characters": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to replace invalid UTF-8 characters with the Unicode replacement character in query results of a table that uses an external catalog.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validation.IntBetween(-1, 90),
+		Description:  "Specifies the retention period for the Iceberg table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. Default value is -1, which means the retention time of the schema is used.",
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the Iceberg table.",
+	},
+	"convert_to_managed": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Converts an Iceberg table that uses an external catalog into a table that uses Snowflake as the catalog (ALTER ICEBERG TABLE ... CONVERT TO MANAGED), using base_location and storage_serialization_policy. Setting it back to false recreates the table.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Iceberg table.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the Iceberg table.",
+	},
+	"iceberg_table_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the Iceberg table: MANAGED when Snowflake is the catalog, UNMANAGED otherwise.",
+	},
+	"tag": tagReferenceSchema,
+}
+
+// IcebergTable returns a pointer to the resource representing an Iceberg table.
+func IcebergTable() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateIcebergTable,
+		Read:   ReadIcebergTable,
+		Update: UpdateIcebergTable,
+		Delete: DeleteIcebergTable,
+
+		CustomizeDiff: customizeIcebergTableDiff,
+
+		Schema: icebergTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// customizeIcebergTableDiff recreates the table when convert_to_managed is switched off, and when base_location or
+// storage_serialization_policy change in any other plan than the one converting the table to a managed one.
+func customizeIcebergTableDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	o, n := d.GetChange("convert_to_managed")
+	if o.(bool) && !n.(bool) {
+		return d.ForceNew("convert_to_managed")
+	}
+	if !o.(bool) && n.(bool) {
+		return nil
+	}
+	for _, key := range []string{"base_location", "storage_serialization_policy"} {
+		if d.HasChange(key) {
+			return d.ForceNew(key)
+		}
+	}
+	return nil
+}
+
+func getIcebergTableColumns(from any) []sdk.IcebergTableColumnRequest {
+	columns := from.([]any)
+	to := make([]sdk.IcebergTableColumnRequest, len(columns))
+	for i, c := range columns {
+		v := c.(map[string]any)
+		column := sdk.NewIcebergTableColumnRequest(v["name"].(string), sdk.DataType(v["type"].(string)))
+		if !v["nullable"].(bool) {
+			column.WithNotNull(sdk.Bool(true))
+		}
+		if comment := v["comment"].(string); comment != "" {
+			column.WithComment(sdk.String(comment))
+		}
+		to[i] = *column
+	}
+	return to
+}
+
+// CreateIcebergTable implements schema.CreateFunc.
+func CreateIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	var externalVolume, catalog, comment *string
+	if v, ok := d.GetOk("external_volume"); ok {
+		externalVolume = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("catalog"); ok {
+		catalog = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		comment = sdk.String(v.(string))
+	}
+	tags := getPropertyTags(d, "tag")
+
+	var err error
+	switch {
+	case d.Get("catalog_table_name").(string) != "":
+		request := sdk.NewCreateWithGlueCatalogIcebergTableRequest(id, d.Get("catalog_table_name").(string)).
+			WithExternalVolume(externalVolume).
+			WithCatalog(catalog).
+			WithReplaceInvalidCharacters(sdk.Bool(d.Get("replace_invalid_characters").(bool))).
+			WithComment(comment).
+			WithTag(tags)
+		if v, ok := d.GetOk("catalog_namespace"); ok {
+			request.WithCatalogNamespace(sdk.String(v.(string)))
+		}
+		err = client.IcebergTables.CreateWithGlueCatalog(ctx, request)
+	case d.Get("metadata_file_path").(string) != "":
+		request := sdk.NewCreateFromObjectStoreIcebergTableRequest(id, d.Get("metadata_file_path").(string)).
+			WithExternalVolume(externalVolume).
+			WithCatalog(catalog).
+			WithReplaceInvalidCharacters(sdk.Bool(d.Get("replace_invalid_characters").(bool))).
+			WithComment(comment).
+			WithTag(tags)
+		err = client.IcebergTables.CreateFromObjectStore(ctx, request)
+	default:
+		if catalog != nil && !strings.EqualFold(*catalog, snowflakeIcebergCatalog) {
+			return fmt.Errorf("error creating iceberg table %v: catalog_table_name or metadata_file_path has to be set for catalog %s", id.FullyQualifiedName(), *catalog)
+		}
+		columns := getIcebergTableColumns(d.Get("column"))
+		if len(columns) == 0 || d.Get("base_location").(string) == "" {
+			return fmt.Errorf("error creating iceberg table %v: column and base_location have to be set for an Iceberg table that uses Snowflake as the catalog", id.FullyQualifiedName())
+		}
+		request := sdk.NewCreateWithSnowflakeCatalogIcebergTableRequest(id, columns, d.Get("base_location").(string)).
+			WithExternalVolume(externalVolume).
+			WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool))).
+			WithComment(comment).
+			WithTag(tags)
+		if v, ok := d.GetOk("cluster_by"); ok {
+			request.WithClusterBy(expandStringList(v.([]any)))
+		}
+		if v, ok := d.GetOk("storage_serialization_policy"); ok {
+			request.WithStorageSerializationPolicy(sdk.Pointer(sdk.IcebergTableStorageSerializationPolicy(strings.ToUpper(v.(string)))))
+		}
+		if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+			request.WithDataRetentionTimeInDays(sdk.Int(v))
+		}
+		err = client.IcebergTables.CreateWithSnowflakeCatalog(ctx, request)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating iceberg table %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	// tables that use an external catalog accept the remaining properties only through ALTER
+	if d.Get("catalog_table_name").(string) != "" || d.Get("metadata_file_path").(string) != "" {
+		set := sdk.NewIcebergTableSetRequest()
+		var runSet bool
+		if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+			set.WithDataRetentionTimeInDays(sdk.Int(v))
+			runSet = true
+		}
+		if d.Get("change_tracking").(bool) {
+			set.WithChangeTracking(sdk.Bool(true))
+			runSet = true
+		}
+		if runSet {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(set)); err != nil {
+				return fmt.Errorf("error updating iceberg table %v err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.Get("convert_to_managed").(bool) {
+		if err := convertIcebergTableToManaged(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	return ReadIcebergTable(d, meta)
+}
+
+func convertIcebergTableToManaged(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	convert := sdk.NewIcebergTableConvertToManagedRequest()
+	if v, ok := d.GetOk("base_location"); ok {
+		convert.WithBaseLocation(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("storage_serialization_policy"); ok {
+		convert.WithStorageSerializationPolicy(sdk.Pointer(sdk.IcebergTableStorageSerializationPolicy(strings.ToUpper(v.(string)))))
+	}
+	if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithConvertToManaged(convert)); err != nil {
+		return fmt.Errorf("error converting iceberg table %v to a managed table err = %w", d.Id(), err)
+	}
+	return nil
+}
+
+// ReadIcebergTable implements schema.ReadFunc.
+func ReadIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] iceberg table (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":               icebergTable.Name,
+		"database":           icebergTable.DatabaseName,
+		"schema":             icebergTable.SchemaName,
+		"external_volume":    icebergTable.ExternalVolumeName,
+		"comment":            icebergTable.Comment,
+		"owner":              icebergTable.Owner,
+		"iceberg_table_type": icebergTable.IcebergTableType,
+	}
+	// a converted table reports SNOWFLAKE as its catalog, so the catalog it was created with is kept
+	if !d.Get("convert_to_managed").(bool) {
+		values["catalog"] = icebergTable.CatalogName
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateIcebergTable implements schema.UpdateFunc.
+func UpdateIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("metadata_file_path") {
+		refresh := sdk.NewIcebergTableRefreshRequest()
+		if v, ok := d.GetOk("metadata_file_path"); ok {
+			refresh.WithMetadataFileRelativePath(sdk.String(v.(string)))
+		}
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRefresh(refresh)); err != nil {
+			return fmt.Errorf("error refreshing iceberg table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("convert_to_managed") && d.Get("convert_to_managed").(bool) {
+		if err := convertIcebergTableToManaged(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	set, unset := sdk.NewIcebergTableSetRequest(), sdk.NewIcebergTableUnsetRequest()
+	var runSet, runUnset bool
+	if d.HasChange("data_retention_time_in_days") {
+		if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+			set.WithDataRetentionTimeInDays(sdk.Int(v))
+			runSet = true
+		} else {
+			unset.WithDataRetentionTimeInDays(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("change_tracking") {
+		set.WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+		runSet = true
+	}
+	if d.HasChange("replace_invalid_characters") {
+		set.WithReplaceInvalidCharacters(sdk.Bool(d.Get("replace_invalid_characters").(bool)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating iceberg table %v err = %w", d.Id(), err)
+		}
+	}
+	if runUnset {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating iceberg table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error occurred when dropping tags on %v, err = %w", d.Id(), err)
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error occurred when setting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	return ReadIcebergTable(d, meta)
+}
+
+// DeleteIcebergTable implements schema.DeleteFunc.
+func DeleteIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,91 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_IcebergTable(t *testing.T) {
+	bucketURL, roleName := externalVolumeTestBucket(t)
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_iceberg_table.t"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: icebergTableConfig(name, bucketURL, roleName, false, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "external_volume", name),
+					resource.TestCheckResourceAttr(resourceName, "catalog", "SNOWFLAKE"),
+					resource.TestCheckResourceAttr(resourceName, "column.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "iceberg_table_type"),
+				),
+			},
+			{
+				Config: icebergTableConfig(name, bucketURL, roleName, true, "other comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// these are not returned by SHOW ICEBERG TABLES
+				ImportStateVerifyIgnore: []string{"column", "base_location", "storage_serialization_policy", "change_tracking"},
+			},
+		},
+	})
+}
+
+func icebergTableConfig(name string, bucketURL string, roleName string, changeTracking bool, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_external_volume" "v" {
+	name = "%[1]s"
+
+	storage_location {
+		name                 = "location"
+		storage_provider     = "S3"
+		storage_base_url     = "%[4]s"
+		storage_aws_role_arn = "%[5]s"
+	}
+}
+
+resource "snowflake_iceberg_table" "t" {
+	name                         = "%[1]s"
+	database                     = "%[2]s"
+	schema                       = "%[3]s"
+	external_volume              = snowflake_external_volume.v.name
+	catalog                      = "SNOWFLAKE"
+	base_location                = "%[1]s/"
+	storage_serialization_policy = "COMPATIBLE"
+	change_tracking              = %[6]t
+	comment                      = "%[7]s"
+
+	column {
+		name     = "id"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+	column {
+		name = "name"
+		type = "STRING"
+	}
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, bucketURL, roleName, changeTracking, comment)
+}
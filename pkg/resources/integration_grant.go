@@ -76,6 +76,10 @@ func IntegrationGrant() *TerraformGrantResource {
 			Update:             UpdateIntegrationGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             integrationGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(integrationGrantSchema, "integration_name", "privilege", "with_grant_option", "roles"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
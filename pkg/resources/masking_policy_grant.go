@@ -88,6 +88,10 @@ func MaskingPolicyGrant() *TerraformGrantResource {
 			Update:             UpdateMaskingPolicyGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             maskingPolicyGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(maskingPolicyGrantSchema, "database_name", "schema_name", "masking_policy_name", "privilege", "with_grant_option", "roles"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
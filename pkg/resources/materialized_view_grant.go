@@ -115,6 +115,10 @@ func MaterializedViewGrant() *TerraformGrantResource {
 			Update:             UpdateMaterializedViewGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             materializedViewGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(materializedViewGrantSchema, "database_name", "schema_name", "materialized_view_name", "privilege", "with_grant_option", "on_future", "on_all", "roles", "shares"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
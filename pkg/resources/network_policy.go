@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -20,10 +21,11 @@ var networkPolicySchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"allowed_ip_list": {
-		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
-		Required:    true,
-		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are allowed access to your Snowflake account",
+		Type:         schema.TypeSet,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Optional:     true,
+		AtLeastOneOf: []string{"allowed_ip_list", "allowed_network_rule_list"},
+		Description:  "Specifies one or more IPv4 addresses (CIDR notation) that are allowed access to your Snowflake account",
 	},
 	// TODO: Add a ValidationFunc to ensure 0.0.0.0/0 is not in blocked_ip_list
 	// See: https://docs.snowflake.com/en/user-guide/network-policies.html#create-an-account-level-network-policy
@@ -33,6 +35,19 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are denied access to your Snowflake account<br><br>**Do not** add `0.0.0.0/0` to `blocked_ip_list`",
 	},
+	"allowed_network_rule_list": {
+		Type:         schema.TypeSet,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Optional:     true,
+		AtLeastOneOf: []string{"allowed_ip_list", "allowed_network_rule_list"},
+		Description:  "Specifies the fully qualified names of the network rules with INGRESS or INTERNAL_STAGE mode that are allowed access to your Snowflake account, e.g. the qualified_name of a snowflake_network_rule resource.",
+	},
+	"blocked_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the network rules with INGRESS or INTERNAL_STAGE mode that are denied access to your Snowflake account, e.g. the qualified_name of a snowflake_network_rule resource.",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -80,12 +95,29 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		for i, v := range ipList {
 			ipRequests[i] = *sdk.NewIPRequest(v)
 		}
-		req = req.WithAllowedIpList(ipRequests)
+		req = req.WithBlockedIpList(ipRequests)
 	}
 
 	db := meta.(*sql.DB)
 	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
+
+	if v, ok := d.GetOk("allowed_network_rule_list"); ok {
+		ruleIds := getSchemaObjectIdentifierList(v)
+		if err := validateNetworkPolicyNetworkRules(ctx, client, ruleIds); err != nil {
+			return err
+		}
+		req = req.WithAllowedNetworkRuleList(ruleIds)
+	}
+
+	if v, ok := d.GetOk("blocked_network_rule_list"); ok {
+		ruleIds := getSchemaObjectIdentifierList(v)
+		if err := validateNetworkPolicyNetworkRules(ctx, client, ruleIds); err != nil {
+			return err
+		}
+		req = req.WithBlockedNetworkRuleList(ruleIds)
+	}
+
 	err := client.NetworkPolicies.Create(ctx, req)
 	if err != nil {
 		return fmt.Errorf("error creating network policy %v err = %w", name, err)
@@ -123,19 +155,42 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// the lists are not returned by DESCRIBE when they are empty
+	allowedIps, blockedIps := make([]string, 0), make([]string, 0)
+	allowedNetworkRules, blockedNetworkRules := make([]string, 0), make([]string, 0)
 	for _, desc := range policyDescriptions {
 		switch desc.Name {
 		case "ALLOWED_IP_LIST":
-			if err = d.Set("allowed_ip_list", strings.Split(desc.Value, ",")); err != nil {
+			allowedIps = strings.Split(desc.Value, ",")
+		case "BLOCKED_IP_LIST":
+			blockedIps = strings.Split(desc.Value, ",")
+		case "ALLOWED_NETWORK_RULE_LIST":
+			if allowedNetworkRules, err = parseNetworkPolicyNetworkRules(desc.Value); err != nil {
 				return err
 			}
-		case "BLOCKED_IP_LIST":
-			if err = d.Set("blocked_ip_list", strings.Split(desc.Value, ",")); err != nil {
+		case "BLOCKED_NETWORK_RULE_LIST":
+			if blockedNetworkRules, err = parseNetworkPolicyNetworkRules(desc.Value); err != nil {
 				return err
 			}
 		}
 	}
 
+	if err = d.Set("allowed_ip_list", allowedIps); err != nil {
+		return err
+	}
+
+	if err = d.Set("blocked_ip_list", blockedIps); err != nil {
+		return err
+	}
+
+	if err = d.Set("allowed_network_rule_list", allowedNetworkRules); err != nil {
+		return err
+	}
+
+	if err = d.Set("blocked_network_rule_list", blockedNetworkRules); err != nil {
+		return err
+	}
+
 	return err
 }
 
@@ -166,27 +221,53 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("allowed_ip_list") {
 		newIps := ipChangeParser(d, "allowed_ip_list")
-		ipRequests := make([]sdk.IPRequest, len(newIps))
-		for i, v := range newIps {
-			ipRequests[i] = *sdk.NewIPRequest(v)
-		}
-		setReq := sdk.NewNetworkPolicySetRequest().WithAllowedIpList(ipRequests)
-		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
-		if err != nil {
-			return fmt.Errorf("error updating ALLOWED_IP_LIST for network policy %v err = %w", name, err)
+		if len(newIps) == 0 {
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithUnsetAllowedIpList(sdk.Bool(true)))
+			if err != nil {
+				return fmt.Errorf("error unsetting ALLOWED_IP_LIST for network policy %v err = %w", name, err)
+			}
+		} else {
+			ipRequests := make([]sdk.IPRequest, len(newIps))
+			for i, v := range newIps {
+				ipRequests[i] = *sdk.NewIPRequest(v)
+			}
+			setReq := sdk.NewNetworkPolicySetRequest().WithAllowedIpList(ipRequests)
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithSet(setReq))
+			if err != nil {
+				return fmt.Errorf("error updating ALLOWED_IP_LIST for network policy %v err = %w", name, err)
+			}
 		}
 	}
 
 	if d.HasChange("blocked_ip_list") {
 		newIps := ipChangeParser(d, "blocked_ip_list")
-		ipRequests := make([]sdk.IPRequest, len(newIps))
-		for i, v := range newIps {
-			ipRequests[i] = *sdk.NewIPRequest(v)
+		if len(newIps) == 0 {
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithUnsetBlockedIpList(sdk.Bool(true)))
+			if err != nil {
+				return fmt.Errorf("error unsetting BLOCKED_IP_LIST for network policy %v err = %w", name, err)
+			}
+		} else {
+			ipRequests := make([]sdk.IPRequest, len(newIps))
+			for i, v := range newIps {
+				ipRequests[i] = *sdk.NewIPRequest(v)
+			}
+			setReq := sdk.NewNetworkPolicySetRequest().WithBlockedIpList(ipRequests)
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithSet(setReq))
+			if err != nil {
+				return fmt.Errorf("error updating BLOCKED_IP_LIST for network policy %v err = %w", name, err)
+			}
 		}
-		setReq := sdk.NewNetworkPolicySetRequest().WithBlockedIpList(ipRequests)
-		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
-		if err != nil {
-			return fmt.Errorf("error updating BLOCKED_IP_LIST for network policy %v err = %w", name, err)
+	}
+
+	if d.HasChange("allowed_network_rule_list") {
+		if err := updateNetworkPolicyNetworkRules(ctx, client, d, "allowed_network_rule_list"); err != nil {
+			return fmt.Errorf("error updating ALLOWED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("blocked_network_rule_list") {
+		if err := updateNetworkPolicyNetworkRules(ctx, client, d, "blocked_network_rule_list"); err != nil {
+			return fmt.Errorf("error updating BLOCKED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
 		}
 	}
 
@@ -219,3 +300,64 @@ func ipChangeParser(data *schema.ResourceData, key string) []string {
 	}
 	return newIps
 }
+
+// updateNetworkPolicyNetworkRules replaces the given network rule list with its new value. Setting an empty list is
+// not supported by Snowflake, so the previous rules are removed from the policy instead.
+func updateNetworkPolicyNetworkRules(ctx context.Context, client *sdk.Client, d *schema.ResourceData, key string) error {
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	o, n := d.GetChange(key)
+	oldRuleIds, newRuleIds := getSchemaObjectIdentifierList(o), getSchemaObjectIdentifierList(n)
+	allowed := key == "allowed_network_rule_list"
+
+	if len(newRuleIds) == 0 {
+		removeReq := sdk.NewRemoveNetworkRuleRequest()
+		if allowed {
+			removeReq.WithAllowedNetworkRuleList(oldRuleIds)
+		} else {
+			removeReq.WithBlockedNetworkRuleList(oldRuleIds)
+		}
+		return client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithRemove(removeReq))
+	}
+
+	if err := validateNetworkPolicyNetworkRules(ctx, client, newRuleIds); err != nil {
+		return err
+	}
+	setReq := sdk.NewNetworkPolicySetRequest()
+	if allowed {
+		setReq.WithAllowedNetworkRuleList(newRuleIds)
+	} else {
+		setReq.WithBlockedNetworkRuleList(newRuleIds)
+	}
+	return client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithSet(setReq))
+}
+
+// validateNetworkPolicyNetworkRules checks that the network rules exist and restrict access to Snowflake, which is
+// required for the rules referenced by a network policy.
+func validateNetworkPolicyNetworkRules(ctx context.Context, client *sdk.Client, ruleIds []sdk.SchemaObjectIdentifier) error {
+	for _, ruleId := range ruleIds {
+		networkRule, err := client.NetworkRules.ShowByID(ctx, ruleId)
+		if err != nil {
+			return fmt.Errorf("error retrieving network rule %v err = %w", ruleId.FullyQualifiedName(), err)
+		}
+		if networkRule.Mode != sdk.NetworkRuleModeIngress && networkRule.Mode != sdk.NetworkRuleModeInternalStage {
+			return fmt.Errorf("network rule %v has mode %v, but network policies only support network rules with mode %v or %v", ruleId.FullyQualifiedName(), networkRule.Mode, sdk.NetworkRuleModeIngress, sdk.NetworkRuleModeInternalStage)
+		}
+	}
+	return nil
+}
+
+// parseNetworkPolicyNetworkRules parses the network rule list returned by DESCRIBE NETWORK POLICY,
+// e.g. [{"fullyQualifiedRuleName":"DB.SCHEMA.RULE"}], into fully qualified names.
+func parseNetworkPolicyNetworkRules(value string) ([]string, error) {
+	var rules []struct {
+		FullyQualifiedRuleName string `json:"fullyQualifiedRuleName"`
+	}
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, fmt.Errorf("error parsing network rule list %v err = %w", value, err)
+	}
+	names := make([]string, len(rules))
+	for i, rule := range rules {
+		names[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(rule.FullyQualifiedRuleName).FullyQualifiedName()
+	}
+	return names, nil
+}
@@ -3,6 +3,7 @@ package resources_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -23,9 +24,9 @@ func TestAcc_NetworkPolicy(t *testing.T) {
 	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: networkPolicyConfig(name),
@@ -46,6 +47,14 @@ func TestAcc_NetworkPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_ip_list.#", "1"),
 				),
 			},
+			// UNSET BLOCKED IP LIST
+			{
+				Config: networkPolicyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_ip_list.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_ip_list.#", "0"),
+				),
+			},
 			// IMPORT
 			{
 				ResourceName:      "snowflake_network_policy.test",
@@ -76,3 +85,93 @@ resource "snowflake_network_policy" "test" {
 }
 `, name, networkPolicyComment)
 }
+
+func TestAcc_NetworkPolicy_NetworkRules(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_NETWORK_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAcc_NetworkPolicy_NetworkRules")
+	}
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkPolicyNetworkRulesConfig(name, "INGRESS", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_network_rule_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_network_rule_list.#", "1"),
+				),
+			},
+			// REMOVE BLOCKED RULES
+			{
+				Config: networkPolicyNetworkRulesConfig(name, "INGRESS", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_network_rule_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_network_rule_list.#", "0"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_network_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_NetworkPolicy_NetworkRulesWithInvalidMode(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_NETWORK_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAcc_NetworkPolicy_NetworkRulesWithInvalidMode")
+	}
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      networkPolicyNetworkRulesConfig(name, "EGRESS", false),
+				ExpectError: regexp.MustCompile("network policies only support network rules with mode INGRESS or INTERNAL_STAGE"),
+			},
+		},
+	})
+}
+
+func networkPolicyNetworkRulesConfig(name string, mode string, blocked bool) string {
+	blockedRules := ""
+	if blocked {
+		blockedRules = "blocked_network_rule_list = [snowflake_network_rule.blocked.qualified_name]"
+	}
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "allowed" {
+	database   = "%[1]s"
+	schema     = "%[2]s"
+	name       = "%[3]s_ALLOWED"
+	type       = "IPV4"
+	mode       = "%[4]s"
+	value_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_network_rule" "blocked" {
+	database   = "%[1]s"
+	schema     = "%[2]s"
+	name       = "%[3]s_BLOCKED"
+	type       = "IPV4"
+	mode       = "%[4]s"
+	value_list = ["192.168.0.101"]
+}
+
+resource "snowflake_network_policy" "test" {
+	name                      = "%[3]s"
+	allowed_network_rule_list = [snowflake_network_rule.allowed.qualified_name]
+	%[5]s
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, mode, blockedRules)
+}
@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetworkPolicyNetworkRules(t *testing.T) {
+	t.Run("rules", func(t *testing.T) {
+		names, err := parseNetworkPolicyNetworkRules(`[{"fullyQualifiedRuleName":"DB.SCHEMA.RULE"},{"fullyQualifiedRuleName":"DB.SCHEMA.OTHER_RULE"}]`)
+		require.NoError(t, err)
+		require.Equal(t, []string{`"DB"."SCHEMA"."RULE"`, `"DB"."SCHEMA"."OTHER_RULE"`}, names)
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		names, err := parseNetworkPolicyNetworkRules(`[]`)
+		require.NoError(t, err)
+		require.Empty(t, names)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := parseNetworkPolicyNetworkRules(`DB.SCHEMA.RULE`)
+		require.ErrorContains(t, err, "error parsing network rule list")
+	})
+}
@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the database and schema in which the network rule is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the network rule.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the network rule.",
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.NetworkRuleTypeIpv4),
+			string(sdk.NetworkRuleTypeAwsVpceId),
+			string(sdk.NetworkRuleTypeAzureLinkId),
+			string(sdk.NetworkRuleTypeHostPort),
+		}, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Specifies the type of network identifiers being allowed or blocked: IPV4 (IP addresses or ranges), AWSVPCEID (AWS VPC endpoint IDs), AZURELINKID (Azure Private Endpoint link IDs) or HOST_PORT (domains with optional ports).",
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the network identifiers that will be allowed or blocked; the valid values depend on the type of the rule.",
+	},
+	"mode": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.NetworkRuleModeIngress),
+			string(sdk.NetworkRuleModeInternalStage),
+			string(sdk.NetworkRuleModeEgress),
+		}, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Specifies what is restricted by the network rule: INGRESS (access to Snowflake), INTERNAL_STAGE (access to an internal stage on AWS) or EGRESS (requests sent from Snowflake to external destinations).",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the network rule.",
+	},
+}
+
+// NetworkRule returns a pointer to the resource representing a network rule.
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateNetworkRule,
+		Read:   ReadNetworkRule,
+		Update: UpdateNetworkRule,
+		Delete: DeleteNetworkRule,
+
+		Schema: networkRuleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getNetworkRuleValueList(from any) []sdk.NetworkRuleValueRequest {
+	values := expandStringList(from.(*schema.Set).List())
+	valueList := make([]sdk.NetworkRuleValueRequest, len(values))
+	for i, v := range values {
+		valueList[i] = *sdk.NewNetworkRuleValueRequest(v)
+	}
+	return valueList
+}
+
+// CreateNetworkRule implements schema.CreateFunc.
+func CreateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	ruleType := sdk.NetworkRuleType(strings.ToUpper(d.Get("type").(string)))
+	mode := sdk.NetworkRuleMode(strings.ToUpper(d.Get("mode").(string)))
+	request := sdk.NewCreateNetworkRuleRequest(id, ruleType, mode).
+		WithValueList(getNetworkRuleValueList(d.Get("value_list")))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.NetworkRules.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating network rule %v err = %w", id.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadNetworkRule(d, meta)
+}
+
+// ReadNetworkRule implements schema.ReadFunc.
+func ReadNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] network rule (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	details, err := client.NetworkRules.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error describing network rule %v err = %w", d.Id(), err)
+	}
+
+	values := map[string]any{
+		"name":           networkRule.Name,
+		"database":       networkRule.DatabaseName,
+		"schema":         networkRule.SchemaName,
+		"type":           string(networkRule.Type),
+		"value_list":     details.ValueList,
+		"mode":           string(networkRule.Mode),
+		"comment":        networkRule.Comment,
+		"qualified_name": id.FullyQualifiedName(),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateNetworkRule implements schema.UpdateFunc.
+func UpdateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewNetworkRuleSetRequest(), sdk.NewNetworkRuleUnsetRequest()
+	var runSet, runUnset bool
+	if d.HasChange("value_list") {
+		if valueList := getNetworkRuleValueList(d.Get("value_list")); len(valueList) > 0 {
+			set.WithValueList(valueList)
+			runSet = true
+		} else {
+			unset.WithValueList(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			set.WithComment(sdk.String(comment))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating network rule %v err = %w", d.Id(), err)
+		}
+	}
+	if runUnset {
+		if err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating network rule %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadNetworkRule(d, meta)
+}
+
+// DeleteNetworkRule implements schema.DeleteFunc.
+func DeleteNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,69 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_NetworkRule(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_network_rule.nr"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkRuleConfig(name, `"example.com", "snowflake.com:443"`, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "HOST_PORT"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "EGRESS"),
+					resource.TestCheckResourceAttr(resourceName, "value_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a test resource"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+				),
+			},
+			{
+				Config: networkRuleConfig(name, `"example.com"`, "this is a changed test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_list.0", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a changed test resource"),
+				),
+			},
+			// unsets the value list
+			{
+				Config: networkRuleConfig(name, "", "this is a changed test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value_list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func networkRuleConfig(name string, valueList string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "nr" {
+	database   = "%s"
+	schema     = "%s"
+	name       = "%s"
+	type       = "HOST_PORT"
+	mode       = "EGRESS"
+	value_list = [%s]
+	comment    = "%s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, valueList, comment)
+}
@@ -20,9 +20,9 @@ func TestAcc_NotificationAzureIntegration(t *testing.T) {
 	tenant := "some-guid"
 
 	resource.Test(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: azureNotificationIntegrationConfig(accName, storageURI, tenant),
@@ -46,9 +46,9 @@ func TestAcc_NotificationGCPIntegration(t *testing.T) {
 
 	pubsubName := "projects/project-1234/subscriptions/sub2"
 	resource.Test(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: gcpNotificationIntegrationConfig(accName, pubsubName, gcpNotificationDirection),
@@ -78,7 +78,7 @@ func TestAcc_NotificationGCPPushIntegration(t *testing.T) {
 
 	topicName := "projects/project-1234/topics/topic1"
 	resource.Test(t, resource.TestCase{
-		Providers:    providers(),
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
 			{
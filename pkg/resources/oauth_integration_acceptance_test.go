@@ -16,9 +16,9 @@ func TestAcc_OAuthIntegration(t *testing.T) {
 	clientType := "PUBLIC"
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: oauthIntegrationConfig(name, oauthClient, clientType),
@@ -62,9 +62,9 @@ func TestAcc_OAuthIntegrationTableau(t *testing.T) {
 	clientType := "PUBLIC" // not used, but left to fail the test
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: oauthIntegrationConfigTableau(name, oauthClient, clientType),
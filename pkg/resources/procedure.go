@@ -162,6 +162,35 @@ var procedureSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The handler method for Java / Python procedures.",
 	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of the external access integrations that allow the Java / Python procedure to access external networks.",
+	},
+	"secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the variable that the handler code uses to retrieve the secret.",
+				},
+				"secret_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The fully qualified name of the secret, e.g. the qualified_name of a snowflake_secret resource.",
+				},
+			},
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Assigns the secrets, allowed by the external access integrations, to variables that the Java / Python procedure handler uses to retrieve them.",
+	},
 }
 
 func DiffTypes(_, o, n string, _ *schema.ResourceData) bool {
@@ -259,6 +288,14 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 		builder.WithHandler(v.(string))
 	}
 
+	// Set optionals, external access for Java / Python
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(getProcedureExternalAccessIntegrations(v))
+	}
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(getProcedureSecrets(v))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -280,6 +317,26 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 	return ReadProcedure(d, meta)
 }
 
+func getProcedureExternalAccessIntegrations(from any) []string {
+	integrations := []string{}
+	for _, name := range expandStringList(from.(*schema.Set).List()) {
+		integrations = append(integrations, fmt.Sprintf(`"%v"`, name))
+	}
+	return integrations
+}
+
+func getProcedureSecrets(from any) []map[string]string {
+	secrets := []map[string]string{}
+	for _, secret := range from.(*schema.Set).List() {
+		secretDef := secret.(map[string]interface{})
+		secrets = append(secrets, map[string]string{
+			"variable_name": secretDef["variable_name"].(string),
+			"secret_id":     secretDef["secret_id"].(string),
+		})
+	}
+	return secrets
+}
+
 // ReadProcedure implements schema.ReadFunc.
 func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -379,7 +436,14 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("handler", desc.Value.String); err != nil {
 				return err
 			}
-
+		case "external_access_integrations":
+			if err := setExternalAccessIntegrations(d, desc.Value.String); err != nil {
+				return err
+			}
+		case "secrets":
+			if err := setSecrets(d, desc.Value.String); err != nil {
+				return err
+			}
 		default:
 			log.Printf("[WARN] unexpected procedure property %v returned from Snowflake", desc.Property.String)
 		}
@@ -0,0 +1,511 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var replicationGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the replication group. The identifier must start with an alphabetic character and cannot contain spaces or special characters unless the identifier string is enclosed in double quotes (e.g. \"My object\"). Identifiers enclosed in double quotes are also case-sensitive.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"object_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of objects for which you are enabling replication from the source account to the target account. The following object types are supported: \"ACCOUNT PARAMETERS\", \"DATABASES\", \"INTEGRATIONS\", \"NETWORK POLICIES\", \"RESOURCE MONITORS\", \"ROLES\", \"SHARES\", \"USERS\", \"WAREHOUSES\". Only \"DATABASES\" and \"SHARES\" are supported for accounts below the Business Critical edition.",
+	},
+	"allowed_databases": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the database or list of databases for which you are enabling replication from the source account to the target account. The OBJECT_TYPES list must include DATABASES to set this parameter.",
+	},
+	"allowed_shares": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the share or list of shares for which you are enabling replication from the source account to the target account. The OBJECT_TYPES list must include SHARES to set this parameter.",
+	},
+	"allowed_integration_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of integrations for which you are enabling replication from the source account to the target account. This property requires that the OBJECT_TYPES list include INTEGRATIONS to set this parameter. The following integration types are supported: \"SECURITY INTEGRATIONS\", \"API INTEGRATIONS\", \"NOTIFICATION INTEGRATIONS\"",
+	},
+	"allowed_accounts": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the target account or list of target accounts to which replication of specified objects from the source account is enabled. Expected in the form <org_name>.<target_account_name>",
+	},
+	"ignore_edition_check": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Allows replicating objects to accounts on lower editions.",
+	},
+	"from_replica": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"object_types", "allowed_accounts", "allowed_databases", "allowed_shares", "allowed_integration_types", "ignore_edition_check", "replication_schedule"},
+		Description:   "Specifies the name of the replica to use as the source for the replication group.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organization_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of your Snowflake organization.",
+				},
+				"source_account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Source account from which you are enabling replication of the specified objects.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Identifier for the primary replication group in the source account.",
+				},
+			},
+		},
+	},
+	"replication_schedule": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Specifies the schedule for refreshing secondary replication groups.",
+		ConflictsWith: []string{"from_replica"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cron": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"replication_schedule.0.interval"},
+					Description:   "Specifies the cron expression for the replication schedule. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expression": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the cron expression for the replication schedule. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
+							},
+							"time_zone": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the time zone for secondary group refresh.",
+							},
+						},
+					},
+				},
+				"interval": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ConflictsWith: []string{"replication_schedule.0.cron"},
+					Description:   "Specifies the interval in minutes for the replication schedule. The interval must be greater than 0 and less than 1440 (24 hours).",
+				},
+			},
+		},
+	},
+}
+
+// ReplicationGroup returns a pointer to the resource representing a replication group.
+func ReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateReplicationGroup,
+		Read:   ReadReplicationGroup,
+		Update: UpdateReplicationGroup,
+		Delete: DeleteReplicationGroup,
+
+		Schema: replicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateReplicationGroup implements schema.CreateFunc.
+func CreateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	// if from_replica is set, then we are creating a secondary replication group from an existing primary one
+	if v, ok := d.GetOk("from_replica"); ok {
+		fromReplica := v.([]interface{})[0].(map[string]interface{})
+		organizationName := fromReplica["organization_name"].(string)
+		sourceAccountName := fromReplica["source_account_name"].(string)
+		sourceReplicationGroupName := fromReplica["name"].(string)
+
+		primaryReplicationGroupID := sdk.NewExternalObjectIdentifier(sdk.NewAccountIdentifier(organizationName, sourceAccountName), sdk.NewAccountObjectIdentifier(sourceReplicationGroupName))
+		err := client.ReplicationGroups.CreateReplica(ctx, sdk.NewCreateReplicaReplicationGroupRequest(id, primaryReplicationGroupID))
+		if err != nil {
+			return err
+		}
+		d.SetId(name)
+		return ReadReplicationGroup(d, meta)
+	}
+
+	// these two are required attributes if from_replica is not set
+	if _, ok := d.GetOk("object_types"); !ok {
+		return errors.New("object_types is required when not creating from a replica")
+	}
+	if _, ok := d.GetOk("allowed_accounts"); !ok {
+		return errors.New("allowed_accounts is required when not creating from a replica")
+	}
+	allowedAccounts, err := replicationGroupAllowedAccounts(d.Get("allowed_accounts").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	request := sdk.NewCreateReplicationGroupRequest(id, replicationGroupObjectTypes(d.Get("object_types").(*schema.Set)), allowedAccounts)
+	if v, ok := d.GetOk("allowed_databases"); ok {
+		request.WithAllowedDatabases(replicationGroupAccountObjectIdentifiers(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("allowed_shares"); ok {
+		request.WithAllowedShares(replicationGroupAccountObjectIdentifiers(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("allowed_integration_types"); ok {
+		request.WithAllowedIntegrationTypes(replicationGroupIntegrationTypes(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("ignore_edition_check"); ok && v.(bool) {
+		request.WithIgnoreEditionCheck(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("replication_schedule"); ok {
+		request.WithReplicationSchedule(replicationGroupSchedule(v.([]interface{})))
+	}
+
+	if err := client.ReplicationGroups.Create(ctx, request); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return ReadReplicationGroup(d, meta)
+}
+
+// ReadReplicationGroup implements schema.ReadFunc.
+func ReadReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+	replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] replication group (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", replicationGroup.Name); err != nil {
+		return err
+	}
+	// if the replication group is created from a replica, then we do not want to get the other values
+	if _, ok := d.GetOk("from_replica"); ok {
+		return nil
+	}
+
+	if replicationSchedule := replicationGroup.ReplicationSchedule; replicationSchedule != "" {
+		if strings.Contains(replicationSchedule, "MINUTE") {
+			interval, err := strconv.Atoi(strings.TrimSuffix(replicationSchedule, " MINUTE"))
+			if err != nil {
+				return err
+			}
+			err = d.Set("replication_schedule", []interface{}{
+				map[string]interface{}{
+					"interval": interval,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			repScheduleParts := strings.Split(replicationSchedule, " ")
+			timeZone := repScheduleParts[len(repScheduleParts)-1]
+			expression := strings.TrimSuffix(strings.TrimPrefix(replicationSchedule, "USING CRON "), " "+timeZone)
+			err = d.Set("replication_schedule", []interface{}{
+				map[string]interface{}{
+					"cron": []interface{}{
+						map[string]interface{}{
+							"expression": expression,
+							"time_zone":  timeZone,
+						},
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	} else if err := d.Set("replication_schedule", nil); err != nil {
+		return err
+	}
+
+	objectTypes := make([]interface{}, len(replicationGroup.ObjectTypes))
+	for i, v := range replicationGroup.ObjectTypes {
+		objectTypes[i] = string(v)
+	}
+	if err := d.Set("object_types", schema.NewSet(schema.HashString, objectTypes)); err != nil {
+		return err
+	}
+
+	allowedIntegrationTypes := make([]interface{}, len(replicationGroup.AllowedIntegrationTypes))
+	for i, v := range replicationGroup.AllowedIntegrationTypes {
+		allowedIntegrationTypes[i] = string(v)
+	}
+	if err := d.Set("allowed_integration_types", schema.NewSet(schema.HashString, allowedIntegrationTypes)); err != nil {
+		return err
+	}
+
+	allowedAccounts := make([]interface{}, len(replicationGroup.AllowedAccounts))
+	for i, v := range replicationGroup.AllowedAccounts {
+		allowedAccounts[i] = v.Name()
+	}
+	if err := d.Set("allowed_accounts", schema.NewSet(schema.HashString, allowedAccounts)); err != nil {
+		return err
+	}
+
+	databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("allowed_databases", replicationGroupIdentifierNames(databases)); err != nil {
+		return err
+	}
+
+	shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("allowed_shares", replicationGroupIdentifierNames(shares)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateReplicationGroup implements schema.UpdateFunc.
+func UpdateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	// alter replication group <name> set ...
+	set := sdk.NewReplicationGroupSetRequest()
+	runSet := false
+
+	if d.HasChange("object_types") {
+		objectTypes := replicationGroupObjectTypes(d.Get("object_types").(*schema.Set))
+		set.WithObjectTypes(objectTypes)
+		if slices.Contains(objectTypes, sdk.PluralObjectTypeIntegrations) {
+			set.WithAllowedIntegrationTypes(replicationGroupIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set)))
+		}
+		runSet = true
+	}
+
+	unsetReplicationSchedule := false
+	if d.HasChange("replication_schedule") {
+		if v, ok := d.GetOk("replication_schedule"); ok {
+			set.WithReplicationSchedule(replicationGroupSchedule(v.([]interface{})))
+			runSet = true
+		} else {
+			unsetReplicationSchedule = true
+		}
+	}
+
+	if d.HasChange("allowed_integration_types") {
+		set.WithAllowedIntegrationTypes(replicationGroupIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set)))
+		runSet = true
+	}
+
+	if runSet {
+		if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating replication group %v err = %w", name, err)
+		}
+	}
+
+	// alter replication group <name> unset replication_schedule
+	if unsetReplicationSchedule {
+		request := sdk.NewAlterReplicationGroupRequest(id).WithUnset(sdk.NewReplicationGroupUnsetRequest().WithReplicationSchedule(sdk.Bool(true)))
+		if err := client.ReplicationGroups.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error unsetting replication schedule for replication group %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("allowed_databases") {
+		o, n := d.GetChange("allowed_databases")
+		removed := replicationGroupAccountObjectIdentifiers(o.(*schema.Set).Difference(n.(*schema.Set)))
+		if len(removed) > 0 {
+			request := sdk.NewAlterReplicationGroupRequest(id).WithRemove(sdk.NewReplicationGroupRemoveRequest().WithAllowedDatabases(removed))
+			if err := client.ReplicationGroups.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error removing allowed databases for replication group %v err = %w", name, err)
+			}
+		}
+		added := replicationGroupAccountObjectIdentifiers(n.(*schema.Set).Difference(o.(*schema.Set)))
+		if len(added) > 0 {
+			request := sdk.NewAlterReplicationGroupRequest(id).WithAdd(sdk.NewReplicationGroupAddRequest().WithAllowedDatabases(added))
+			if err := client.ReplicationGroups.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error adding allowed databases for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("allowed_shares") {
+		o, n := d.GetChange("allowed_shares")
+		removed := replicationGroupAccountObjectIdentifiers(o.(*schema.Set).Difference(n.(*schema.Set)))
+		if len(removed) > 0 {
+			request := sdk.NewAlterReplicationGroupRequest(id).WithRemove(sdk.NewReplicationGroupRemoveRequest().WithAllowedShares(removed))
+			if err := client.ReplicationGroups.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error removing allowed shares for replication group %v err = %w", name, err)
+			}
+		}
+		added := replicationGroupAccountObjectIdentifiers(n.(*schema.Set).Difference(o.(*schema.Set)))
+		if len(added) > 0 {
+			request := sdk.NewAlterReplicationGroupRequest(id).WithAdd(sdk.NewReplicationGroupAddRequest().WithAllowedShares(added))
+			if err := client.ReplicationGroups.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error adding allowed shares for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("allowed_accounts") {
+		o, n := d.GetChange("allowed_accounts")
+		removed, err := replicationGroupAllowedAccounts(o.(*schema.Set).Difference(n.(*schema.Set)))
+		if err != nil {
+			return err
+		}
+		if len(removed) > 0 {
+			request := sdk.NewAlterReplicationGroupRequest(id).WithRemove(sdk.NewReplicationGroupRemoveRequest().WithAllowedAccounts(removed))
+			if err := client.ReplicationGroups.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error removing allowed accounts for replication group %v err = %w", name, err)
+			}
+		}
+		added, err := replicationGroupAllowedAccounts(n.(*schema.Set).Difference(o.(*schema.Set)))
+		if err != nil {
+			return err
+		}
+		if len(added) > 0 {
+			add := sdk.NewReplicationGroupAddRequest().WithAllowedAccounts(added)
+			if d.Get("ignore_edition_check").(bool) {
+				add.WithIgnoreEditionCheck(sdk.Bool(true))
+			}
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(add)); err != nil {
+				return fmt.Errorf("error adding allowed accounts for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	return ReadReplicationGroup(d, meta)
+}
+
+// DeleteReplicationGroup implements schema.DeleteFunc.
+func DeleteReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+	ctx := context.Background()
+	err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(id).WithIfExists(sdk.Bool(true)))
+	if err != nil {
+		return fmt.Errorf("error deleting replication group %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func replicationGroupObjectTypes(set *schema.Set) []sdk.PluralObjectType {
+	values := expandStringList(set.List())
+	objectTypes := make([]sdk.PluralObjectType, len(values))
+	for i, v := range values {
+		objectTypes[i] = sdk.PluralObjectType(v)
+	}
+	return objectTypes
+}
+
+func replicationGroupIntegrationTypes(set *schema.Set) []sdk.IntegrationType {
+	values := expandStringList(set.List())
+	integrationTypes := make([]sdk.IntegrationType, len(values))
+	for i, v := range values {
+		integrationTypes[i] = sdk.IntegrationType(v)
+	}
+	return integrationTypes
+}
+
+func replicationGroupAccountObjectIdentifiers(set *schema.Set) []sdk.AccountObjectIdentifier {
+	values := expandStringList(set.List())
+	ids := make([]sdk.AccountObjectIdentifier, len(values))
+	for i, v := range values {
+		ids[i] = sdk.NewAccountObjectIdentifier(v)
+	}
+	return ids
+}
+
+func replicationGroupAllowedAccounts(set *schema.Set) ([]sdk.AccountIdentifier, error) {
+	values := expandStringList(set.List())
+	allowedAccounts := make([]sdk.AccountIdentifier, len(values))
+	for i, v := range values {
+		// validation since we cannot do that in the ValidateFunc
+		parts := strings.Split(v, ".")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("allowed_account %s cannot be an account locator and must be of the format <org_name>.<target_account_name>", v)
+		}
+		allowedAccounts[i] = sdk.NewAccountIdentifier(parts[0], parts[1])
+	}
+	return allowedAccounts, nil
+}
+
+func replicationGroupIdentifierNames(ids []sdk.AccountObjectIdentifier) []interface{} {
+	names := make([]interface{}, len(ids))
+	for i, id := range ids {
+		names[i] = id.Name()
+	}
+	return names
+}
+
+func replicationGroupSchedule(v []interface{}) *string {
+	replicationSchedule := v[0].(map[string]interface{})
+	if c := replicationSchedule["cron"].([]interface{}); len(c) > 0 {
+		cron := c[0].(map[string]interface{})
+		cronExpression := "USING CRON " + cron["expression"].(string)
+		if timeZone, ok := cron["time_zone"].(string); ok && timeZone != "" {
+			cronExpression += " " + timeZone
+		}
+		return sdk.String(cronExpression)
+	}
+	return sdk.String(fmt.Sprintf("%d MINUTE", replicationSchedule["interval"].(int)))
+}
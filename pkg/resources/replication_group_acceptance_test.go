@@ -0,0 +1,81 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ReplicationGroup(t *testing.T) {
+	randomCharacters := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	if _, ok := os.LookupEnv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT"); !ok {
+		t.Skip("Skipping TestAcc_ReplicationGroup since there is no target account")
+	}
+	accountName := os.Getenv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: replicationGroupConfig(randomCharacters, accountName, `["DATABASES"]`, fmt.Sprintf(`["%s"]`, acc.TestDatabaseName), 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_databases.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.0.interval", "10"),
+				),
+			},
+			{
+				Config: replicationGroupConfig(randomCharacters, accountName, `["DATABASES", "SHARES"]`, `[]`, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "object_types.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_databases.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.0.interval", "20"),
+				),
+			},
+			// the schedule is unset when it is removed from the configuration
+			{
+				Config: replicationGroupConfig(randomCharacters, accountName, `["DATABASES", "SHARES"]`, `[]`, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.#", "0"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_replication_group.rg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+			},
+		},
+	})
+}
+
+// replicationGroupConfig returns the configuration without the replication schedule when interval is 0.
+func replicationGroupConfig(randomCharacters, accountName, objectTypes, allowedDatabases string, interval int) string {
+	replicationSchedule := ""
+	if interval > 0 {
+		replicationSchedule = fmt.Sprintf(`
+	replication_schedule {
+		interval = %d
+	}`, interval)
+	}
+	return fmt.Sprintf(`
+resource "snowflake_replication_group" "rg" {
+	name = "%s"
+	object_types = %s
+	allowed_accounts = ["%s"]
+	allowed_databases = %s%s
+}
+`, randomCharacters, objectTypes, accountName, allowedDatabases, replicationSchedule)
+}
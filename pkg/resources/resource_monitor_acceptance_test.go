@@ -10,6 +10,7 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAcc_ResourceMonitor(t *testing.T) {
@@ -17,9 +18,9 @@ func TestAcc_ResourceMonitor(t *testing.T) {
 	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: resourceMonitorConfig(name),
@@ -54,6 +55,44 @@ func TestAcc_ResourceMonitor(t *testing.T) {
 	})
 }
 
+// TestAcc_ResourceMonitor_migrateFromSDKv2 checks that the resource monitor served by the plugin framework provider
+// keeps the state of the SDKv2 implementation.
+func TestAcc_ResourceMonitor_migrateFromSDKv2(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	checks := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "id", name),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "name", name),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "credit_quota", "100"),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "set_for_account", "false"),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "notify_triggers.0", "40"),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "suspend_trigger", "80"),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "suspend_immediate_trigger", "90"),
+		resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "warehouses.#", "1"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acc.TestAccSDKv2ProtoV6ProviderFactories,
+				Config:                   resourceMonitorConfig(name),
+				Check:                    checks,
+			},
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				Config:                   resourceMonitorConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: checks,
+			},
+		},
+	})
+}
+
 func resourceMonitorConfig(accName string) string {
 	return fmt.Sprintf(`
 resource "snowflake_warehouse" "warehouse" {
@@ -113,9 +152,9 @@ func TestAcc_ResourceMonitorNotifyUsers(t *testing.T) {
 		checks = append(checks, resource.TestCheckTypeSetElemAttr("snowflake_resource_monitor.test", "notify_users.*", s))
 	}
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
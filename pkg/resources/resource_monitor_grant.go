@@ -84,7 +84,11 @@ func ResourceMonitorGrant() *TerraformGrantResource {
 					return []*schema.ResourceData{d}, nil
 				},
 			},
-			Schema: resourceMonitorGrantSchema,
+			Schema:        resourceMonitorGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(resourceMonitorGrantSchema, "monitor_name", "privilege", "with_grant_option", "roles"),
+			},
 		},
 		ValidPrivs: validResourceMonitorPrivileges,
 	}
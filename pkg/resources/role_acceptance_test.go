@@ -8,6 +8,7 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAcc_Role(t *testing.T) {
@@ -15,9 +16,9 @@ func TestAcc_Role(t *testing.T) {
 	name2 := "5tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: roleBasicConfig(name, "test comment"),
@@ -52,6 +53,39 @@ func TestAcc_Role(t *testing.T) {
 	})
 }
 
+// TestAcc_Role_migrateFromSDKv2 checks that the role served by the plugin framework provider
+// keeps the state of the SDKv2 implementation.
+func TestAcc_Role_migrateFromSDKv2(t *testing.T) {
+	name := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	checks := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("snowflake_role.role", "id", name),
+		resource.TestCheckResourceAttr("snowflake_role.role", "name", name),
+		resource.TestCheckResourceAttr("snowflake_role.role", "comment", "test comment"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acc.TestAccSDKv2ProtoV6ProviderFactories,
+				Config:                   roleBasicConfig(name, "test comment"),
+				Check:                    checks,
+			},
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				Config:                   roleBasicConfig(name, "test comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: checks,
+			},
+		},
+	})
+}
+
 func roleBasicConfig(name, comment string) string {
 	s := `
 resource "snowflake_role" "role" {
@@ -14,7 +14,6 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
-	"github.com/snowflakedb/gosnowflake"
 	"golang.org/x/exp/slices"
 )
 
@@ -237,20 +236,18 @@ func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
 	rg := snowflake.RoleGrant(role1).Role(role2)
 	err := snowflake.Exec(db, rg.Revoke())
 	log.Printf("revokeRoleFromRole %v", err)
-	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
-		if driverErr.Number == 2003 {
-			// handling error if a role has been deleted prior to revoking a role
-			// 002003 (02000): SQL compilation error:
-			// User 'XXX' does not exist or not authorized.
-			roles, _ := snowflake.ListRoles(db, role2)
-			roleNames := make([]string, len(roles))
-			for i, r := range roles {
-				roleNames[i] = r.Name.String
-			}
-			if !slices.Contains(roleNames, role2) {
-				log.Printf("[WARN] Role %s does not exist. No need to revoke role %s", role2, role1)
-				return nil
-			}
+	if errors.Is(sdk.DecodeDriverError(err), sdk.ErrObjectNotExistOrAuthorized) {
+		// handling error if a role has been deleted prior to revoking a role
+		// 002003 (02000): SQL compilation error:
+		// User 'XXX' does not exist or not authorized.
+		roles, _ := snowflake.ListRoles(db, role2)
+		roleNames := make([]string, len(roles))
+		for i, r := range roles {
+			roleNames[i] = r.Name.String
+		}
+		if !slices.Contains(roleNames, role2) {
+			log.Printf("[WARN] Role %s does not exist. No need to revoke role %s", role2, role1)
+			return nil
 		}
 	}
 	return err
@@ -259,20 +256,18 @@ func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
 func revokeRoleFromUser(db *sql.DB, role1, user string) error {
 	rg := snowflake.RoleGrant(role1).User(user)
 	err := snowflake.Exec(db, rg.Revoke())
-	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
-		// handling error if a user has been deleted prior to revoking a role
-		// 002003 (02000): SQL compilation error:
-		// User 'XXX' does not exist or not authorized.
-		if driverErr.Number == 2003 {
-			users, _ := snowflake.ListUsers(user, db)
-			logins := make([]string, len(users))
-			for i, u := range users {
-				logins[i] = u.LoginName.String
-			}
-			if !snowflake.Contains(logins, user) {
-				log.Printf("[WARN] User %s does not exist. No need to revoke role %s", user, role1)
-				return nil
-			}
+	// handling error if a user has been deleted prior to revoking a role
+	// 002003 (02000): SQL compilation error:
+	// User 'XXX' does not exist or not authorized.
+	if errors.Is(sdk.DecodeDriverError(err), sdk.ErrObjectNotExistOrAuthorized) {
+		users, _ := snowflake.ListUsers(user, db)
+		logins := make([]string, len(users))
+		for i, u := range users {
+			logins[i] = u.LoginName.String
+		}
+		if !snowflake.Contains(logins, user) {
+			log.Printf("[WARN] User %s does not exist. No need to revoke role %s", user, role1)
+			return nil
 		}
 	}
 	return err
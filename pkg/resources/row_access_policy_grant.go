@@ -88,6 +88,10 @@ func RowAccessPolicyGrant() *TerraformGrantResource {
 			Update:             UpdateRowAccessPolicyGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             rowAccessPolicyGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(rowAccessPolicyGrantSchema, "database_name", "schema_name", "row_access_policy_name", "privilege", "with_grant_option", "roles"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
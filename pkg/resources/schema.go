@@ -50,7 +50,7 @@ var schemaSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies a managed schema. Managed access schemas centralize privilege management with the schema owner.",
 	},
-	"data_retention_days": {
+	"data_retention_time_in_days": {
 		Type:         schema.TypeInt,
 		Optional:     true,
 		Default:      1,
@@ -60,6 +60,11 @@ var schemaSchema = map[string]*schema.Schema{
 	"tag": tagReferenceSchema,
 }
 
+// schemaRenamedAttributesV0 maps the attributes renamed in schema version 1 from their old to their new names.
+var schemaRenamedAttributesV0 = map[string]string{
+	"data_retention_days": "data_retention_time_in_days",
+}
+
 // Schema returns a pointer to the resource representing a schema.
 func Schema() *schema.Resource {
 	return &schema.Resource{
@@ -72,6 +77,15 @@ func Schema() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    renamedAttributesResourceType(schemaSchema, schemaRenamedAttributesV0),
+				Upgrade: renamedAttributesStateUpgrader(schemaRenamedAttributesV0),
+			},
+		},
 	}
 }
 
@@ -87,7 +101,7 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 	err := client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier(database, name), &sdk.CreateSchemaOptions{
 		Transient:               GetPropertyAsPointer[bool](d, "is_transient"),
 		WithManagedAccess:       GetPropertyAsPointer[bool](d, "is_managed"),
-		DataRetentionTimeInDays: GetPropertyAsPointer[int](d, "data_retention_days"),
+		DataRetentionTimeInDays: GetPropertyAsPointer[int](d, "data_retention_time_in_days"),
 		Tag:                     getPropertyTags(d, "tag"),
 		Comment:                 GetPropertyAsPointer[string](d, "comment"),
 	})
@@ -134,9 +148,9 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	values := map[string]any{
-		"name":                s.Name,
-		"database":            s.DatabaseName,
-		"data_retention_days": retentionTime,
+		"name":                        s.Name,
+		"database":                    s.DatabaseName,
+		"data_retention_time_in_days": retentionTime,
 		// reset the options before reading back from the DB
 		"is_transient": false,
 		"is_managed":   false,
@@ -216,8 +230,8 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("data_retention_days") {
-		days := d.Get("data_retention_days")
+	if d.HasChange("data_retention_time_in_days") {
+		days := d.Get("data_retention_time_in_days")
 		err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{
 			Set: &sdk.SchemaSet{
 				DataRetentionTimeInDays: sdk.Int(days.(int)),
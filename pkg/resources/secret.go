@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var secretSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the secret; must be unique for the database and schema in which the secret is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the secret.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the secret.",
+	},
+	"secret_type": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.SecretTypePassword),
+			string(sdk.SecretTypeGenericString),
+			string(sdk.SecretTypeOAuth2),
+		}, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Specifies the type of the secret: PASSWORD (username and password), GENERIC_STRING (secret_string) or OAUTH2 (api_authentication with oauth_scopes for the client credentials flow, or with oauth_refresh_token and oauth_refresh_token_expiry_time for the authorization code flow).",
+	},
+	"username": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the username value to store in a PASSWORD secret.",
+	},
+	"password": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Specifies the password value to store in a PASSWORD secret. The value is never read back from Snowflake.",
+	},
+	"secret_string": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Specifies the string to store in a GENERIC_STRING secret. The value is never read back from Snowflake.",
+	},
+	"api_authentication": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the security integration that connects Snowflake to the external service of an OAUTH2 secret.",
+	},
+	"oauth_scopes": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"oauth_refresh_token"},
+		Description:   "Specifies the scopes to use when making a request from the OAuth server by a role with USAGE on the integration during the OAuth client credentials flow.",
+	},
+	"oauth_refresh_token": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		RequiredWith: []string{"oauth_refresh_token_expiry_time"},
+		Description:  "Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires (OAuth authorization code flow). The value is never read back from Snowflake.",
+	},
+	"oauth_refresh_token_expiry_time": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"oauth_refresh_token"},
+		Description:  "Specifies the timestamp as a string when the OAuth refresh token expires, e.g. '2024-01-01 12:00:00'.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secret.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the secret.",
+	},
+}
+
+// Secret returns a pointer to the resource representing a secret.
+func Secret() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateSecret,
+		Read:   ReadSecret,
+		Update: UpdateSecret,
+		Delete: DeleteSecret,
+
+		Schema: secretSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getSecretOAuthScopes(from any) []sdk.SecretOAuthScopeRequest {
+	scopes := expandStringList(from.(*schema.Set).List())
+	oauthScopes := make([]sdk.SecretOAuthScopeRequest, len(scopes))
+	for i, s := range scopes {
+		oauthScopes[i] = *sdk.NewSecretOAuthScopeRequest(s)
+	}
+	return oauthScopes
+}
+
+// CreateSecret implements schema.CreateFunc.
+func CreateSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	var comment *string
+	if v, ok := d.GetOk("comment"); ok {
+		comment = sdk.String(v.(string))
+	}
+
+	var err error
+	switch secretType := sdk.SecretType(strings.ToUpper(d.Get("secret_type").(string))); secretType {
+	case sdk.SecretTypePassword:
+		username, usernameOk := d.GetOk("username")
+		password, passwordOk := d.GetOk("password")
+		if !usernameOk || !passwordOk {
+			return fmt.Errorf("username and password are required for a secret of type %s", secretType)
+		}
+		request := sdk.NewCreateWithBasicAuthenticationSecretRequest(id, username.(string), password.(string)).WithComment(comment)
+		err = client.Secrets.CreateWithBasicAuthentication(ctx, request)
+	case sdk.SecretTypeGenericString:
+		secretString, ok := d.GetOk("secret_string")
+		if !ok {
+			return fmt.Errorf("secret_string is required for a secret of type %s", secretType)
+		}
+		request := sdk.NewCreateWithGenericStringSecretRequest(id, secretString.(string)).WithComment(comment)
+		err = client.Secrets.CreateWithGenericString(ctx, request)
+	case sdk.SecretTypeOAuth2:
+		apiAuthentication, ok := d.GetOk("api_authentication")
+		if !ok {
+			return fmt.Errorf("api_authentication is required for a secret of type %s", secretType)
+		}
+		integrationId := sdk.NewAccountObjectIdentifier(apiAuthentication.(string))
+		if refreshToken, ok := d.GetOk("oauth_refresh_token"); ok {
+			request := sdk.NewCreateWithOAuthAuthorizationCodeFlowSecretRequest(id, refreshToken.(string), d.Get("oauth_refresh_token_expiry_time").(string), integrationId).WithComment(comment)
+			err = client.Secrets.CreateWithOAuthAuthorizationCodeFlow(ctx, request)
+		} else {
+			request := sdk.NewCreateWithOAuthClientCredentialsFlowSecretRequest(id, integrationId).
+				WithOAuthScopes(getSecretOAuthScopes(d.Get("oauth_scopes"))).
+				WithComment(comment)
+			err = client.Secrets.CreateWithOAuthClientCredentialsFlow(ctx, request)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error creating secret %v err = %w", id.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadSecret(d, meta)
+}
+
+// ReadSecret implements schema.ReadFunc.
+func ReadSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	secret, err := client.Secrets.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] secret (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	details, err := client.Secrets.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error describing secret %v err = %w", d.Id(), err)
+	}
+
+	// password, secret_string and oauth_refresh_token are not returned by Snowflake, so the configured values are kept
+	values := map[string]any{
+		"name":               secret.Name,
+		"database":           secret.DatabaseName,
+		"schema":             secret.SchemaName,
+		"secret_type":        secret.SecretType,
+		"username":           details.Username,
+		"api_authentication": details.IntegrationName,
+		"oauth_scopes":       details.OAuthScopes,
+		"comment":            secret.Comment,
+		"qualified_name":     id.FullyQualifiedName(),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateSecret implements schema.UpdateFunc.
+func UpdateSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set := sdk.NewSecretSetRequest()
+	var runSet bool
+	if d.HasChange("username") {
+		set.WithUsername(sdk.String(d.Get("username").(string)))
+		runSet = true
+	}
+	if d.HasChange("password") {
+		set.WithPassword(sdk.String(d.Get("password").(string)))
+		runSet = true
+	}
+	if d.HasChange("secret_string") {
+		set.WithSecretString(sdk.String(d.Get("secret_string").(string)))
+		runSet = true
+	}
+	if d.HasChange("oauth_scopes") {
+		set.WithOAuthScopes(getSecretOAuthScopes(d.Get("oauth_scopes")))
+		runSet = true
+	}
+	if d.HasChanges("oauth_refresh_token", "oauth_refresh_token_expiry_time") {
+		set.WithOauthRefreshToken(sdk.String(d.Get("oauth_refresh_token").(string))).
+			WithOauthRefreshTokenExpiryTime(sdk.String(d.Get("oauth_refresh_token_expiry_time").(string)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			set.WithComment(sdk.String(comment))
+			runSet = true
+		} else {
+			if err := client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithUnset(sdk.NewSecretUnsetRequest().WithComment(sdk.Bool(true)))); err != nil {
+				return fmt.Errorf("error unsetting comment of secret %v err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	if runSet {
+		if err := client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating secret %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadSecret(d, meta)
+}
+
+// DeleteSecret implements schema.DeleteFunc.
+func DeleteSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,101 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Secret_Password(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_secret.s"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secretPasswordConfig(name, "user", "password", "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "PASSWORD"),
+					resource.TestCheckResourceAttr(resourceName, "username", "user"),
+					resource.TestCheckResourceAttr(resourceName, "password", "password"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a test resource"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+				),
+			},
+			{
+				Config: secretPasswordConfig(name, "other_user", "other_password", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", "other_user"),
+					resource.TestCheckResourceAttr(resourceName, "password", "other_password"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAcc_Secret_GenericString(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_secret.s"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secretGenericStringConfig(name, "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "GENERIC_STRING"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string", "secret"),
+				),
+			},
+			{
+				Config: secretGenericStringConfig(name, "other secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_string", "other secret"),
+				),
+			},
+		},
+	})
+}
+
+func secretPasswordConfig(name string, username string, password string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret" "s" {
+	database    = "%s"
+	schema      = "%s"
+	name        = "%s"
+	secret_type = "PASSWORD"
+	username    = "%s"
+	password    = "%s"
+	comment     = "%s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, username, password, comment)
+}
+
+func secretGenericStringConfig(name string, secretString string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret" "s" {
+	database      = "%s"
+	schema        = "%s"
+	name          = "%s"
+	secret_type   = "GENERIC_STRING"
+	secret_string = "%s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, secretString)
+}
@@ -105,6 +105,10 @@ func SequenceGrant() *TerraformGrantResource {
 			Update:             UpdateSequenceGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_role instead.",
 			Schema:             sequenceGrantSchema,
+			SchemaVersion:      1,
+			StateUpgraders: []schema.StateUpgrader{
+				grantIDStateUpgrader(sequenceGrantSchema, "database_name", "schema_name", "sequence_name", "privilege", "with_grant_option", "on_future", "on_all", "roles"),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 					parts := strings.Split(d.Id(), helpers.IDDelimiter)
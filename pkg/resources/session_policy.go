@@ -0,0 +1,206 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var sessionPolicySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database this session policy belongs to.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema this session policy belongs to.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Identifier for the session policy; must be unique for the database and schema in which the session policy is created.",
+	},
+	"session_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		Description:  "Specifies the number of minutes in which a session can be idle before users must authenticate to Snowflake again. Supported range: 5 to 240, inclusive. Default: 240",
+		ValidateFunc: validation.IntBetween(5, 240),
+	},
+	"session_ui_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		Description:  "Specifies the number of minutes in which a Snowsight or Classic Console session can be idle before users must authenticate to Snowflake again. Supported range: 5 to 240, inclusive. Default: 240",
+		ValidateFunc: validation.IntBetween(5, 240),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Adds a comment or overwrites an existing comment for the session policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the session policy.",
+	},
+}
+
+func SessionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "A session policy defines the idle session timeout period in minutes for Snowflake sessions, Snowsight and the Classic Console.",
+		Create:      CreateSessionPolicy,
+		Read:        ReadSessionPolicy,
+		Update:      UpdateSessionPolicy,
+		Delete:      DeleteSessionPolicy,
+
+		Schema: sessionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateSessionPolicy implements schema.CreateFunc.
+func CreateSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	request := sdk.NewCreateSessionPolicyRequest(objectIdentifier).
+		WithSessionIdleTimeoutMins(sdk.Int(d.Get("session_idle_timeout_mins").(int))).
+		WithSessionUiIdleTimeoutMins(sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.SessionPolicies.Create(ctx, request); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadSessionPolicy(d, meta)
+}
+
+// ReadSessionPolicy implements schema.ReadFunc.
+func ReadSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	sessionPolicy, err := client.SessionPolicies.ShowByID(ctx, objectIdentifier)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] session policy (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	if err := d.Set("database", sessionPolicy.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", sessionPolicy.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", sessionPolicy.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", sessionPolicy.Comment); err != nil {
+		return err
+	}
+
+	sessionPolicyDetails, err := client.SessionPolicies.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("session_idle_timeout_mins", sessionPolicyDetails.SessionIdleTimeoutMins); err != nil {
+		return err
+	}
+	if err := d.Set("session_ui_idle_timeout_mins", sessionPolicyDetails.SessionUIIdleTimeoutMins); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSessionPolicy implements schema.UpdateFunc.
+func UpdateSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newID := sdk.NewSchemaObjectIdentifier(objectIdentifier.DatabaseName(), objectIdentifier.SchemaName(), d.Get("name").(string))
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(objectIdentifier).WithRenameTo(&newID)); err != nil {
+			return fmt.Errorf("error renaming session policy %v err = %w", d.Id(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newID))
+		objectIdentifier = newID
+	}
+
+	set := sdk.NewSessionPolicySetRequest()
+	var runSet bool
+	if d.HasChange("session_idle_timeout_mins") {
+		set.WithSessionIdleTimeoutMins(sdk.Int(d.Get("session_idle_timeout_mins").(int)))
+		runSet = true
+	}
+	if d.HasChange("session_ui_idle_timeout_mins") {
+		set.WithSessionUiIdleTimeoutMins(sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset := sdk.NewSessionPolicyUnsetRequest().WithComment(sdk.Bool(true))
+			if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(objectIdentifier).WithUnset(unset)); err != nil {
+				return fmt.Errorf("error unsetting comment on session policy %v err = %w", d.Id(), err)
+			}
+		}
+	}
+	if runSet {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(objectIdentifier).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating session policy %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadSessionPolicy(d, meta)
+}
+
+// DeleteSessionPolicy implements schema.DeleteFunc.
+func DeleteSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.SessionPolicies.Drop(ctx, sdk.NewDropSessionPolicyRequest(objectIdentifier)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
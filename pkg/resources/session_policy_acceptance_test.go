@@ -0,0 +1,60 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SessionPolicy(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_session_policy.sp"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicyConfig(name, 30, 15, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "session_idle_timeout_mins", "30"),
+					resource.TestCheckResourceAttr(resourceName, "session_ui_idle_timeout_mins", "15"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a test resource"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+				),
+			},
+			{
+				Config: sessionPolicyConfig(name, 60, 240, "this is a changed test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "session_idle_timeout_mins", "60"),
+					resource.TestCheckResourceAttr(resourceName, "session_ui_idle_timeout_mins", "240"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is a changed test resource"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func sessionPolicyConfig(name string, idleTimeout int, uiIdleTimeout int, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_session_policy" "sp" {
+	database                     = "%s"
+	schema                       = "%s"
+	name                         = "%s"
+	session_idle_timeout_mins    = %d
+	session_ui_idle_timeout_mins = %d
+	comment                      = "%s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, idleTimeout, uiIdleTimeout, comment)
+}
@@ -23,9 +23,9 @@ func TestAcc_Share(t *testing.T) {
 		t.Skip("SNOWFLAKE_ACCOUNT_THIRD must be set for Share acceptance tests")
 	}
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: shareConfig(name, shareComment),
@@ -9,9 +9,9 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/snowflakedb/gosnowflake"
 )
 
 const (
@@ -241,14 +241,12 @@ func ReadStage(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
-		// 002003 (02000): SQL compilation error:
-		// 'XXX' does not exist or not authorized.
-		if driverErr.Number == 2003 {
-			log.Printf("[DEBUG] stage (%s) not found", d.Id())
-			d.SetId("")
-			return nil
-		}
+	// 002003 (02000): SQL compilation error:
+	// 'XXX' does not exist or not authorized.
+	if errors.Is(sdk.DecodeDriverError(err), sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] stage (%s) not found", d.Id())
+		d.SetId("")
+		return nil
 	}
 
 	sq := snowflake.NewStageBuilder(stage, dbName, schema).Show()
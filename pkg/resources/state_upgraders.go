@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// renamedAttributesResourceType returns the type of the previous resource version, in which the renamed attributes
+// were still present under their old names. It is used by Terraform only for the legacy (flatmap) states.
+func renamedAttributesResourceType(currentSchema map[string]*schema.Schema, renamedAttributes map[string]string) cty.Type {
+	previousSchema := make(map[string]*schema.Schema, len(currentSchema)+len(renamedAttributes))
+	for key, value := range currentSchema {
+		previousSchema[key] = value
+	}
+	for oldKey, newKey := range renamedAttributes {
+		previousSchema[oldKey] = currentSchema[newKey]
+	}
+	return (&schema.Resource{Schema: previousSchema}).CoreConfigSchema().ImpliedType()
+}
+
+// renamedAttributesStateUpgrader moves the values of renamed attributes from their old to their new names.
+// The value under the new name is kept if it is already present in the state.
+func renamedAttributesStateUpgrader(renamedAttributes map[string]string) schema.StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+		for oldKey, newKey := range renamedAttributes {
+			oldValue, ok := rawState[oldKey]
+			if !ok {
+				continue
+			}
+			if oldValue != nil && rawState[newKey] == nil {
+				rawState[newKey] = oldValue
+			}
+			delete(rawState, oldKey)
+		}
+		return rawState, nil
+	}
+}
+
+// grantIDStateUpgrader returns the upgrader rebuilding the pipe-delimited ID of a grant resource from the attributes
+// kept in the state. This brings IDs produced by older provider versions to the format expected by the importer.
+// The idAttributes have to be given in the same order as in the ID.
+func grantIDStateUpgrader(grantSchema map[string]*schema.Schema, idAttributes ...string) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: 0,
+		Type:    (&schema.Resource{Schema: grantSchema}).CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+			if rawState == nil {
+				return rawState, nil
+			}
+			attributes := make([]any, len(idAttributes))
+			for i, key := range idAttributes {
+				switch grantSchema[key].Type {
+				case schema.TypeBool:
+					v, _ := rawState[key].(bool)
+					attributes[i] = v
+				case schema.TypeSet:
+					// sets are ordered the same way as in the read functions
+					set := grantSchema[key].ZeroValue().(*schema.Set)
+					v, _ := rawState[key].([]any)
+					for _, elem := range v {
+						set.Add(elem)
+					}
+					attributes[i] = expandStringList(set.List())
+				case schema.TypeList:
+					v, _ := rawState[key].([]any)
+					attributes[i] = expandStringList(v)
+				default:
+					v, _ := rawState[key].(string)
+					attributes[i] = v
+				}
+			}
+			rawState["id"] = helpers.EncodeSnowflakeID(attributes...)
+			return rawState, nil
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func upgradeRawState(t *testing.T, upgrader schema.StateUpgrader, rawStateJSON string) map[string]any {
+	t.Helper()
+	var rawState map[string]any
+	require.NoError(t, json.Unmarshal([]byte(rawStateJSON), &rawState))
+	upgraded, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	require.NoError(t, err)
+	return upgraded
+}
+
+func TestTableStateUpgraderV0(t *testing.T) {
+	upgrader := Table().StateUpgraders[0]
+
+	t.Run("moves data_retention_days", func(t *testing.T) {
+		upgraded := upgradeRawState(t, upgrader, `{
+			"id": "db|schema|table",
+			"name": "table",
+			"data_retention_days": 5,
+			"data_retention_time_in_days": null
+		}`)
+
+		require.Equal(t, map[string]any{
+			"id":                          "db|schema|table",
+			"name":                        "table",
+			"data_retention_time_in_days": float64(5),
+		}, upgraded)
+	})
+
+	t.Run("keeps data_retention_time_in_days", func(t *testing.T) {
+		upgraded := upgradeRawState(t, upgrader, `{
+			"id": "db|schema|table",
+			"data_retention_days": null,
+			"data_retention_time_in_days": 3
+		}`)
+
+		require.Equal(t, map[string]any{
+			"id":                          "db|schema|table",
+			"data_retention_time_in_days": float64(3),
+		}, upgraded)
+	})
+
+	t.Run("nil state", func(t *testing.T) {
+		upgraded, err := upgrader.Upgrade(context.Background(), nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, upgraded)
+	})
+}
+
+func TestSchemaStateUpgraderV0(t *testing.T) {
+	upgraded := upgradeRawState(t, Schema().StateUpgraders[0], `{
+		"id": "db|schema",
+		"name": "schema",
+		"database": "db",
+		"data_retention_days": 1,
+		"is_managed": false
+	}`)
+
+	require.Equal(t, map[string]any{
+		"id":                          "db|schema",
+		"name":                        "schema",
+		"database":                    "db",
+		"data_retention_time_in_days": float64(1),
+		"is_managed":                  false,
+	}, upgraded)
+}
+
+func TestGrantIDStateUpgraderV0(t *testing.T) {
+	t.Run("table grant with legacy id", func(t *testing.T) {
+		upgraded := upgradeRawState(t, TableGrant().Resource.StateUpgraders[0], `{
+			"id": "db|schema|table|SELECT|false",
+			"database_name": "db",
+			"schema_name": "schema",
+			"table_name": "table",
+			"privilege": "SELECT",
+			"with_grant_option": false,
+			"roles": ["role_a"],
+			"shares": []
+		}`)
+
+		require.Equal(t, "db|schema|table|SELECT|false|false|false|role_a|", upgraded["id"])
+		require.Equal(t, "table", upgraded["table_name"])
+	})
+
+	t.Run("database grant with multiple roles", func(t *testing.T) {
+		upgraded := upgradeRawState(t, DatabaseGrant().Resource.StateUpgraders[0], `{
+			"id": "db|USAGE|role_a,role_b",
+			"database_name": "db",
+			"privilege": "USAGE",
+			"with_grant_option": true,
+			"roles": ["role_b", "role_a"],
+			"shares": ["share"]
+		}`)
+
+		roles := schema.NewSet(schema.HashString, []any{"role_a", "role_b"}).List()
+		require.Equal(t, "db|USAGE|true|"+roles[0].(string)+","+roles[1].(string)+"|share", upgraded["id"])
+	})
+
+	t.Run("function grant with argument data types", func(t *testing.T) {
+		upgraded := upgradeRawState(t, FunctionGrant().Resource.StateUpgraders[0], `{
+			"id": "db|schema|fun|USAGE|false|role",
+			"database_name": "db",
+			"schema_name": "schema",
+			"function_name": "fun",
+			"argument_data_types": ["NUMBER", "VARCHAR"],
+			"privilege": "USAGE",
+			"with_grant_option": false,
+			"on_future": false,
+			"roles": ["role"]
+		}`)
+
+		require.Equal(t, "db|schema|fun|NUMBER,VARCHAR|USAGE|false|false|false|role|", upgraded["id"])
+	})
+}
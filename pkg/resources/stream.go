@@ -11,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -102,6 +103,8 @@ func Stream() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: sdkValidationCustomDiff(validateStreamOnPlan, "on_table", "on_view", "on_stage", "append_only", "show_initial_rows"),
 	}
 }
 
@@ -111,9 +114,6 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
-	appendOnly := d.Get("append_only").(bool)
-	insertOnly := d.Get("insert_only").(bool)
-	showInitialRows := d.Get("show_initial_rows").(bool)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	client := sdk.NewClientFromDB(db)
@@ -125,11 +125,10 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 
 	switch {
 	case onTableSet:
-		tableObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onTable.(string))
+		tableId, err := streamSourceId(onTable.(string))
 		if err != nil {
 			return err
 		}
-		tableId := tableObjectIdentifier.(sdk.SchemaObjectIdentifier)
 
 		tq := snowflake.NewTableBuilder(tableId.Name(), tableId.DatabaseName(), tableId.SchemaName()).Show()
 		tableRow := snowflake.QueryRow(db, tq)
@@ -139,36 +138,18 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if t.IsExternal.String == "Y" {
-			req := sdk.NewCreateStreamOnExternalTableRequest(id, tableId)
-			if insertOnly {
-				req.WithInsertOnly(sdk.Bool(true))
-			}
-			if v, ok := d.GetOk("comment"); ok {
-				req.WithComment(sdk.String(v.(string)))
-			}
-			err := client.Streams.CreateOnExternalTable(ctx, req)
+			err := client.Streams.CreateOnExternalTable(ctx, streamOnExternalTableRequest(d, id, tableId))
 			if err != nil {
 				return fmt.Errorf("error creating stream %v err = %w", name, err)
 			}
 		} else {
-			req := sdk.NewCreateStreamOnTableRequest(id, tableId)
-			if appendOnly {
-				req.WithAppendOnly(sdk.Bool(true))
-			}
-			if showInitialRows {
-				req.WithShowInitialRows(sdk.Bool(true))
-			}
-			if v, ok := d.GetOk("comment"); ok {
-				req.WithComment(sdk.String(v.(string)))
-			}
-			err := client.Streams.CreateOnTable(ctx, req)
+			err := client.Streams.CreateOnTable(ctx, streamOnTableRequest(d, id, tableId))
 			if err != nil {
 				return fmt.Errorf("error creating stream %v err = %w", name, err)
 			}
 		}
 	case onViewSet:
-		viewObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onView.(string))
-		viewId := viewObjectIdentifier.(sdk.SchemaObjectIdentifier)
+		viewId, err := streamSourceId(onView.(string))
 		if err != nil {
 			return err
 		}
@@ -180,23 +161,12 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		req := sdk.NewCreateStreamOnViewRequest(id, viewId)
-		if appendOnly {
-			req.WithAppendOnly(sdk.Bool(true))
-		}
-		if showInitialRows {
-			req.WithShowInitialRows(sdk.Bool(true))
-		}
-		if v, ok := d.GetOk("comment"); ok {
-			req.WithComment(sdk.String(v.(string)))
-		}
-		err = client.Streams.CreateOnView(ctx, req)
+		err = client.Streams.CreateOnView(ctx, streamOnViewRequest(d, id, viewId))
 		if err != nil {
 			return fmt.Errorf("error creating stream %v err = %w", name, err)
 		}
 	case onStageSet:
-		stageObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onStage.(string))
-		stageId := stageObjectIdentifier.(sdk.SchemaObjectIdentifier)
+		stageId, err := streamSourceId(onStage.(string))
 		if err != nil {
 			return err
 		}
@@ -209,11 +179,7 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		if !strings.Contains(stageDesc.Directory, "ENABLE = true") {
 			return fmt.Errorf("directory must be enabled on stage")
 		}
-		req := sdk.NewCreateStreamOnDirectoryTableRequest(id, stageId)
-		if v, ok := d.GetOk("comment"); ok {
-			req.WithComment(sdk.String(v.(string)))
-		}
-		err = client.Streams.CreateOnDirectoryTable(ctx, req)
+		err = client.Streams.CreateOnDirectoryTable(ctx, streamOnDirectoryTableRequest(d, id, stageId))
 		if err != nil {
 			return fmt.Errorf("error creating stream %v err = %w", name, err)
 		}
@@ -224,6 +190,98 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	return ReadStream(d, meta)
 }
 
+// streamSourceId decodes the identifier of the object the stream monitors, given in on_table, on_view or on_stage.
+func streamSourceId(source string) (sdk.SchemaObjectIdentifier, error) {
+	sourceId, err := helpers.DecodeSnowflakeParameterID(source)
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, err
+	}
+	schemaObjectId, ok := sourceId.(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return sdk.SchemaObjectIdentifier{}, fmt.Errorf("expected a fully qualified identifier <database>.<schema>.<name>, got %s", source)
+	}
+	return schemaObjectId, nil
+}
+
+// streamOnTableRequest builds the request used to create the stream on a table from the given configuration.
+func streamOnTableRequest(d resourceDataGetter, id sdk.SchemaObjectIdentifier, tableId sdk.SchemaObjectIdentifier) *sdk.CreateOnTableStreamRequest {
+	req := sdk.NewCreateStreamOnTableRequest(id, tableId)
+	if d.Get("append_only").(bool) {
+		req.WithAppendOnly(sdk.Bool(true))
+	}
+	if d.Get("show_initial_rows").(bool) {
+		req.WithShowInitialRows(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+	return req
+}
+
+// streamOnExternalTableRequest builds the request used to create the stream on an external table from the given configuration.
+func streamOnExternalTableRequest(d resourceDataGetter, id sdk.SchemaObjectIdentifier, tableId sdk.SchemaObjectIdentifier) *sdk.CreateOnExternalTableStreamRequest {
+	req := sdk.NewCreateStreamOnExternalTableRequest(id, tableId)
+	if d.Get("insert_only").(bool) {
+		req.WithInsertOnly(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+	return req
+}
+
+// streamOnViewRequest builds the request used to create the stream on a view from the given configuration.
+func streamOnViewRequest(d resourceDataGetter, id sdk.SchemaObjectIdentifier, viewId sdk.SchemaObjectIdentifier) *sdk.CreateOnViewStreamRequest {
+	req := sdk.NewCreateStreamOnViewRequest(id, viewId)
+	if d.Get("append_only").(bool) {
+		req.WithAppendOnly(sdk.Bool(true))
+	}
+	if d.Get("show_initial_rows").(bool) {
+		req.WithShowInitialRows(sdk.Bool(true))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+	return req
+}
+
+// streamOnDirectoryTableRequest builds the request used to create the stream on a stage from the given configuration.
+func streamOnDirectoryTableRequest(d resourceDataGetter, id sdk.SchemaObjectIdentifier, stageId sdk.SchemaObjectIdentifier) *sdk.CreateOnDirectoryTableStreamRequest {
+	req := sdk.NewCreateStreamOnDirectoryTableRequest(id, stageId)
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+	return req
+}
+
+// validateStreamOnPlan runs the SDK validations of the stream creation against the planned state. A stream on a table
+// is validated as a stream on a regular table, because telling external tables apart requires querying Snowflake.
+func validateStreamOnPlan(ctx context.Context, client *sdk.Client, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	for _, key := range []string{"on_table", "on_view", "on_stage"} {
+		v, ok := d.GetOk(key)
+		if !ok || !d.NewValueKnown(key) {
+			continue
+		}
+		sourceId, err := streamSourceId(v.(string))
+		if err != nil {
+			return cty.GetAttrPath(key).NewError(err)
+		}
+		switch key {
+		case "on_table":
+			return client.Streams.CreateOnTable(ctx, streamOnTableRequest(d, id, sourceId))
+		case "on_view":
+			return client.Streams.CreateOnView(ctx, streamOnViewRequest(d, id, sourceId))
+		case "on_stage":
+			return client.Streams.CreateOnDirectoryTable(ctx, streamOnDirectoryTableRequest(d, id, sourceId))
+		}
+	}
+	return nil
+}
+
 // ReadStream implements schema.ReadFunc.
 func ReadStream(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
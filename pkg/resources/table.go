@@ -2,13 +2,15 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
-	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,7 +51,7 @@ var tableSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Required:    true,
 		MinItems:    1,
-		Description: "Definitions of a column to create in the table. Minimum one required.",
+		Description: "Definitions of a column to create in the table. Minimum one required. Columns are matched by name, so a column whose name changes is dropped and added again, unless renamed_from is set.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
@@ -57,16 +59,24 @@ var tableSchema = map[string]*schema.Schema{
 					Required:    true,
 					Description: "Column name",
 				},
+				"renamed_from": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Previous name of the column. When the table has a column with this name, it is renamed with ALTER TABLE ... RENAME COLUMN, so that its data is preserved, instead of being dropped and added again. Ignored when the table is created.",
+				},
 				"type": {
 					Type:        schema.TypeString,
 					Required:    true,
-					Description: "Column type, e.g. VARIANT",
+					Description: "Column type, e.g. VARIANT. Only increasing the length of a text column or the precision of a number column can be applied in place.",
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// these are all equivalent as per https://docs.snowflake.com/en/sql-reference/data-types-text.html
-						varcharType := []string{"VARCHAR(16777216)", "VARCHAR", "text", "string", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING"}
-						return slices.Contains(varcharType, new) && slices.Contains(varcharType, old)
+						return sameColumnType(old, new)
 					},
 				},
+				"collate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Column collation, e.g. utf8. Cannot be changed in place.",
+				},
 				"nullable": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -76,7 +86,7 @@ var tableSchema = map[string]*schema.Schema{
 				"default": {
 					Type:        schema.TypeList,
 					Optional:    true,
-					Description: "Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied",
+					Description: "Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN a default can only be dropped, or changed from one sequence to another, on an existing column",
 					MinItems:    1,
 					MaxItems:    1,
 					Elem: &schema.Resource{
@@ -138,7 +148,13 @@ var tableSchema = map[string]*schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "Masking policy to apply on column",
+					Description: "Fully qualified name of the masking policy to apply on column, in the form of database.schema.policy",
+				},
+				"tag": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Definitions of a tag to associate with the column.",
+					Elem:        tagReferenceSchema.Elem,
 				},
 			},
 		},
@@ -177,21 +193,12 @@ var tableSchema = map[string]*schema.Schema{
 			},
 		},
 	},
-	"data_retention_days": {
-		Type:          schema.TypeInt,
-		Optional:      true,
-		Description:   "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. Default value is 1, if you wish to inherit the parent schema setting then pass in the schema attribute to this argument.",
-		ValidateFunc:  validation.IntBetween(0, 90),
-		Deprecated:    "Use data_retention_time_in_days attribute instead",
-		ConflictsWith: []string{"data_retention_time_in_days"},
-	},
 	"data_retention_time_in_days": {
-		Type:          schema.TypeInt,
-		Optional:      true,
-		Description:   "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. Default value is 1, if you wish to inherit the parent schema setting then pass in the schema attribute to this argument.",
-		ValidateFunc:  validation.IntBetween(0, 90),
-		Deprecated:    "Use snowflake_object_parameter instead",
-		ConflictsWith: []string{"data_retention_days"},
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. Default value is 1, if you wish to inherit the parent schema setting then pass in the schema attribute to this argument.",
+		ValidateFunc: validation.IntBetween(0, 90),
+		Deprecated:   "Use snowflake_object_parameter instead",
 	},
 	"change_tracking": {
 		Type:        schema.TypeBool,
@@ -207,6 +214,11 @@ var tableSchema = map[string]*schema.Schema{
 	"tag": tagReferenceSchema,
 }
 
+// tableRenamedAttributesV0 maps the attributes renamed in schema version 1 from their old to their new names.
+var tableRenamedAttributesV0 = map[string]string{
+	"data_retention_days": "data_retention_time_in_days",
+}
+
 func Table() *schema.Resource {
 	return &schema.Resource{
 		Create: CreateTable,
@@ -218,6 +230,17 @@ func Table() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customizeTableDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    renamedAttributesResourceType(tableSchema, tableRenamedAttributesV0),
+				Upgrade: renamedAttributesStateUpgrader(tableRenamedAttributesV0),
+			},
+		},
 	}
 }
 
@@ -272,22 +295,6 @@ type columnDefault struct {
 	sequence   *string
 }
 
-func (cd *columnDefault) toSnowflakeColumnDefault() *snowflake.ColumnDefault {
-	if cd.constant != nil {
-		return snowflake.NewColumnDefaultWithConstant(*cd.constant)
-	}
-
-	if cd.expression != nil {
-		return snowflake.NewColumnDefaultWithExpression(*cd.expression)
-	}
-
-	if cd.sequence != nil {
-		return snowflake.NewColumnDefaultWithSequence(*cd.sequence)
-	}
-
-	return nil
-}
-
 func (cd *columnDefault) _type() string {
 	if cd.constant != nil {
 		return "constant"
@@ -304,96 +311,246 @@ func (cd *columnDefault) _type() string {
 	return "unknown"
 }
 
+// toExpression returns the SQL expression of the default; constants of text columns are quoted.
+func (cd *columnDefault) toExpression(dataType string) string {
+	switch {
+	case cd.expression != nil:
+		return *cd.expression
+	case cd.sequence != nil:
+		return sdk.SequenceName(*cd.sequence).String()
+	case parseColumnType(dataType).isText():
+		return snowflake.EscapeSnowflakeString(*cd.constant)
+	default:
+		return *cd.constant
+	}
+}
+
+func (cd *columnDefault) equal(other *columnDefault) bool {
+	if cd == nil || other == nil {
+		return cd == other
+	}
+	return cd._type() == other._type() && cd.toExpression("") == other.toExpression("")
+}
+
 type columnIdentity struct {
 	startNum int
 	stepNum  int
 }
 
-func (identity *columnIdentity) toSnowflakeColumnIdentity() *snowflake.ColumnIdentity {
-	snowIdentity := snowflake.ColumnIdentity{}
-	return snowIdentity.WithStartNum(identity.startNum).WithStep(identity.stepNum)
+func (identity *columnIdentity) equal(other *columnIdentity) bool {
+	if identity == nil || other == nil {
+		return identity == other
+	}
+	return *identity == *other
 }
 
 type column struct {
 	name          string
+	renamedFrom   string
 	dataType      string
+	collate       string
 	nullable      bool
 	_default      *columnDefault
 	identity      *columnIdentity
 	comment       string
 	maskingPolicy string
+	tags          tags
 }
 
-func (c column) toSnowflakeColumn() snowflake.Column {
-	sC := &snowflake.Column{}
+func (c column) defaultValueRequest() *sdk.ColumnDefaultValueRequest {
+	switch {
+	case c.identity != nil:
+		return sdk.NewColumnDefaultValueRequest().WithIdentity(sdk.NewColumnIdentityRequest(c.identity.startNum, c.identity.stepNum))
+	case c._default != nil:
+		return sdk.NewColumnDefaultValueRequest().WithExpression(sdk.String(c._default.toExpression(c.dataType)))
+	default:
+		return nil
+	}
+}
 
-	if c._default != nil {
-		sC = sC.WithDefault(c._default.toSnowflakeColumnDefault())
+func (c column) toTableColumnRequest() sdk.TableColumnRequest {
+	request := sdk.NewTableColumnRequest(quoteColumnName(c.name), sdk.DataType(c.dataType))
+	if defaultValue := c.defaultValueRequest(); defaultValue != nil {
+		request.WithDefaultValue(defaultValue)
+	}
+	if !c.nullable {
+		request.WithNotNull(sdk.Bool(true))
+	}
+	if c.collate != "" {
+		request.WithCollate(sdk.String(c.collate))
 	}
+	if c.maskingPolicy != "" {
+		request.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(c.maskingPolicy)))
+	}
+	if len(c.tags) > 0 {
+		request.WithTags(columnTagAssociations(c.tags))
+	}
+	if c.comment != "" {
+		request.WithComment(sdk.String(c.comment))
+	}
+	return *request
+}
 
-	if c.identity != nil {
-		sC = sC.WithIdentity(c.identity.toSnowflakeColumnIdentity())
+func (c column) toTableColumnAddActionRequest() *sdk.TableColumnAddActionRequest {
+	request := sdk.NewTableColumnAddActionRequest(quoteColumnName(c.name), sdk.DataType(c.dataType))
+	if defaultValue := c.defaultValueRequest(); defaultValue != nil {
+		request.WithDefaultValue(defaultValue)
 	}
+	if !c.nullable {
+		request.WithInlineConstraint(sdk.NewTableColumnAddInlineConstraintRequest().WithNotNull(sdk.Bool(true)))
+	}
+	if c.collate != "" {
+		request.WithCollate(sdk.String(c.collate))
+	}
+	if c.maskingPolicy != "" {
+		request.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(c.maskingPolicy)))
+	}
+	if len(c.tags) > 0 {
+		request.WithTags(columnTagAssociations(c.tags))
+	}
+	if c.comment != "" {
+		request.WithComment(sdk.String(c.comment))
+	}
+	return request
+}
 
-	return *sC.WithName(c.name).
-		WithType(c.dataType).
-		WithNullable(c.nullable).
-		WithComment(c.comment).
-		WithMaskingPolicy(c.maskingPolicy)
+// validateMaskingPolicy checks that the masking policy is given as database.schema.policy, as required to attach it.
+func (c column) validateMaskingPolicy() error {
+	if c.maskingPolicy != "" && len(strings.Split(c.maskingPolicy, ".")) != 3 {
+		return fmt.Errorf("masking policy %s of column %s must be a fully qualified name in the form of database.schema.policy", c.maskingPolicy, c.name)
+	}
+	return nil
 }
 
 type columns []column
 
-func (c columns) toSnowflakeColumns() []snowflake.Column {
-	sC := make([]snowflake.Column, len(c))
-	for i, col := range c {
-		sC[i] = col.toSnowflakeColumn()
-	}
-	return sC
+// changedColumn is a column present in both the old and the new definition of the table, possibly under a new name.
+type changedColumn struct {
+	oldColumn column
+	newColumn column
 }
 
-type changedColumns []changedColumn
+func (c changedColumn) renamed() bool {
+	return c.oldColumn.name != c.newColumn.name
+}
 
-type changedColumn struct {
-	newColumn             column // our new column
-	changedDataType       bool
-	changedNullConstraint bool
-	dropedDefault         bool
-	changedComment        bool
-	changedMaskingPolicy  bool
-}
-
-func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
-	changed = changedColumns{}
-	for _, cO := range c {
-		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false}
-			if cO.name == cN.name && cO.dataType != cN.dataType {
-				changeColumn.changedDataType = true
-			}
-			if cO.name == cN.name && cO.nullable != cN.nullable {
-				changeColumn.changedNullConstraint = true
-			}
-			if cO.name == cN.name && cO._default != nil && cN._default == nil {
-				changeColumn.dropedDefault = true
-			}
+// validate returns an error when the column cannot be changed with ALTER TABLE ... ALTER COLUMN.
+func (c changedColumn) validate() error {
+	o, n := c.oldColumn, c.newColumn
+	if !sameColumnType(o.dataType, n.dataType) && !canWidenColumnType(o.dataType, n.dataType) {
+		return fmt.Errorf("column %s: type cannot be changed from %s to %s in place, only increasing the length of a text column or the precision of a number column is supported", n.name, o.dataType, n.dataType)
+	}
+	if !strings.EqualFold(o.collate, n.collate) {
+		return fmt.Errorf("column %s: collation cannot be changed from %q to %q in place", n.name, o.collate, n.collate)
+	}
+	if !o.identity.equal(n.identity) {
+		return fmt.Errorf("column %s: identity cannot be changed in place", n.name)
+	}
+	if !o._default.equal(n._default) && n._default != nil && (o._default == nil || o._default.sequence == nil || n._default.sequence == nil) {
+		return fmt.Errorf("column %s: default can only be dropped or changed from one sequence to another in place", n.name)
+	}
+	return nil
+}
 
-			if cO.name == cN.name && cO.comment != cN.comment {
-				changeColumn.changedComment = true
-			}
+// alterActions returns the ALTER COLUMN actions applying the type, nullability, default and comment changes.
+func (c changedColumn) alterActions() []sdk.TableColumnAlterActionRequest {
+	o, n := c.oldColumn, c.newColumn
+	name := quoteColumnName(n.name)
+	actions := make([]sdk.TableColumnAlterActionRequest, 0)
+	if !sameColumnType(o.dataType, n.dataType) {
+		dataType := sdk.DataType(n.dataType)
+		actions = append(actions, *sdk.NewTableColumnAlterActionRequest(true, name).WithType(&dataType))
+	}
+	if o.nullable != n.nullable {
+		notNullConstraint := sdk.NewTableColumnNotNullConstraintRequest()
+		if n.nullable {
+			notNullConstraint.WithDrop(sdk.Bool(true))
+		} else {
+			notNullConstraint.WithSet(sdk.Bool(true))
+		}
+		actions = append(actions, *sdk.NewTableColumnAlterActionRequest(true, name).WithNotNullConstraint(notNullConstraint))
+	}
+	if !o._default.equal(n._default) {
+		if n._default == nil {
+			actions = append(actions, *sdk.NewTableColumnAlterActionRequest(true, name).WithDropDefault(sdk.Bool(true)))
+		} else if n._default.sequence != nil {
+			sequence := sdk.SequenceName(*n._default.sequence)
+			actions = append(actions, *sdk.NewTableColumnAlterActionRequest(true, name).WithSetDefault(&sequence))
+		}
+	}
+	if o.comment != n.comment {
+		if n.comment == "" {
+			actions = append(actions, *sdk.NewTableColumnAlterActionRequest(true, name).WithUnsetComment(sdk.Bool(true)))
+		} else {
+			actions = append(actions, *sdk.NewTableColumnAlterActionRequest(true, name).WithComment(sdk.String(n.comment)))
+		}
+	}
+	return actions
+}
 
-			if cO.name == cN.name && cO.maskingPolicy != cN.maskingPolicy {
-				changeColumn.changedMaskingPolicy = true
+// diffs matches the old and the new columns by name. A new column is matched with the old column it is renamed from,
+// unless a column with the new name already exists. The columns are renamed only through renamed_from: a column whose
+// name changes otherwise is dropped and added again.
+func (c columns) diffs(new columns) (removed columns, added columns, changed []changedColumn) {
+	matchedOld, matchedNew := make([]bool, len(c)), make([]bool, len(new))
+	for j, cN := range new {
+		if cN.renamedFrom == "" || c.index(cN.name) >= 0 || new.index(cN.renamedFrom) >= 0 {
+			continue
+		}
+		if i := c.index(cN.renamedFrom); i >= 0 && !matchedOld[i] {
+			matchedOld[i], matchedNew[j] = true, true
+			changed = append(changed, changedColumn{c[i], cN})
+		}
+	}
+	for i, cO := range c {
+		if matchedOld[i] {
+			continue
+		}
+		for j, cN := range new {
+			if !matchedNew[j] && cO.name == cN.name {
+				matchedOld[i], matchedNew[j] = true, true
+				changed = append(changed, changedColumn{cO, cN})
+				break
 			}
+		}
+	}
+	for i, cO := range c {
+		if !matchedOld[i] {
+			removed = append(removed, cO)
+		}
+	}
+	for j, cN := range new {
+		if !matchedNew[j] {
+			added = append(added, cN)
+		}
+	}
+	return removed, added, changed
+}
 
-			changed = append(changed, changeColumn)
+func (c columns) index(name string) int {
+	for i, column := range c {
+		if column.name == name {
+			return i
 		}
 	}
-	return
+	return -1
 }
 
-func (c columns) diffs(new columns) (removed columns, added columns, changed changedColumns) {
-	return c.getNewIn(new), new.getNewIn(c), c.getChangedColumnProperties(new)
+// validateRenames checks that every column renamed from another one can be renamed; the columns that already
+// have their new name in the table are skipped, as they were renamed before.
+func (c columns) validateRenames(new columns) error {
+	for _, cN := range new {
+		if cN.renamedFrom == "" || cN.renamedFrom == cN.name || c.index(cN.name) >= 0 {
+			continue
+		}
+		if new.index(cN.renamedFrom) >= 0 {
+			return fmt.Errorf("column %s cannot be renamed from %s, because a column named %s is still defined", cN.name, cN.renamedFrom, cN.renamedFrom)
+		}
+		if c.index(cN.renamedFrom) < 0 {
+			return fmt.Errorf("column %s cannot be renamed from %s, because the table has no column named %s", cN.name, cN.renamedFrom, cN.renamedFrom)
+		}
+	}
+	return nil
 }
 
 func getColumnDefault(def map[string]interface{}) *columnDefault {
@@ -442,21 +599,24 @@ func getColumn(from interface{}) (to column) {
 	_default := c["default"].([]interface{})
 	identity := c["identity"].([]interface{})
 
-	if len(_default) == 1 {
+	if len(_default) == 1 && _default[0] != nil {
 		cd = getColumnDefault(_default[0].(map[string]interface{}))
 	}
-	if len(identity) == 1 {
+	if len(identity) == 1 && identity[0] != nil {
 		id = getColumnIdentity(identity[0].(map[string]interface{}))
 	}
 
 	return column{
 		name:          c["name"].(string),
+		renamedFrom:   c["renamed_from"].(string),
 		dataType:      c["type"].(string),
+		collate:       c["collate"].(string),
 		nullable:      c["nullable"].(bool),
 		_default:      cd,
 		identity:      id,
 		comment:       c["comment"].(string),
 		maskingPolicy: c["masking_policy"].(string),
+		tags:          getTags(c["tag"]),
 	}
 }
 
@@ -469,6 +629,227 @@ func getColumns(from interface{}) (to columns) {
 	return to
 }
 
+// flattenTableColumns converts the described columns into the column blocks. Column tags and previous names are not
+// returned by DESCRIBE TABLE, so they are kept from the current columns, as are masking policy names referring to the same policy.
+func flattenTableColumns(details []sdk.TableColumnDetails, current columns) []interface{} {
+	flattened := make([]interface{}, 0, len(details))
+	for _, detail := range details {
+		if detail.Kind != "COLUMN" {
+			continue
+		}
+		dataType, collate := splitColumnCollation(string(detail.Type))
+		flat := map[string]interface{}{
+			"name":           detail.Name,
+			"type":           dataType,
+			"collate":        collate,
+			"nullable":       detail.IsNullable,
+			"comment":        "",
+			"masking_policy": "",
+		}
+		if detail.Comment != nil {
+			flat["comment"] = *detail.Comment
+		}
+		if detail.PolicyName != nil {
+			flat["masking_policy"] = *detail.PolicyName
+		}
+		if detail.Default != nil {
+			def, identity := parseColumnDefault(*detail.Default, dataType)
+			if def != nil {
+				flat["default"] = []interface{}{map[string]interface{}{
+					"constant":   stringValue(def.constant),
+					"expression": stringValue(def.expression),
+					"sequence":   stringValue(def.sequence),
+				}}
+			}
+			if identity != nil {
+				flat["identity"] = []interface{}{map[string]interface{}{
+					"start_num": identity.startNum,
+					"step_num":  identity.stepNum,
+				}}
+			}
+		}
+		for _, c := range current {
+			if c.name != detail.Name {
+				continue
+			}
+			if sameIdentifier(c.maskingPolicy, flat["masking_policy"].(string)) {
+				flat["masking_policy"] = c.maskingPolicy
+			}
+			flat["renamed_from"] = c.renamedFrom
+			flat["tag"] = flattenColumnTags(c.tags)
+		}
+		flattened = append(flattened, flat)
+	}
+	return flattened
+}
+
+// parseColumnDefault parses the default of a described column; identity columns are described with a default
+// in the form of IDENTITY START 1 INCREMENT 1.
+func parseColumnDefault(value string, dataType string) (*columnDefault, *columnIdentity) {
+	switch {
+	case strings.HasSuffix(value, ".NEXTVAL"):
+		sequence := strings.TrimSuffix(value, ".NEXTVAL")
+		return &columnDefault{sequence: &sequence}, nil
+	case strings.Contains(value, "(") && strings.Contains(value, ")"):
+		return &columnDefault{expression: &value}, nil
+	case parseColumnType(dataType).isText():
+		constant := snowflake.UnescapeSnowflakeString(value)
+		return &columnDefault{constant: &constant}, nil
+	case strings.Contains(value, "IDENTITY"):
+		split := strings.Split(value, " ")
+		if len(split) < 5 {
+			return nil, nil
+		}
+		start, _ := strconv.Atoi(split[2])
+		step, _ := strconv.Atoi(split[4])
+		return nil, &columnIdentity{start, step}
+	default:
+		return &columnDefault{constant: &value}, nil
+	}
+}
+
+// splitColumnCollation splits a described column type, e.g. VARCHAR(16777216) COLLATE 'en-ci', into the type and the collation.
+func splitColumnCollation(dataType string) (string, string) {
+	before, after, found := strings.Cut(dataType, " COLLATE ")
+	if !found {
+		return dataType, ""
+	}
+	return before, strings.Trim(after, `'`)
+}
+
+func flattenColumnTags(t tags) []interface{} {
+	flattened := make([]interface{}, len(t))
+	for i, tag := range t {
+		flattened[i] = map[string]interface{}{
+			"name":     tag.name,
+			"value":    tag.value,
+			"database": tag.database,
+			"schema":   tag.schema,
+		}
+	}
+	return flattened
+}
+
+func columnTagAssociations(t tags) []sdk.TagAssociation {
+	associations := make([]sdk.TagAssociation, len(t))
+	for i, tag := range t {
+		associations[i] = sdk.TagAssociation{
+			Name:  sdk.NewSchemaObjectIdentifier(tag.database, tag.schema, tag.name),
+			Value: tag.value,
+		}
+	}
+	return associations
+}
+
+// quoteColumnName returns the column name as a quoted identifier, so that its case is preserved.
+func quoteColumnName(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
+
+// sameIdentifier reports whether both names refer to the same object, ignoring quotes and case.
+func sameIdentifier(a, b string) bool {
+	return strings.EqualFold(strings.ReplaceAll(a, `"`, ""), strings.ReplaceAll(b, `"`, ""))
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// columnType is a column data type split into its base type and its parameters, i.e. the length of a text type
+// or the precision and scale of a number type.
+type columnType struct {
+	base   string
+	params []int
+}
+
+func parseColumnType(dataType string) columnType {
+	t := strings.ToUpper(strings.TrimSpace(dataType))
+	before, after, found := strings.Cut(t, "(")
+	if !found || !strings.HasSuffix(after, ")") {
+		return columnType{base: t}
+	}
+	parsed := columnType{base: strings.TrimSpace(before)}
+	for _, param := range strings.Split(strings.TrimSuffix(after, ")"), ",") {
+		value, err := strconv.Atoi(strings.TrimSpace(param))
+		if err != nil {
+			return columnType{base: t}
+		}
+		parsed.params = append(parsed.params, value)
+	}
+	return parsed
+}
+
+func (t columnType) isText() bool {
+	return slices.Contains([]string{"VARCHAR", "CHAR", "CHARACTER", "STRING", "TEXT", "NVARCHAR", "NVARCHAR2", "NCHAR", "CHAR VARYING", "NCHAR VARYING"}, t.base)
+}
+
+// length returns the maximum length of a text type; see https://docs.snowflake.com/en/sql-reference/data-types-text.
+func (t columnType) length() int {
+	if len(t.params) > 0 {
+		return t.params[0]
+	}
+	if slices.Contains([]string{"CHAR", "CHARACTER", "NCHAR"}, t.base) {
+		return 1
+	}
+	return 16777216
+}
+
+func (t columnType) isNumber() bool {
+	return slices.Contains([]string{"NUMBER", "DECIMAL", "NUMERIC", "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "BYTEINT"}, t.base)
+}
+
+// precisionAndScale returns the precision and scale of a number type; see https://docs.snowflake.com/en/sql-reference/data-types-numeric.
+func (t columnType) precisionAndScale() (int, int) {
+	switch len(t.params) {
+	case 0:
+		return 38, 0
+	case 1:
+		return t.params[0], 0
+	default:
+		return t.params[0], t.params[1]
+	}
+}
+
+func (t columnType) isFloat() bool {
+	return slices.Contains([]string{"FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DOUBLE PRECISION", "REAL"}, t.base)
+}
+
+// sameColumnType reports whether both data types are the same type, e.g. VARCHAR and VARCHAR(16777216) or INT and NUMBER(38,0).
+func sameColumnType(a, b string) bool {
+	ta, tb := parseColumnType(a), parseColumnType(b)
+	switch {
+	case ta.isText() && tb.isText():
+		return ta.length() == tb.length()
+	case ta.isNumber() && tb.isNumber():
+		precisionA, scaleA := ta.precisionAndScale()
+		precisionB, scaleB := tb.precisionAndScale()
+		return precisionA == precisionB && scaleA == scaleB
+	case ta.isFloat() && tb.isFloat():
+		return true
+	default:
+		return ta.base == tb.base && slices.Equal(ta.params, tb.params)
+	}
+}
+
+// canWidenColumnType reports whether a column can be altered from the old to the new data type; Snowflake allows
+// increasing the length of a text column and the precision of a number column as long as the scale stays the same.
+func canWidenColumnType(oldType, newType string) bool {
+	o, n := parseColumnType(oldType), parseColumnType(newType)
+	switch {
+	case o.isText() && n.isText():
+		return n.length() >= o.length()
+	case o.isNumber() && n.isNumber():
+		oldPrecision, oldScale := o.precisionAndScale()
+		newPrecision, newScale := n.precisionAndScale()
+		return newScale == oldScale && newPrecision >= oldPrecision
+	default:
+		return false
+	}
+}
+
 type primarykey struct {
 	name string
 	keys []string
@@ -486,59 +867,110 @@ func getPrimaryKey(from interface{}) (to primarykey) {
 	return to
 }
 
-func (pk primarykey) toSnowflakePrimaryKey() snowflake.PrimaryKey {
-	snowPk := snowflake.PrimaryKey{}
-	return *snowPk.WithName(pk.name).WithKeys(pk.keys)
+func (pk primarykey) toOutOfLineConstraintRequest() *sdk.OutOfLineConstraintRequest {
+	name := pk.name
+	if name != "" {
+		name = quoteColumnName(name)
+	}
+	keys := make([]string, len(pk.keys))
+	for i, key := range pk.keys {
+		keys[i] = quoteColumnName(key)
+	}
+	return sdk.NewOutOfLineConstraintRequest(name, sdk.ColumnConstraintTypePrimaryKey).WithColumns(keys)
+}
+
+// customizeTableDiff rejects during plan the column changes that Snowflake cannot apply to an existing table.
+func customizeTableDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("column") {
+		return nil
+	}
+	o, n := d.GetChange("column")
+	newColumns := getColumns(n)
+	for i := range newColumns {
+		for _, key := range []string{"name", "type", "collate", "masking_policy", "default.0.constant", "default.0.expression", "default.0.sequence", "identity.0.start_num", "identity.0.step_num"} {
+			if !d.NewValueKnown(fmt.Sprintf("column.%d.%s", i, key)) {
+				return nil
+			}
+		}
+	}
+	for _, c := range newColumns {
+		if err := c.validateMaskingPolicy(); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	oldColumns := getColumns(o)
+	if err := oldColumns.validateRenames(newColumns); err != nil {
+		return err
+	}
+	_, added, changed := oldColumns.diffs(newColumns)
+	for _, c := range added {
+		if c._default != nil && c._default._type() != "constant" {
+			return fmt.Errorf("column %s: only a constant default or an identity is supported by Snowflake when adding a column", c.name)
+		}
+	}
+	for _, c := range changed {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // CreateTable implements schema.CreateFunc.
 func CreateTable(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	database := d.Get("database").(string)
-	schema := d.Get("schema").(string)
-	name := d.Get("name").(string)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
-	columns := getColumns(d.Get("column").([]interface{}))
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	builder := snowflake.NewTableWithColumnDefinitionsBuilder(name, database, schema, columns.toSnowflakeColumns())
+	tableColumns := getColumns(d.Get("column"))
+	columnRequests := make([]sdk.TableColumnRequest, len(tableColumns))
+	for i, c := range tableColumns {
+		columnRequests[i] = c.toTableColumnRequest()
+	}
 
-	// Set optionals
+	request := sdk.NewCreateTableRequest(id, columnRequests)
 	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+		request.WithComment(sdk.String(v.(string)))
 	}
-
 	if v, ok := d.GetOk("cluster_by"); ok {
-		builder.WithClustering(expandStringList(v.([]interface{})))
+		request.WithClusterBy(expandStringList(v.([]interface{})))
 	}
-
 	if v, ok := d.GetOk("primary_key"); ok {
-		pk := getPrimaryKey(v.([]interface{}))
-		builder.WithPrimaryKey(pk.toSnowflakePrimaryKey())
+		if pk := getPrimaryKey(v); len(pk.keys) > 0 {
+			request.WithOutOfLineConstraint(*pk.toOutOfLineConstraintRequest())
+		}
 	}
-
-	if v, ok := d.GetOk("data_retention_days"); ok {
-		builder.WithDataRetentionTimeInDays(v.(int))
-	} else if v, ok := d.GetOk("data_retention_time_in_days"); ok {
-		builder.WithDataRetentionTimeInDays(v.(int))
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		request.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
 	}
-
-	if v, ok := d.GetOk("change_tracking"); ok {
-		builder.WithChangeTracking(v.(bool))
+	if v, ok := d.GetOk("change_tracking"); ok && v.(bool) {
+		request.WithChangeTracking(sdk.Bool(true))
 	}
-
-	if v, ok := d.GetOk("tag"); ok {
-		tags := getTags(v)
-		builder.WithTags(tags.toSnowflakeTagValues())
+	if _, ok := d.GetOk("tag"); ok {
+		tagAssociations := getPropertyTags(d, "tag")
+		tagAssociationRequests := make([]sdk.TagAssociationRequest, len(tagAssociations))
+		for i, t := range tagAssociations {
+			tagAssociationRequests[i] = *sdk.NewTagAssociationRequest(t.Name, t.Value)
+		}
+		request.WithTags(tagAssociationRequests)
 	}
 
-	stmt := builder.Create()
-	if err := snowflake.Exec(db, stmt); err != nil {
-		return fmt.Errorf("error creating table %v", name)
+	if err := client.Tables.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating table %v err = %w", id.FullyQualifiedName(), err)
 	}
 
 	tableID := &tableID{
-		DatabaseName: database,
-		SchemaName:   schema,
+		DatabaseName: databaseName,
+		SchemaName:   schemaName,
 		TableName:    name,
 	}
 	dataIDInput, err := tableID.String()
@@ -553,72 +985,44 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 // ReadTable implements schema.ReadFunc.
 func ReadTable(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	tableID, err := tableIDFromString(d.Id())
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	tid, err := tableIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
-	builder := snowflake.NewTableBuilder(tableID.TableName, tableID.DatabaseName, tableID.SchemaName)
+	id := sdk.NewSchemaObjectIdentifier(tid.DatabaseName, tid.SchemaName, tid.TableName)
 
-	row := snowflake.QueryRow(db, builder.Show())
-	table, err := snowflake.ScanTable(row)
-	if errors.Is(err, sql.ErrNoRows) {
+	table, err := client.Tables.ShowByID(ctx, id)
+	if err != nil {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] table (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
-	if err != nil {
-		return err
-	}
 
-	// Describe the table to read the cols
-	tableDescriptionRows, err := snowflake.Query(db, builder.ShowColumns())
+	columnDetails, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
 	if err != nil {
 		return err
 	}
 
-	tableDescription, err := snowflake.ScanTableDescription(tableDescriptionRows)
-	if err != nil {
-		return err
+	values := map[string]any{
+		"name":            table.Name,
+		"owner":           table.Owner,
+		"database":        tid.DatabaseName,
+		"schema":          tid.SchemaName,
+		"comment":         table.Comment,
+		"column":          flattenTableColumns(columnDetails, getColumns(d.Get("column"))),
+		"cluster_by":      snowflake.ClusterStatementToList(table.ClusterBy),
+		"change_tracking": table.ChangeTracking,
+		"qualified_name":  id.FullyQualifiedName(),
 	}
-
-	/*
-		deprecated as it conflicts with the new table_constraint resource
-		showPkrows, err := snowflake.Query(db, builder.ShowPrimaryKeys())
-		if err != nil {
-			return err
-		}
-
-		pkDescription, err := snowflake.ScanPrimaryKeyDescription(showPkrows)
-		if err != nil {
-			return err
-		}*/
-
-	// Set the relevant data in the state
-	toSet := map[string]interface{}{
-		"name":       table.TableName.String,
-		"owner":      table.Owner.String,
-		"database":   tableID.DatabaseName,
-		"schema":     tableID.SchemaName,
-		"comment":    table.Comment.String,
-		"column":     snowflake.NewColumns(tableDescription).Flatten(),
-		"cluster_by": snowflake.ClusterStatementToList(table.ClusterBy.String),
-		// "primary_key":         snowflake.FlattenTablePrimaryKey(pkDescription),
-		"change_tracking": (table.ChangeTracking.String == "ON"),
-		"qualified_name":  fmt.Sprintf(`"%s"."%s"."%s"`, tableID.DatabaseName, tableID.SchemaName, table.TableName.String),
-	}
-	var dataRetentionKey string
 	if _, ok := d.GetOk("data_retention_time_in_days"); ok {
-		dataRetentionKey = "data_retention_time_in_days"
-	} else if _, ok := d.GetOk("data_retention_days"); ok {
-		dataRetentionKey = "data_retention_days"
-	}
-	if dataRetentionKey != "" {
-		toSet[dataRetentionKey] = table.RetentionTime.Int32
+		values["data_retention_time_in_days"] = table.RetentionTime
 	}
 
-	for key, val := range toSet {
-		if err := d.Set(key, val); err != nil { // lintignore:R001
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
 			return err
 		}
 	}
@@ -627,190 +1031,220 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateTable implements schema.UpdateFunc.
 func UpdateTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 	tid, err := tableIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaObjectIdentifier(tid.DatabaseName, tid.SchemaName, tid.TableName)
 
-	dbName := tid.DatabaseName
-	schema := tid.SchemaName
-	tableName := tid.TableName
-
-	builder := snowflake.NewTableBuilder(tableName, dbName, schema)
-
-	db := meta.(*sql.DB)
 	if d.HasChange("name") {
-		name := d.Get("name")
-		q := builder.Rename(name.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating table name on %v", d.Id())
+		newId := sdk.NewSchemaObjectIdentifier(tid.DatabaseName, tid.SchemaName, d.Get("name").(string))
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithNewName(&newId)); err != nil {
+			return fmt.Errorf("error updating table name on %v err = %w", d.Id(), err)
 		}
 		tableID := &tableID{
-			DatabaseName: dbName,
-			SchemaName:   schema,
-			TableName:    name.(string),
+			DatabaseName: tid.DatabaseName,
+			SchemaName:   tid.SchemaName,
+			TableName:    newId.Name(),
 		}
 		dataIDInput, err := tableID.String()
 		if err != nil {
 			return err
 		}
 		d.SetId(dataIDInput)
+		id = newId
 	}
+
 	if d.HasChange("comment") {
-		comment := d.Get("comment")
-		q := builder.ChangeComment(comment.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating table comment on %v", d.Id())
+		request := sdk.NewAlterTableRequest(id)
+		if comment := d.Get("comment").(string); comment != "" {
+			request.WithSet(sdk.NewTableSetRequest().WithComment(sdk.String(comment)))
+		} else {
+			request.WithUnset(sdk.NewTableUnsetRequest().WithComment(true))
+		}
+		if err := client.Tables.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error updating table comment on %v err = %w", d.Id(), err)
 		}
 	}
+
 	if d.HasChange("column") {
-		t, n := d.GetChange("column")
-		removed, added, changed := getColumns(t).diffs(getColumns(n))
-		for _, cA := range removed {
-			q := builder.DropColumn(cA.name)
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error dropping column on %v", d.Id())
-			}
+		o, n := d.GetChange("column")
+		removed, added, changed := getColumns(o).diffs(getColumns(n))
+		if err := updateTableColumns(ctx, client, id, removed, added, changed); err != nil {
+			return fmt.Errorf("error updating columns on %v err = %w", d.Id(), err)
 		}
-		for _, cA := range added {
-			var q string
-
-			if cA.identity == nil && cA._default == nil { //nolint:gocritic  // todo: please fix this to pass gocritic
-				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, nil, nil, cA.comment, cA.maskingPolicy)
-			} else if cA.identity != nil {
-				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, nil, cA.identity.toSnowflakeColumnIdentity(), cA.comment, cA.maskingPolicy)
-			} else {
-				if cA._default._type() != "constant" {
-					return fmt.Errorf("failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name)
-				}
+	}
 
-				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, cA._default.toSnowflakeColumnDefault(), nil, cA.comment, cA.maskingPolicy)
-			}
+	if d.HasChange("cluster_by") {
+		clusteringAction := sdk.NewTableClusteringActionRequest()
+		if clusterBy := expandStringList(d.Get("cluster_by").([]interface{})); len(clusterBy) > 0 {
+			clusteringAction.WithClusterBy(clusterBy)
+		} else {
+			clusteringAction.WithDropClusteringKey(sdk.Bool(true))
+		}
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(clusteringAction)); err != nil {
+			return fmt.Errorf("error updating table clustering on %v err = %w", d.Id(), err)
+		}
+	}
 
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error adding column on %v", d.Id())
+	if d.HasChange("primary_key") {
+		o, n := d.GetChange("primary_key")
+		oldPk, newPk := getPrimaryKey(o), getPrimaryKey(n)
+		if len(oldPk.keys) > 0 {
+			dropAction := sdk.NewTableConstraintDropActionRequest(nil).WithPrimaryKey(sdk.Bool(true))
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithConstraintAction(sdk.NewTableConstraintActionRequest().WithDrop(dropAction))); err != nil {
+				return fmt.Errorf("error dropping primary key on %v err = %w", d.Id(), err)
 			}
 		}
-		for _, cA := range changed {
-			if cA.changedDataType {
-				q := builder.ChangeColumnType(cA.newColumn.name, cA.newColumn.dataType)
-				if err := snowflake.Exec(db, q); err != nil {
-					return fmt.Errorf("error changing property on %v", d.Id())
-				}
-			}
-			if cA.changedNullConstraint {
-				q := builder.ChangeNullConstraint(cA.newColumn.name, cA.newColumn.nullable)
-				if err := snowflake.Exec(db, q); err != nil {
-					return fmt.Errorf("error changing property on %v", d.Id())
-				}
+		if len(newPk.keys) > 0 {
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithConstraintAction(sdk.NewTableConstraintActionRequest().WithAdd(newPk.toOutOfLineConstraintRequest()))); err != nil {
+				return fmt.Errorf("error adding primary key on %v err = %w", d.Id(), err)
 			}
-			if cA.dropedDefault {
-				q := builder.DropColumnDefault(cA.newColumn.name)
-				if err := snowflake.Exec(db, q); err != nil {
-					return fmt.Errorf("error changing property on %v", d.Id())
-				}
+		}
+	}
+
+	set := sdk.NewTableSetRequest()
+	var runSet bool
+	if d.HasChange("data_retention_time_in_days") {
+		set.WithDataRetentionTimeInDays(sdk.Int(d.Get("data_retention_time_in_days").(int)))
+		runSet = true
+	}
+	if d.HasChange("change_tracking") {
+		set.WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+		runSet = true
+	}
+	if runSet {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating table %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error occurred when dropping tags on %v, err = %w", d.Id(), err)
 			}
-			if cA.changedComment {
-				q := builder.ChangeColumnComment(cA.newColumn.name, cA.newColumn.comment)
-				if err := snowflake.Exec(db, q); err != nil {
-					return fmt.Errorf("error changing property on %v", d.Id())
-				}
+		}
+		if len(setTags) > 0 {
+			tagAssociationRequests := make([]sdk.TagAssociationRequest, len(setTags))
+			for i, t := range setTags {
+				tagAssociationRequests[i] = *sdk.NewTagAssociationRequest(t.Name, t.Value)
 			}
-			if cA.changedMaskingPolicy {
-				q := builder.ChangeColumnMaskingPolicy(cA.newColumn.name, cA.newColumn.maskingPolicy)
-				if err := snowflake.Exec(db, q); err != nil {
-					return fmt.Errorf("error changing property on %v", d.Id())
-				}
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetTags(tagAssociationRequests)); err != nil {
+				return fmt.Errorf("error occurred when setting tags on %v, err = %w", d.Id(), err)
 			}
 		}
 	}
-	if d.HasChange("cluster_by") {
-		cb := expandStringList(d.Get("cluster_by").([]interface{}))
 
-		var q string
-		if len(cb) != 0 {
-			builder.WithClustering(cb)
-			q = builder.ChangeClusterBy(builder.GetClusterKeyString())
-		} else {
-			q = builder.DropClustering()
+	return ReadTable(d, meta)
+}
+
+// updateTableColumns renames, alters, adds and drops the columns, in this order. Renames go first, so that
+// the remaining statements can refer to the columns by their new names.
+func updateTableColumns(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, removed columns, added columns, changed []changedColumn) error {
+	alterColumns := func(columnAction *sdk.TableColumnActionRequest) error {
+		return client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
+	}
+
+	// the changes are validated during plan too, but the validation is skipped there when some values are not known yet
+	for _, c := range changed {
+		if err := c.validate(); err != nil {
+			return err
 		}
+	}
 
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating table clustering on %v", d.Id())
+	for _, c := range changed {
+		if c.renamed() {
+			if err := alterColumns(sdk.NewTableColumnActionRequest().WithRename(sdk.NewTableColumnRenameActionRequest(quoteColumnName(c.oldColumn.name), quoteColumnName(c.newColumn.name)))); err != nil {
+				return err
+			}
 		}
 	}
-	if d.HasChange("primary_key") {
-		opk, npk := d.GetChange("primary_key")
 
-		newpk := getPrimaryKey(npk)
-		oldpk := getPrimaryKey(opk)
+	alterActions := make([]sdk.TableColumnAlterActionRequest, 0)
+	for _, c := range changed {
+		alterActions = append(alterActions, c.alterActions()...)
+	}
+	if len(alterActions) > 0 {
+		if err := alterColumns(sdk.NewTableColumnActionRequest().WithAlter(alterActions)); err != nil {
+			return err
+		}
+	}
 
-		if len(oldpk.keys) > 0 || len(newpk.keys) == 0 {
-			// drop our pk if there was an old primary key, or pk has been removed
-			q := builder.DropPrimaryKey()
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error changing primary key first on %v", d.Id())
+	for _, c := range changed {
+		name := quoteColumnName(c.newColumn.name)
+		if oldPolicy, newPolicy := c.oldColumn.maskingPolicy, c.newColumn.maskingPolicy; oldPolicy != newPolicy {
+			columnAction := sdk.NewTableColumnActionRequest()
+			if newPolicy == "" {
+				columnAction.WithUnsetMaskingPolicy(sdk.NewTableColumnAlterUnsetMaskingPolicyActionRequest(name))
+			} else {
+				setMaskingPolicy := sdk.NewTableColumnAlterSetMaskingPolicyActionRequest(name, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(newPolicy), nil)
+				// replacing a masking policy requires FORCE, otherwise the current one has to be unset first
+				if oldPolicy != "" {
+					setMaskingPolicy.WithForce(sdk.Bool(true))
+				}
+				columnAction.WithSetMaskingPolicy(setMaskingPolicy)
+			}
+			if err := alterColumns(columnAction); err != nil {
+				return err
 			}
 		}
 
-		if len(newpk.keys) > 0 {
-			// add our new pk
-			q := builder.ChangePrimaryKey(newpk.toSnowflakePrimaryKey())
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error changing property on %v", d.Id())
+		removedTags, addedTags, changedTags := c.oldColumn.tags.diffs(c.newColumn.tags)
+		if len(removedTags) > 0 {
+			unsetTags := make([]sdk.ObjectIdentifier, len(removedTags))
+			for i, t := range removedTags {
+				unsetTags[i] = sdk.NewSchemaObjectIdentifier(t.database, t.schema, t.name)
+			}
+			if err := alterColumns(sdk.NewTableColumnActionRequest().WithUnsetTags(sdk.NewTableColumnAlterUnsetTagsActionRequest(name, unsetTags))); err != nil {
+				return err
 			}
 		}
-	}
-	updateDataRetention := func(key string) error {
-		if d.HasChange(key) {
-			ndr := d.Get(key)
-			q := builder.ChangeDataRetention(ndr.(int))
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error changing property on %v", d.Id())
+		setTags := make(tags, 0, len(addedTags)+len(changedTags))
+		setTags = append(setTags, addedTags...)
+		setTags = append(setTags, changedTags...)
+		if len(setTags) > 0 {
+			if err := alterColumns(sdk.NewTableColumnActionRequest().WithSetTags(sdk.NewTableColumnAlterSetTagsActionRequest(name, columnTagAssociations(setTags)))); err != nil {
+				return err
 			}
 		}
-		return nil
-	}
-	err = updateDataRetention("data_retention_days")
-	if err != nil {
-		return err
-	}
-	err = updateDataRetention("data_retention_time_in_days")
-	if err != nil {
-		return err
 	}
-	if d.HasChange("change_tracking") {
-		nct := d.Get("change_tracking")
 
-		q := builder.ChangeChangeTracking(nct.(bool))
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error changing property on %v", d.Id())
+	for _, c := range added {
+		if err := alterColumns(sdk.NewTableColumnActionRequest().WithAdd(c.toTableColumnAddActionRequest())); err != nil {
+			return err
 		}
 	}
-	tagChangeErr := handleTagChanges(db, d, builder)
-	if tagChangeErr != nil {
-		return tagChangeErr
-	}
 
-	return ReadTable(d, meta)
+	if len(removed) > 0 {
+		names := make([]string, len(removed))
+		for i, c := range removed {
+			names[i] = quoteColumnName(c.name)
+		}
+		if err := alterColumns(sdk.NewTableColumnActionRequest().WithDropColumns(names)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // DeleteTable implements schema.DeleteFunc.
 func DeleteTable(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	tableID, err := tableIDFromString(d.Id())
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	tid, err := tableIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaObjectIdentifier(tid.DatabaseName, tid.SchemaName, tid.TableName)
 
-	dbName := tableID.DatabaseName
-	schemaName := tableID.SchemaName
-	tableName := tableID.TableName
-
-	q := snowflake.NewTableBuilder(tableName, dbName, schemaName).Drop()
-
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error deleting pipe %v err = %w", d.Id(), err)
+	if err := client.Tables.Drop(ctx, sdk.NewDropTableRequest(id)); err != nil {
+		return fmt.Errorf("error deleting table %v err = %w", d.Id(), err)
 	}
 
 	d.SetId("")
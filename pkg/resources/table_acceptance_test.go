@@ -1,14 +1,20 @@
 package resources_test
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAcc_TableWithSeparateDataRetentionObjectParameterWithoutLifecycle(t *testing.T) {
@@ -18,9 +24,9 @@ func TestAcc_TableWithSeparateDataRetentionObjectParameterWithoutLifecycle(t *te
 
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableConfig(accName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -67,9 +73,9 @@ func TestAcc_TableWithSeparateDataRetentionObjectParameterWithLifecycle(t *testi
 
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableConfig(accName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -132,9 +138,9 @@ func TestAcc_Table(t *testing.T) {
 	table3Name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableConfig(accName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -869,9 +875,9 @@ func TestAcc_TableDefaults(t *testing.T) {
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableColumnWithDefaults(accName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -1006,9 +1012,9 @@ func TestAcc_TableTags(t *testing.T) {
 	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	tag2Name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableWithTags(accName, tagName, tag2Name, acc.TestDatabaseName, acc.TestSchemaName),
@@ -1081,9 +1087,9 @@ func TestAcc_TableIdentity(t *testing.T) {
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableColumnWithIdentityDefault(accName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -1215,9 +1221,9 @@ func TestAcc_TableRename(t *testing.T) {
 	oldTableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	newTableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: tableConfigWithName(oldTableName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -1263,3 +1269,188 @@ resource "snowflake_table" "test_table" {
 `
 	return fmt.Sprintf(s, tableName, databaseName, schemaName)
 }
+
+func TestAcc_TableColumnEvolution(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableColumnEvolutionConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, "column1", "VARCHAR(16)", "NUMBER(10,2)", true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.name", "column1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.type", "VARCHAR(16)"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.collate", "en-ci"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.type", "NUMBER(10,2)"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.nullable", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.comment", ""),
+				),
+			},
+			{
+				Config: tableColumnEvolutionConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, "renamed", "VARCHAR(200)", "NUMBER(20,2)", false, "widened"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.name", "renamed"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.type", "VARCHAR(200)"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.collate", "en-ci"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.type", "NUMBER(20,2)"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.nullable", "false"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.comment", "widened"),
+				),
+			},
+			{
+				Config:      tableColumnEvolutionConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, "renamed", "VARCHAR(20)", "NUMBER(20,2)", false, "widened"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`column renamed: type cannot be changed from VARCHAR\(200\) to VARCHAR\(20\) in place`),
+			},
+		},
+	})
+}
+
+func tableColumnEvolutionConfig(name string, databaseName string, schemaName string, textColumnName string, textType string, numberType string, nullable bool, comment string) string {
+	s := `
+resource "snowflake_table" "test_table" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+	column {
+		name         = "%s"
+		renamed_from = "column1"
+		type         = "%s"
+		collate      = "en-ci"
+	}
+	column {
+		name     = "amount"
+		type     = "%s"
+		nullable = %t
+		comment  = "%s"
+	}
+}
+`
+	return fmt.Sprintf(s, name, databaseName, schemaName, textColumnName, textType, numberType, nullable, comment)
+}
+
+func TestAcc_TableColumnRenamedFrom(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, accName)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableColumnRenamedFromConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, "other", "old_name", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.name", "old_name"),
+				),
+			},
+			// the column is renamed with renamed_from, so its data is preserved
+			{
+				PreConfig: func() {
+					execInTestWarehouse(t, fmt.Sprintf(`INSERT INTO %s ("other", "old_name") VALUES (1, 'preserved')`, tableId.FullyQualifiedName()))
+				},
+				Config: tableColumnRenamedFromConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, "new_name", "other", "old_name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.name", "new_name"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.renamed_from", "old_name"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.name", "other"),
+					testAccCheckTableColumnValue(tableId, "new_name", "preserved"),
+				),
+			},
+			// renamed_from is ignored once the column has its new name
+			{
+				Config:   tableColumnRenamedFromConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, "new_name", "other", "old_name"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func tableColumnRenamedFromConfig(name string, databaseName string, schemaName string, firstColumnName string, secondColumnName string, renamedFrom string) string {
+	firstColumnType, secondColumnType := "NUMBER(38,0)", "VARCHAR(16)"
+	if renamedFrom != "" {
+		firstColumnType, secondColumnType = secondColumnType, firstColumnType
+	}
+	s := `
+resource "snowflake_table" "test_table" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+	column {
+		name         = "%s"
+		type         = "%s"
+		renamed_from = "%s"
+	}
+	column {
+		name = "%s"
+		type = "%s"
+	}
+}
+`
+	return fmt.Sprintf(s, name, databaseName, schemaName, firstColumnName, firstColumnType, renamedFrom, secondColumnName, secondColumnType)
+}
+
+// execInTestWarehouse runs the statement outside Terraform on a connection using the test warehouse.
+func execInTestWarehouse(t *testing.T, statement string) {
+	t.Helper()
+	ctx := context.Background()
+	conn, err := testWarehouseConn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, statement); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckTableColumnValue(tableId sdk.SchemaObjectIdentifier, columnName string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+		conn, err := testWarehouseConn(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		var value string
+		if err := conn.QueryRowContext(ctx, fmt.Sprintf(`SELECT "%s" FROM %s`, columnName, tableId.FullyQualifiedName())).Scan(&value); err != nil {
+			return err
+		}
+		if value != expected {
+			return fmt.Errorf("expected column %s to hold %s, got %s", columnName, expected, value)
+		}
+		return nil
+	}
+}
+
+// testWarehouseConn returns a single connection, so that the warehouse set on it is used by the following statements.
+func testWarehouseConn(ctx context.Context) (*sql.Conn, error) {
+	client, err := sdk.NewDefaultClient()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.GetConn().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE WAREHOUSE %s", sdk.NewAccountObjectIdentifier(acc.TestWarehouseName).FullyQualifiedName())); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
@@ -10,9 +10,9 @@ import (
 
 func TestAcc_TableColumnMaskingPolicyApplication(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: maskingPolicyApplicationTestConfig(acc.TestDatabaseName, acc.TestSchemaName),
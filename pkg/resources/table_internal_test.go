@@ -1,9 +1,11 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,3 +72,222 @@ func TestTableStruct(t *testing.T) {
 	r.Equal("database|name", newTable.DatabaseName)
 	r.Equal("table|name", newTable.TableName)
 }
+
+func TestTableColumnsDiffs(t *testing.T) {
+	names := func(c columns) []string {
+		result := make([]string, len(c))
+		for i, column := range c {
+			result[i] = column.name
+		}
+		return result
+	}
+
+	t.Run("matched by name", func(t *testing.T) {
+		old := columns{{name: "a", dataType: "VARIANT"}, {name: "b", dataType: "VARCHAR(16)"}}
+		new := columns{{name: "b", dataType: "VARCHAR(16777216)"}, {name: "c", dataType: "FLOAT"}}
+		removed, added, changed := old.diffs(new)
+		require.Equal(t, []string{"a"}, names(removed))
+		require.Equal(t, []string{"c"}, names(added))
+		require.Len(t, changed, 1)
+		require.False(t, changed[0].renamed())
+		require.Equal(t, "VARCHAR(16777216)", changed[0].newColumn.dataType)
+	})
+
+	t.Run("renamed without renamed_from", func(t *testing.T) {
+		old := columns{{name: "a", dataType: "VARIANT"}, {name: "b", dataType: "VARCHAR"}}
+		new := columns{{name: "a", dataType: "VARIANT"}, {name: "c", dataType: "VARCHAR(16777216)"}}
+		removed, added, changed := old.diffs(new)
+		require.Equal(t, []string{"b"}, names(removed))
+		require.Equal(t, []string{"c"}, names(added))
+		require.Len(t, changed, 1)
+		require.False(t, changed[0].renamed())
+	})
+
+	t.Run("renamed explicitly", func(t *testing.T) {
+		old := columns{{name: "a", dataType: "VARIANT"}, {name: "b", dataType: "VARCHAR"}}
+		new := columns{{name: "c", renamedFrom: "b", dataType: "VARCHAR(200)"}}
+		removed, added, changed := old.diffs(new)
+		require.Equal(t, []string{"a"}, names(removed))
+		require.Empty(t, added)
+		require.Len(t, changed, 1)
+		require.True(t, changed[0].renamed())
+		require.Equal(t, "b", changed[0].oldColumn.name)
+		require.Equal(t, "c", changed[0].newColumn.name)
+	})
+
+	t.Run("already renamed", func(t *testing.T) {
+		old := columns{{name: "b", dataType: "VARCHAR"}, {name: "c", dataType: "VARCHAR"}}
+		new := columns{{name: "b", dataType: "VARCHAR"}, {name: "c", renamedFrom: "b", dataType: "VARCHAR"}}
+		removed, added, changed := old.diffs(new)
+		require.Empty(t, removed)
+		require.Empty(t, added)
+		require.Len(t, changed, 2)
+		require.False(t, changed[0].renamed())
+		require.False(t, changed[1].renamed())
+		require.NoError(t, old.validateRenames(new))
+	})
+}
+
+func TestTableChangedColumn(t *testing.T) {
+	sequence := func(name string) *columnDefault {
+		return &columnDefault{sequence: &name}
+	}
+	constant := func(value string) *columnDefault {
+		return &columnDefault{constant: &value}
+	}
+
+	t.Run("alter actions", func(t *testing.T) {
+		c := changedColumn{
+			oldColumn: column{name: "a", dataType: "NUMBER(10,2)", nullable: true, _default: sequence("seq1"), comment: "comment"},
+			newColumn: column{name: "a", dataType: "NUMBER(20,2)", nullable: false, _default: sequence("seq2")},
+		}
+		require.NoError(t, c.validate())
+		actions := c.alterActions()
+		require.Len(t, actions, 4)
+		require.Equal(t, `"a"`, actions[0].Name)
+		require.Equal(t, sdk.DataType("NUMBER(20,2)"), *actions[0].Type)
+		require.True(t, *actions[1].NotNullConstraint.Set)
+		require.Equal(t, sdk.SequenceName("seq2"), *actions[2].SetDefault)
+		require.True(t, *actions[3].UnsetComment)
+	})
+
+	t.Run("equivalent types", func(t *testing.T) {
+		c := changedColumn{
+			oldColumn: column{name: "a", dataType: "NUMBER(38,0)", nullable: true},
+			newColumn: column{name: "a", dataType: "INT", nullable: true},
+		}
+		require.NoError(t, c.validate())
+		require.Empty(t, c.alterActions())
+	})
+
+	t.Run("dropped default", func(t *testing.T) {
+		c := changedColumn{
+			oldColumn: column{name: "a", dataType: "VARCHAR", _default: constant("x")},
+			newColumn: column{name: "a", dataType: "VARCHAR"},
+		}
+		require.NoError(t, c.validate())
+		actions := c.alterActions()
+		require.Len(t, actions, 1)
+		require.True(t, *actions[0].DropDefault)
+	})
+
+	t.Run("invalid change not validated during plan", func(t *testing.T) {
+		client := sdk.NewDryRunClient()
+		changed := []changedColumn{
+			{
+				oldColumn: column{name: "a", dataType: "VARCHAR(10)"},
+				newColumn: column{name: "a", dataType: "VARCHAR(20)"},
+			},
+			{
+				oldColumn: column{name: "b", dataType: "VARCHAR", _default: constant("x")},
+				newColumn: column{name: "b", dataType: "VARCHAR", _default: constant("y")},
+			},
+		}
+		err := updateTableColumns(context.Background(), client, sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE"), nil, nil, changed)
+		require.ErrorContains(t, err, "column b: default can only be dropped or changed from one sequence to another in place")
+		require.Empty(t, client.TraceLogs())
+	})
+
+	t.Run("invalid changes", func(t *testing.T) {
+		testCases := map[string]struct {
+			old           column
+			new           column
+			expectedError string
+		}{
+			"narrowed text": {
+				old:           column{name: "a", dataType: "VARCHAR(20)"},
+				new:           column{name: "a", dataType: "VARCHAR(10)"},
+				expectedError: "column a: type cannot be changed from VARCHAR(20) to VARCHAR(10) in place",
+			},
+			"changed scale": {
+				old:           column{name: "a", dataType: "NUMBER(10,2)"},
+				new:           column{name: "a", dataType: "NUMBER(12,4)"},
+				expectedError: "column a: type cannot be changed from NUMBER(10,2) to NUMBER(12,4) in place",
+			},
+			"changed type": {
+				old:           column{name: "a", dataType: "VARCHAR"},
+				new:           column{name: "a", dataType: "NUMBER"},
+				expectedError: "column a: type cannot be changed from VARCHAR to NUMBER in place",
+			},
+			"changed collation": {
+				old:           column{name: "a", dataType: "VARCHAR", collate: "en"},
+				new:           column{name: "a", dataType: "VARCHAR", collate: "en-ci"},
+				expectedError: `column a: collation cannot be changed from "en" to "en-ci" in place`,
+			},
+			"changed identity": {
+				old:           column{name: "a", dataType: "NUMBER", identity: &columnIdentity{1, 1}},
+				new:           column{name: "a", dataType: "NUMBER", identity: &columnIdentity{1, 2}},
+				expectedError: "column a: identity cannot be changed in place",
+			},
+			"changed constant default": {
+				old:           column{name: "a", dataType: "VARCHAR", _default: constant("x")},
+				new:           column{name: "a", dataType: "VARCHAR", _default: constant("y")},
+				expectedError: "column a: default can only be dropped or changed from one sequence to another in place",
+			},
+			"added sequence default": {
+				old:           column{name: "a", dataType: "NUMBER"},
+				new:           column{name: "a", dataType: "NUMBER", _default: sequence("seq")},
+				expectedError: "column a: default can only be dropped or changed from one sequence to another in place",
+			},
+		}
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				require.ErrorContains(t, changedColumn{tc.old, tc.new}.validate(), tc.expectedError)
+			})
+		}
+	})
+}
+
+func TestTableColumnTypes(t *testing.T) {
+	require.True(t, sameColumnType("VARCHAR", "VARCHAR(16777216)"))
+	require.True(t, sameColumnType("text", "STRING"))
+	require.True(t, sameColumnType("CHAR", "VARCHAR(1)"))
+	require.True(t, sameColumnType("INT", "NUMBER(38,0)"))
+	require.True(t, sameColumnType("NUMBER(10)", "NUMBER(10,0)"))
+	require.True(t, sameColumnType("DOUBLE", "FLOAT"))
+	require.True(t, sameColumnType("TIMESTAMP_NTZ(9)", "timestamp_ntz(9)"))
+	require.False(t, sameColumnType("VARCHAR(16)", "VARCHAR"))
+	require.False(t, sameColumnType("NUMBER(10,2)", "NUMBER(10,0)"))
+	require.False(t, sameColumnType("TIMESTAMP_NTZ(9)", "TIMESTAMP_LTZ(9)"))
+
+	require.True(t, canWidenColumnType("VARCHAR(16)", "VARCHAR"))
+	require.True(t, canWidenColumnType("NUMBER(10,2)", "NUMBER(20,2)"))
+	require.True(t, canWidenColumnType("NUMBER(10)", "INT"))
+	require.False(t, canWidenColumnType("VARCHAR", "VARCHAR(16)"))
+	require.False(t, canWidenColumnType("NUMBER(10,2)", "NUMBER(20,4)"))
+	require.False(t, canWidenColumnType("VARCHAR", "NUMBER"))
+}
+
+func TestTableParseColumnDefault(t *testing.T) {
+	def, identity := parseColumnDefault("DB.SCHEMA.SEQ.NEXTVAL", "NUMBER(38,0)")
+	require.Nil(t, identity)
+	require.Equal(t, "DB.SCHEMA.SEQ", *def.sequence)
+
+	def, identity = parseColumnDefault("CURRENT_TIMESTAMP()", "TIMESTAMP_NTZ(9)")
+	require.Nil(t, identity)
+	require.Equal(t, "CURRENT_TIMESTAMP()", *def.expression)
+
+	def, identity = parseColumnDefault("'it''s'", "VARCHAR(16)")
+	require.Nil(t, identity)
+	require.Equal(t, "it's", *def.constant)
+
+	def, identity = parseColumnDefault("IDENTITY START 2 INCREMENT 4", "NUMBER(38,0)")
+	require.Nil(t, def)
+	require.Equal(t, &columnIdentity{2, 4}, identity)
+
+	def, identity = parseColumnDefault("0", "NUMBER(38,0)")
+	require.Nil(t, identity)
+	require.Equal(t, "0", *def.constant)
+
+	dataType, collate := splitColumnCollation("VARCHAR(16777216) COLLATE 'en-ci'")
+	require.Equal(t, "VARCHAR(16777216)", dataType)
+	require.Equal(t, "en-ci", collate)
+}
+
+func TestTableColumnsValidateRenames(t *testing.T) {
+	old := columns{{name: "a"}, {name: "b"}}
+
+	require.NoError(t, old.validateRenames(columns{{name: "a"}, {name: "c", renamedFrom: "b"}}))
+	require.ErrorContains(t, old.validateRenames(columns{{name: "a"}, {name: "c", renamedFrom: "a"}}), "column c cannot be renamed from a, because a column named a is still defined")
+	require.ErrorContains(t, old.validateRenames(columns{{name: "a"}, {name: "c", renamedFrom: "x"}}), "column c cannot be renamed from x, because the table has no column named x")
+}
@@ -405,23 +405,6 @@ func (t tags) diffs(new tags) (removed tags, added tags, changed tags) {
 	return t.getNewIn(new), new.getNewIn(t), t.getChangedTagProperties(new)
 }
 
-func (t columns) getNewIn(new columns) (added columns) {
-	added = columns{}
-	for _, cO := range t {
-		found := false
-		for _, cN := range new {
-			if cO.name == cN.name {
-				found = true
-				break
-			}
-		}
-		if !found {
-			added = append(added, cO)
-		}
-	}
-	return
-}
-
 type tag struct {
 	name     string
 	value    string
@@ -155,6 +155,8 @@ func Task() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: sdkValidationCustomDiff(validateTaskOnPlan, "schedule", "after", "warehouse", "user_task_managed_initial_warehouse_size", "session_parameters"),
 	}
 }
 
@@ -290,10 +292,53 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
 
-	sqlStatement := d.Get("sql_statement").(string)
-
 	taskId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
-	createRequest := sdk.NewCreateTaskRequest(taskId, sqlStatement)
+	createRequest, err := taskCreateRequest(d, taskId)
+	if err != nil {
+		return err
+	}
+
+	for _, precedingTaskId := range createRequest.After {
+		rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, precedingTaskId)
+		if err != nil {
+			return err
+		}
+		for _, rootTask := range rootTasks {
+			// if a root task is started, then it needs to be suspended before the child tasks can be created
+			if rootTask.IsStarted() {
+				err := suspendTask(ctx, client, rootTask.ID())
+				if err != nil {
+					return err
+				}
+
+				// resume the task after modifications are complete as long as it is not a standalone task
+				if !(rootTask.Name == name) {
+					defer func(identifier sdk.SchemaObjectIdentifier) { _ = resumeTask(ctx, client, identifier) }(rootTask.ID())
+				}
+			}
+		}
+	}
+
+	if err := client.Tasks.Create(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating task %s err = %w", taskId.FullyQualifiedName(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(taskId))
+
+	enabled := d.Get("enabled").(bool)
+	if enabled {
+		if err := waitForTaskStart(ctx, client, taskId); err != nil {
+			log.Printf("[WARN] failed to resume task %s", name)
+		}
+	}
+
+	return ReadTask(d, meta)
+}
+
+// taskCreateRequest builds the request used to create the task from the given configuration.
+// The root tasks of the predecessors have to be suspended before the request is sent.
+func taskCreateRequest(d resourceDataGetter, taskId sdk.SchemaObjectIdentifier) (*sdk.CreateTaskRequest, error) {
+	createRequest := sdk.NewCreateTaskRequest(taskId, d.Get("sql_statement").(string))
 
 	// Set optionals
 	if v, ok := d.GetOk("warehouse"); ok {
@@ -304,7 +349,7 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("user_task_managed_initial_warehouse_size"); ok {
 		size, err := sdk.ToWarehouseSize(v.(string))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		createRequest.WithWarehouse(sdk.NewCreateTaskWarehouseRequest().WithUserTaskManagedInitialWarehouseSize(&size))
 	}
@@ -316,7 +361,7 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("session_parameters"); ok {
 		sessionParameters, err := sdk.GetSessionParametersFrom(v.(map[string]any))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		createRequest.WithSessionParameters(sessionParameters)
 	}
@@ -342,29 +387,9 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("after"); ok {
-		after := expandStringList(v.([]interface{}))
 		precedingTasks := make([]sdk.SchemaObjectIdentifier, 0)
-		for _, dep := range after {
-			precedingTaskId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, dep)
-			rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, precedingTaskId)
-			if err != nil {
-				return err
-			}
-			for _, rootTask := range rootTasks {
-				// if a root task is started, then it needs to be suspended before the child tasks can be created
-				if rootTask.IsStarted() {
-					err := suspendTask(ctx, client, rootTask.ID())
-					if err != nil {
-						return err
-					}
-
-					// resume the task after modifications are complete as long as it is not a standalone task
-					if !(rootTask.Name == name) {
-						defer func(identifier sdk.SchemaObjectIdentifier) { _ = resumeTask(ctx, client, identifier) }(rootTask.ID())
-					}
-				}
-			}
-			precedingTasks = append(precedingTasks, precedingTaskId)
+		for _, dep := range expandStringList(v.([]interface{})) {
+			precedingTasks = append(precedingTasks, sdk.NewSchemaObjectIdentifier(taskId.DatabaseName(), taskId.SchemaName(), dep))
 		}
 		createRequest.WithAfter(precedingTasks)
 	}
@@ -373,20 +398,20 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 		createRequest.WithWhen(sdk.String(v.(string)))
 	}
 
-	if err := client.Tasks.Create(ctx, createRequest); err != nil {
-		return fmt.Errorf("error creating task %s err = %w", taskId.FullyQualifiedName(), err)
-	}
-
-	d.SetId(helpers.EncodeSnowflakeID(taskId))
+	return createRequest, nil
+}
 
-	enabled := d.Get("enabled").(bool)
-	if enabled {
-		if err := waitForTaskStart(ctx, client, taskId); err != nil {
-			log.Printf("[WARN] failed to resume task %s", name)
-		}
+// validateTaskOnPlan runs the SDK validations of the task creation against the planned state.
+func validateTaskOnPlan(ctx context.Context, client *sdk.Client, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("name") {
+		return nil
 	}
-
-	return ReadTask(d, meta)
+	taskId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	createRequest, err := taskCreateRequest(d, taskId)
+	if err != nil {
+		return err
+	}
+	return client.Tasks.Create(ctx, createRequest)
 }
 
 func waitForTaskStart(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
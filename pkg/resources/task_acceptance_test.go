@@ -188,9 +188,9 @@ var (
 
 func TestAcc_Task(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: taskConfig(initialState),
@@ -415,7 +415,7 @@ todo: this test is failing due to error message below. Need to figure out why th
 	func TestAcc_Task_Managed(t *testing.T) {
 		accName := "tst-terraform-" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 		resource.Test(t, resource.TestCase{
-			Providers:    acc.TestAccProviders(),
+			ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
 			PreCheck:     func() { acc.TestAccPreCheck(t) },
 			CheckDestroy: nil,
 			Steps: []resource.TestStep{
@@ -540,9 +540,9 @@ func TestAcc_Task_SwitchScheduled(t *testing.T) {
 	taskRootName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: taskConfigManagedScheduled(accName, taskRootName, acc.TestDatabaseName, acc.TestSchemaName),
@@ -0,0 +1,791 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"golang.org/x/exp/slices"
+)
+
+var taskGraphSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the tasks of the graph.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the tasks of the graph.",
+		ForceNew:    true,
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies if the task graph should be started (enabled) after the changes are applied or should remain suspended (default).",
+	},
+	"root": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "The root task of the graph; it is the only task running on a schedule.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the identifier for the root task; must be unique for the database and schema in which the task is created.",
+				},
+				"schedule": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The schedule for periodically running the task graph. This can be a cron or interval in minutes.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"when": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a Boolean SQL expression; multiple conditions joined with AND/OR are supported. Removing the condition recreates the task graph.",
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The warehouse the task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the task.",
+				},
+			},
+		},
+	},
+	"finalizer": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The finalizer task of the graph; it runs after all the other tasks of the graph have finished, even if some of them failed.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier for the finalizer task; must be unique for the database and schema in which the task is created.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The warehouse the task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the task.",
+				},
+			},
+		},
+	},
+	"task": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The child tasks of the graph.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier for the task; must be unique for the database and schema in which the task is created.",
+				},
+				"after": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "Specifies the names of the predecessor tasks of the task. Each of them must be either the root task or another task of the graph.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"when": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a Boolean SQL expression; multiple conditions joined with AND/OR are supported. Removing the condition recreates the task graph.",
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The warehouse the task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the task.",
+				},
+			},
+		},
+	},
+}
+
+// TaskGraph returns a pointer to the resource representing a whole graph of tasks. Contrary to the task resource,
+// the root task is suspended once before the changes to the graph are applied and resumed once after all of them succeed.
+func TaskGraph() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateTaskGraph,
+		Read:   ReadTaskGraph,
+		Update: UpdateTaskGraph,
+		Delete: DeleteTaskGraph,
+
+		Description: "Manages a whole graph (DAG) of tasks: a scheduled root task, its child tasks and an optional finalizer task. The root task is suspended once before the changes are applied and resumed once after all of them succeed.",
+		Schema:      taskGraphSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customizeTaskGraphDiff,
+	}
+}
+
+// taskGraphTask is a single task of the graph, as configured in the root, finalizer or task blocks.
+type taskGraphTask struct {
+	Name         string
+	Schedule     string
+	After        []string
+	SQLStatement string
+	When         string
+	Warehouse    string
+	Comment      string
+}
+
+type taskGraph struct {
+	Root      taskGraphTask
+	Finalizer *taskGraphTask
+	Tasks     []taskGraphTask
+}
+
+func expandTaskGraphTask(v any) taskGraphTask {
+	m := v.(map[string]any)
+	task := taskGraphTask{Name: m["name"].(string), SQLStatement: m["sql_statement"].(string)}
+	if v, ok := m["schedule"]; ok {
+		task.Schedule = v.(string)
+	}
+	if v, ok := m["after"]; ok {
+		task.After = expandStringList(v.([]any))
+	}
+	if v, ok := m["when"]; ok {
+		task.When = v.(string)
+	}
+	if v, ok := m["warehouse"]; ok {
+		task.Warehouse = v.(string)
+	}
+	if v, ok := m["comment"]; ok {
+		task.Comment = v.(string)
+	}
+	return task
+}
+
+func expandTaskGraph(root, finalizer, tasks any) *taskGraph {
+	graph := &taskGraph{}
+	if r := root.([]any); len(r) > 0 && r[0] != nil {
+		graph.Root = expandTaskGraphTask(r[0])
+	}
+	if f := finalizer.([]any); len(f) > 0 && f[0] != nil {
+		task := expandTaskGraphTask(f[0])
+		graph.Finalizer = &task
+	}
+	for _, t := range tasks.([]any) {
+		if t != nil {
+			graph.Tasks = append(graph.Tasks, expandTaskGraphTask(t))
+		}
+	}
+	return graph
+}
+
+func taskGraphFrom(d resourceDataGetter) *taskGraph {
+	return expandTaskGraph(d.Get("root"), d.Get("finalizer"), d.Get("task"))
+}
+
+func (g *taskGraph) task(name string) (taskGraphTask, bool) {
+	for _, task := range g.Tasks {
+		if task.Name == name {
+			return task, true
+		}
+	}
+	return taskGraphTask{}, false
+}
+
+// sortedTasks validates the graph and returns its child tasks ordered so that every task comes after all of its
+// predecessors. The configuration order is kept between tasks that do not depend on each other.
+func (g *taskGraph) sortedTasks() ([]taskGraphTask, error) {
+	names := map[string]bool{g.Root.Name: true}
+	if g.Finalizer != nil {
+		if names[g.Finalizer.Name] {
+			return nil, fmt.Errorf("task %s is defined more than once in the task graph", g.Finalizer.Name)
+		}
+		names[g.Finalizer.Name] = true
+	}
+	for _, task := range g.Tasks {
+		if names[task.Name] {
+			return nil, fmt.Errorf("task %s is defined more than once in the task graph", task.Name)
+		}
+		names[task.Name] = true
+	}
+	for _, task := range g.Tasks {
+		for _, predecessor := range task.After {
+			if g.Finalizer != nil && predecessor == g.Finalizer.Name {
+				return nil, fmt.Errorf("task %s cannot run after the finalizer task %s", task.Name, predecessor)
+			}
+			if !names[predecessor] {
+				return nil, fmt.Errorf("task %s runs after %s, which is not part of the task graph", task.Name, predecessor)
+			}
+		}
+	}
+
+	sorted := make([]taskGraphTask, 0, len(g.Tasks))
+	done := map[string]bool{g.Root.Name: true}
+	for len(sorted) < len(g.Tasks) {
+		progressed := false
+		for _, task := range g.Tasks {
+			if done[task.Name] {
+				continue
+			}
+			ready := true
+			for _, predecessor := range task.After {
+				ready = ready && done[predecessor]
+			}
+			if ready {
+				sorted = append(sorted, task)
+				done[task.Name] = true
+				progressed = true
+			}
+		}
+		if !progressed {
+			remaining := make([]string, 0)
+			for _, task := range g.Tasks {
+				if !done[task.Name] {
+					remaining = append(remaining, task.Name)
+				}
+			}
+			return nil, fmt.Errorf("the task graph is not acyclic, there is a cycle between tasks: %s", strings.Join(remaining, ", "))
+		}
+	}
+	return sorted, nil
+}
+
+// customizeTaskGraphDiff validates the planned graph and recreates it when a condition is removed from one of
+// its tasks, because Snowflake has no statement removing the condition of an existing task.
+func customizeTaskGraphDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("root") || !d.NewValueKnown("finalizer") || !d.NewValueKnown("task") {
+		return nil
+	}
+	graph := taskGraphFrom(d)
+	if _, err := graph.sortedTasks(); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	o, _ := d.GetChange("task")
+	oldRoot, _ := d.GetChange("root")
+	oldGraph := expandTaskGraph(oldRoot, []any{}, o)
+	if oldGraph.Root.When != "" && graph.Root.When == "" {
+		return d.ForceNew("root")
+	}
+	for _, oldTask := range oldGraph.Tasks {
+		if task, ok := graph.task(oldTask.Name); ok && oldTask.When != "" && task.When == "" {
+			return d.ForceNew("task")
+		}
+	}
+	return nil
+}
+
+func taskGraphCreateRequest(id sdk.SchemaObjectIdentifier, task taskGraphTask) *sdk.CreateTaskRequest {
+	createRequest := sdk.NewCreateTaskRequest(id, task.SQLStatement)
+	if task.Warehouse != "" {
+		warehouseId := sdk.NewAccountObjectIdentifier(task.Warehouse)
+		createRequest.WithWarehouse(sdk.NewCreateTaskWarehouseRequest().WithWarehouse(&warehouseId))
+	}
+	if task.Schedule != "" {
+		createRequest.WithSchedule(sdk.String(task.Schedule))
+	}
+	if task.Comment != "" {
+		createRequest.WithComment(sdk.String(task.Comment))
+	}
+	if len(task.After) > 0 {
+		after := make([]sdk.SchemaObjectIdentifier, len(task.After))
+		for i, predecessor := range task.After {
+			after[i] = sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), predecessor)
+		}
+		createRequest.WithAfter(after)
+	}
+	if task.When != "" {
+		createRequest.WithWhen(sdk.String(task.When))
+	}
+	return createRequest
+}
+
+// alterTaskGraphTask applies the differences between the old and the new definition of a task. Predecessors are
+// compared with the given actual ones, because dropping a task removes it from the predecessors of its children.
+func alterTaskGraphTask(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, oldTask, newTask taskGraphTask, actualAfter []string) error {
+	alter := func(alterRequest *sdk.AlterTaskRequest, attribute string) error {
+		if err := client.Tasks.Alter(ctx, alterRequest); err != nil {
+			return fmt.Errorf("error updating %s on task %s err = %w", attribute, id.FullyQualifiedName(), err)
+		}
+		return nil
+	}
+
+	if oldTask.Schedule != newTask.Schedule {
+		alterRequest := sdk.NewAlterTaskRequest(id)
+		if newTask.Schedule == "" {
+			alterRequest.WithUnset(sdk.NewTaskUnsetRequest().WithSchedule(sdk.Bool(true)))
+		} else {
+			alterRequest.WithSet(sdk.NewTaskSetRequest().WithSchedule(sdk.String(newTask.Schedule)))
+		}
+		if err := alter(alterRequest, "schedule"); err != nil {
+			return err
+		}
+	}
+
+	if oldTask.Warehouse != newTask.Warehouse {
+		alterRequest := sdk.NewAlterTaskRequest(id)
+		if newTask.Warehouse == "" {
+			alterRequest.WithUnset(sdk.NewTaskUnsetRequest().WithWarehouse(sdk.Bool(true)))
+		} else {
+			alterRequest.WithSet(sdk.NewTaskSetRequest().WithWarehouse(sdk.Pointer(sdk.NewAccountObjectIdentifier(newTask.Warehouse))))
+		}
+		if err := alter(alterRequest, "warehouse"); err != nil {
+			return err
+		}
+	}
+
+	if oldTask.Comment != newTask.Comment {
+		alterRequest := sdk.NewAlterTaskRequest(id)
+		if newTask.Comment == "" {
+			alterRequest.WithUnset(sdk.NewTaskUnsetRequest().WithComment(sdk.Bool(true)))
+		} else {
+			alterRequest.WithSet(sdk.NewTaskSetRequest().WithComment(sdk.String(newTask.Comment)))
+		}
+		if err := alter(alterRequest, "comment"); err != nil {
+			return err
+		}
+	}
+
+	// predecessors are added before the old ones are removed, so that the task never becomes a standalone one
+	toAdd := make([]sdk.SchemaObjectIdentifier, 0)
+	for _, predecessor := range newTask.After {
+		if !slices.Contains(actualAfter, predecessor) {
+			toAdd = append(toAdd, sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), predecessor))
+		}
+	}
+	if len(toAdd) > 0 {
+		if err := alter(sdk.NewAlterTaskRequest(id).WithAddAfter(toAdd), "after dependencies"); err != nil {
+			return err
+		}
+	}
+	toRemove := make([]sdk.SchemaObjectIdentifier, 0)
+	for _, predecessor := range actualAfter {
+		if !slices.Contains(newTask.After, predecessor) {
+			toRemove = append(toRemove, sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), predecessor))
+		}
+	}
+	if len(toRemove) > 0 {
+		if err := alter(sdk.NewAlterTaskRequest(id).WithRemoveAfter(toRemove), "after dependencies"); err != nil {
+			return err
+		}
+	}
+
+	if newTask.When != "" && oldTask.When != newTask.When {
+		if err := alter(sdk.NewAlterTaskRequest(id).WithModifyWhen(sdk.String(newTask.When)), "when condition"); err != nil {
+			return err
+		}
+	}
+
+	if !DiffSuppressStatement("", oldTask.SQLStatement, newTask.SQLStatement, nil) {
+		if err := alter(sdk.NewAlterTaskRequest(id).WithModifyAs(sdk.String(newTask.SQLStatement)), "sql statement"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeTaskGraph resumes the child tasks and the finalizer first, and the root task last and only once.
+func resumeTaskGraph(ctx context.Context, client *sdk.Client, rootId sdk.SchemaObjectIdentifier, graph *taskGraph) error {
+	for _, task := range graph.Tasks {
+		if err := resumeTask(ctx, client, sdk.NewSchemaObjectIdentifier(rootId.DatabaseName(), rootId.SchemaName(), task.Name)); err != nil {
+			return fmt.Errorf("error resuming task %s err = %w", task.Name, err)
+		}
+	}
+	if graph.Finalizer != nil {
+		if err := resumeTask(ctx, client, sdk.NewSchemaObjectIdentifier(rootId.DatabaseName(), rootId.SchemaName(), graph.Finalizer.Name)); err != nil {
+			return fmt.Errorf("error resuming task %s err = %w", graph.Finalizer.Name, err)
+		}
+	}
+	return waitForTaskStart(ctx, client, rootId)
+}
+
+// ReadTaskGraph implements schema.ReadFunc.
+func ReadTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	rootId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	root, err := client.Tasks.ShowByID(ctx, rootId)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] root task (%s) not found or we are not authorized. Err: %v", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	tasks, err := client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(rootId.DatabaseName(), rootId.SchemaName())}))
+	if err != nil {
+		return err
+	}
+
+	var finalizer *sdk.Task
+	children := taskGraphChildren(root, tasks)
+	if root.TaskRelations.FinalizerTask != nil {
+		for i, task := range tasks {
+			if task.ID().FullyQualifiedName() == root.TaskRelations.FinalizerTask.FullyQualifiedName() {
+				finalizer = &tasks[i]
+			}
+		}
+	}
+
+	if err := d.Set("database", root.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", root.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", root.IsStarted()); err != nil {
+		return err
+	}
+	if err := d.Set("root", []any{map[string]any{
+		"name":          root.Name,
+		"schedule":      root.Schedule,
+		"sql_statement": root.Definition,
+		"when":          root.Condition,
+		"warehouse":     root.Warehouse,
+		"comment":       root.Comment,
+	}}); err != nil {
+		return err
+	}
+
+	finalizerState := make([]any, 0)
+	if finalizer != nil {
+		finalizerState = append(finalizerState, map[string]any{
+			"name":          finalizer.Name,
+			"sql_statement": finalizer.Definition,
+			"warehouse":     finalizer.Warehouse,
+			"comment":       finalizer.Comment,
+		})
+	}
+	if err := d.Set("finalizer", finalizerState); err != nil {
+		return err
+	}
+
+	configured := taskGraphFrom(d)
+	sortTaskGraphChildren(children, configured)
+	tasksState := make([]any, len(children))
+	for i, task := range children {
+		after := make([]string, len(task.Predecessors))
+		for j, predecessor := range task.Predecessors {
+			after[j] = predecessor.Name()
+		}
+		// keep the configured order of the predecessors if they did not change
+		if configuredTask, ok := configured.task(task.Name); ok && sameElements(configuredTask.After, after) {
+			after = configuredTask.After
+		}
+		tasksState[i] = map[string]any{
+			"name":          task.Name,
+			"after":         after,
+			"sql_statement": task.Definition,
+			"when":          task.Condition,
+			"warehouse":     task.Warehouse,
+			"comment":       task.Comment,
+		}
+	}
+	return d.Set("task", tasksState)
+}
+
+// taskGraphChildren returns the tasks of the schema that run after the root task, directly or through other tasks.
+func taskGraphChildren(root *sdk.Task, tasks []sdk.Task) []sdk.Task {
+	inGraph := map[string]bool{root.ID().FullyQualifiedName(): true}
+	children := make([]sdk.Task, 0)
+	for found := true; found; {
+		found = false
+		for _, task := range tasks {
+			if inGraph[task.ID().FullyQualifiedName()] {
+				continue
+			}
+			for _, predecessor := range task.Predecessors {
+				if inGraph[predecessor.FullyQualifiedName()] {
+					inGraph[task.ID().FullyQualifiedName()] = true
+					children = append(children, task)
+					found = true
+					break
+				}
+			}
+		}
+	}
+	return children
+}
+
+// sortTaskGraphChildren orders the tasks as they are configured; tasks missing from the configuration go last, by name.
+func sortTaskGraphChildren(children []sdk.Task, configured *taskGraph) {
+	position := make(map[string]int)
+	for i, task := range configured.Tasks {
+		position[task.Name] = i
+	}
+	sort.SliceStable(children, func(i, j int) bool {
+		pi, iConfigured := position[children[i].Name]
+		pj, jConfigured := position[children[j].Name]
+		switch {
+		case iConfigured && jConfigured:
+			return pi < pj
+		case iConfigured != jConfigured:
+			return iConfigured
+		default:
+			return children[i].Name < children[j].Name
+		}
+	})
+}
+
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateTaskGraph implements schema.CreateFunc.
+func CreateTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	graph := taskGraphFrom(d)
+	tasks, err := graph.sortedTasks()
+	if err != nil {
+		return err
+	}
+
+	// tasks are created suspended, so the root does not need to be suspended while the graph is built
+	rootId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, graph.Root.Name)
+	if err := client.Tasks.Create(ctx, taskGraphCreateRequest(rootId, graph.Root)); err != nil {
+		return fmt.Errorf("error creating task %s err = %w", rootId.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(rootId))
+
+	for _, task := range tasks {
+		taskId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, task.Name)
+		if err := client.Tasks.Create(ctx, taskGraphCreateRequest(taskId, task)); err != nil {
+			return fmt.Errorf("error creating task %s err = %w", taskId.FullyQualifiedName(), err)
+		}
+	}
+
+	if graph.Finalizer != nil {
+		finalizerId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, graph.Finalizer.Name)
+		if err := client.Tasks.Create(ctx, taskGraphCreateRequest(finalizerId, *graph.Finalizer).WithFinalize(&rootId)); err != nil {
+			return fmt.Errorf("error creating task %s err = %w", finalizerId.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.Get("enabled").(bool) {
+		if err := resumeTaskGraph(ctx, client, rootId, graph); err != nil {
+			return err
+		}
+	}
+
+	return ReadTaskGraph(d, meta)
+}
+
+// UpdateTaskGraph implements schema.UpdateFunc.
+func UpdateTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	rootId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	root, err := client.Tasks.ShowByID(ctx, rootId)
+	if err != nil {
+		return fmt.Errorf("error reading task %s err = %w", rootId.FullyQualifiedName(), err)
+	}
+	wasStarted := root.IsStarted()
+	if wasStarted {
+		if err := suspendTask(ctx, client, rootId); err != nil {
+			return err
+		}
+	}
+
+	graph := taskGraphFrom(d)
+	if err := updateTaskGraph(ctx, client, d, rootId, graph); err != nil {
+		// bring the graph back to its previous state, so that a failed apply does not leave it suspended
+		if wasStarted {
+			_ = resumeTask(ctx, client, rootId)
+		}
+		return err
+	}
+
+	if d.Get("enabled").(bool) {
+		if err := resumeTaskGraph(ctx, client, rootId, graph); err != nil {
+			return err
+		}
+	}
+
+	return ReadTaskGraph(d, meta)
+}
+
+// updateTaskGraph applies the changes to the graph while its root task is suspended.
+func updateTaskGraph(ctx context.Context, client *sdk.Client, d *schema.ResourceData, rootId sdk.SchemaObjectIdentifier, graph *taskGraph) error {
+	tasks, err := graph.sortedTasks()
+	if err != nil {
+		return err
+	}
+	oldRootRaw, _ := d.GetChange("root")
+	oldFinalizerRaw, _ := d.GetChange("finalizer")
+	oldTasksRaw, _ := d.GetChange("task")
+	oldGraph := expandTaskGraph(oldRootRaw, oldFinalizerRaw, oldTasksRaw)
+	oldFinalizer := oldGraph.Finalizer
+	oldTasks, err := oldGraph.sortedTasks()
+	if err != nil {
+		oldTasks = oldGraph.Tasks
+	}
+	idFor := func(name string) sdk.SchemaObjectIdentifier {
+		return sdk.NewSchemaObjectIdentifier(rootId.DatabaseName(), rootId.SchemaName(), name)
+	}
+
+	if oldFinalizer != nil && (graph.Finalizer == nil || graph.Finalizer.Name != oldFinalizer.Name) {
+		if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(idFor(oldFinalizer.Name)).WithIfExists(sdk.Bool(true))); err != nil {
+			return fmt.Errorf("error deleting task %s err = %w", idFor(oldFinalizer.Name).FullyQualifiedName(), err)
+		}
+	}
+
+	// removed tasks are dropped before the remaining ones are altered, children first
+	dropped := make(map[string]bool)
+	for i := len(oldTasks) - 1; i >= 0; i-- {
+		if _, ok := graph.task(oldTasks[i].Name); !ok {
+			if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(idFor(oldTasks[i].Name)).WithIfExists(sdk.Bool(true))); err != nil {
+				return fmt.Errorf("error deleting task %s err = %w", idFor(oldTasks[i].Name).FullyQualifiedName(), err)
+			}
+			dropped[oldTasks[i].Name] = true
+		}
+	}
+
+	if err := alterTaskGraphTask(ctx, client, rootId, oldGraph.Root, graph.Root, nil); err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		taskId := idFor(task.Name)
+		oldTask, ok := oldGraph.task(task.Name)
+		if !ok {
+			if err := client.Tasks.Create(ctx, taskGraphCreateRequest(taskId, task)); err != nil {
+				return fmt.Errorf("error creating task %s err = %w", taskId.FullyQualifiedName(), err)
+			}
+			continue
+		}
+		actualAfter := make([]string, 0, len(oldTask.After))
+		for _, predecessor := range oldTask.After {
+			if !dropped[predecessor] {
+				actualAfter = append(actualAfter, predecessor)
+			}
+		}
+		if err := alterTaskGraphTask(ctx, client, taskId, oldTask, task, actualAfter); err != nil {
+			return err
+		}
+	}
+
+	if graph.Finalizer != nil {
+		finalizerId := idFor(graph.Finalizer.Name)
+		if oldFinalizer != nil && oldFinalizer.Name == graph.Finalizer.Name {
+			return alterTaskGraphTask(ctx, client, finalizerId, *oldFinalizer, *graph.Finalizer, nil)
+		}
+		if err := client.Tasks.Create(ctx, taskGraphCreateRequest(finalizerId, *graph.Finalizer).WithFinalize(&rootId)); err != nil {
+			return fmt.Errorf("error creating task %s err = %w", finalizerId.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// DeleteTaskGraph implements schema.DeleteFunc.
+func DeleteTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	rootId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	graph := taskGraphFrom(d)
+
+	root, err := client.Tasks.ShowByID(ctx, rootId)
+	if err != nil {
+		return fmt.Errorf("error reading task %s err = %w", rootId.FullyQualifiedName(), err)
+	}
+	if root.IsStarted() {
+		if err := suspendTask(ctx, client, rootId); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, 0, len(graph.Tasks)+1)
+	if graph.Finalizer != nil {
+		names = append(names, graph.Finalizer.Name)
+	}
+	tasks, err := graph.sortedTasks()
+	if err != nil {
+		tasks = graph.Tasks
+	}
+	for i := len(tasks) - 1; i >= 0; i-- {
+		names = append(names, tasks[i].Name)
+	}
+	names = append(names, rootId.Name())
+	for _, name := range names {
+		taskId := sdk.NewSchemaObjectIdentifier(rootId.DatabaseName(), rootId.SchemaName(), name)
+		if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(taskId).WithIfExists(sdk.Bool(true))); err != nil {
+			return fmt.Errorf("error deleting task %s err = %w", taskId.FullyQualifiedName(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
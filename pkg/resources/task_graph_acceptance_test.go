@@ -0,0 +1,147 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TaskGraph(t *testing.T) {
+	prefix := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_task_graph.graph"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: taskGraphConfig(prefix, true, "SELECT 1", `
+	task {
+		name          = "%[1]s_LOAD"
+		after         = ["%[1]s_ROOT"]
+		sql_statement = "SELECT 2"
+	}
+	task {
+		name          = "%[1]s_TRANSFORM"
+		after         = ["%[1]s_LOAD"]
+		sql_statement = "SELECT 3"
+		comment       = "transform"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "root.0.name", prefix+"_ROOT"),
+					resource.TestCheckResourceAttr(resourceName, "root.0.schedule", "60 MINUTE"),
+					resource.TestCheckResourceAttr(resourceName, "finalizer.0.name", prefix+"_FINALIZER"),
+					resource.TestCheckResourceAttr(resourceName, "task.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "task.0.name", prefix+"_LOAD"),
+					resource.TestCheckResourceAttr(resourceName, "task.0.after.0", prefix+"_ROOT"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.name", prefix+"_TRANSFORM"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.after.0", prefix+"_LOAD"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.comment", "transform"),
+				),
+			},
+			// rewire the graph: drop a task, add a new one and change the predecessors of the remaining one
+			{
+				Config: taskGraphConfig(prefix, true, "SELECT 10", `
+	task {
+		name          = "%[1]s_EXTRACT"
+		after         = ["%[1]s_ROOT"]
+		sql_statement = "SELECT 4"
+	}
+	task {
+		name          = "%[1]s_TRANSFORM"
+		after         = ["%[1]s_ROOT", "%[1]s_EXTRACT"]
+		sql_statement = "SELECT 30"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "root.0.sql_statement", "SELECT 10"),
+					resource.TestCheckResourceAttr(resourceName, "task.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "task.0.name", prefix+"_EXTRACT"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.name", prefix+"_TRANSFORM"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.after.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.sql_statement", "SELECT 30"),
+					resource.TestCheckResourceAttr(resourceName, "task.1.comment", ""),
+				),
+			},
+			{
+				Config: taskGraphConfig(prefix, false, "SELECT 10", `
+	task {
+		name          = "%[1]s_EXTRACT"
+		after         = ["%[1]s_ROOT"]
+		sql_statement = "SELECT 4"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "task.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_TaskGraph_Cycle(t *testing.T) {
+	prefix := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: taskGraphConfig(prefix, false, "SELECT 1", `
+	task {
+		name          = "%[1]s_A"
+		after         = ["%[1]s_B"]
+		sql_statement = "SELECT 2"
+	}
+	task {
+		name          = "%[1]s_B"
+		after         = ["%[1]s_A"]
+		sql_statement = "SELECT 3"
+	}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the task graph is not acyclic"),
+			},
+		},
+	})
+}
+
+func taskGraphConfig(prefix string, enabled bool, rootStatement string, tasks string) string {
+	return fmt.Sprintf(`
+resource "snowflake_task_graph" "graph" {
+	database = "%[2]s"
+	schema   = "%[3]s"
+	enabled  = %[4]t
+
+	root {
+		name          = "%[1]s_ROOT"
+		schedule      = "60 MINUTE"
+		sql_statement = "%[5]s"
+		warehouse     = "%[6]s"
+	}
+
+	finalizer {
+		name          = "%[1]s_FINALIZER"
+		sql_statement = "SELECT 'done'"
+		warehouse     = "%[6]s"
+	}
+`+tasks+`
+}
+`, prefix, acc.TestDatabaseName, acc.TestSchemaName, enabled, rootStatement, acc.TestWarehouseName)
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskGraphSortedTasks(t *testing.T) {
+	names := func(tasks []taskGraphTask) []string {
+		result := make([]string, len(tasks))
+		for i, task := range tasks {
+			result[i] = task.Name
+		}
+		return result
+	}
+
+	t.Run("predecessors come first", func(t *testing.T) {
+		graph := &taskGraph{
+			Root: taskGraphTask{Name: "root"},
+			Tasks: []taskGraphTask{
+				{Name: "c", After: []string{"a", "b"}},
+				{Name: "b", After: []string{"a"}},
+				{Name: "a", After: []string{"root"}},
+				{Name: "d", After: []string{"root"}},
+			},
+		}
+		tasks, err := graph.sortedTasks()
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "d", "b", "c"}, names(tasks))
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		graph := &taskGraph{
+			Root:  taskGraphTask{Name: "root"},
+			Tasks: []taskGraphTask{{Name: "root", After: []string{"root"}}},
+		}
+		_, err := graph.sortedTasks()
+		require.ErrorContains(t, err, "task root is defined more than once in the task graph")
+	})
+
+	t.Run("unknown predecessor", func(t *testing.T) {
+		graph := &taskGraph{
+			Root:  taskGraphTask{Name: "root"},
+			Tasks: []taskGraphTask{{Name: "a", After: []string{"other"}}},
+		}
+		_, err := graph.sortedTasks()
+		require.ErrorContains(t, err, "task a runs after other, which is not part of the task graph")
+	})
+
+	t.Run("after finalizer", func(t *testing.T) {
+		graph := &taskGraph{
+			Root:      taskGraphTask{Name: "root"},
+			Finalizer: &taskGraphTask{Name: "finalizer"},
+			Tasks:     []taskGraphTask{{Name: "a", After: []string{"finalizer"}}},
+		}
+		_, err := graph.sortedTasks()
+		require.ErrorContains(t, err, "task a cannot run after the finalizer task finalizer")
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		graph := &taskGraph{
+			Root: taskGraphTask{Name: "root"},
+			Tasks: []taskGraphTask{
+				{Name: "a", After: []string{"root"}},
+				{Name: "b", After: []string{"a", "c"}},
+				{Name: "c", After: []string{"b"}},
+			},
+		}
+		_, err := graph.sortedTasks()
+		require.ErrorContains(t, err, "the task graph is not acyclic, there is a cycle between tasks: b, c")
+	})
+}
+
+func TestTaskGraphChildren(t *testing.T) {
+	task := func(name string, predecessors ...string) sdk.Task {
+		ids := make([]sdk.SchemaObjectIdentifier, len(predecessors))
+		for i, predecessor := range predecessors {
+			ids[i] = sdk.NewSchemaObjectIdentifier("db", "schema", predecessor)
+		}
+		return sdk.Task{DatabaseName: "db", SchemaName: "schema", Name: name, Predecessors: ids}
+	}
+	root := task("root")
+	tasks := []sdk.Task{
+		task("grandchild", "child"),
+		root,
+		task("other_root"),
+		task("child", "root"),
+		task("other_child", "other_root"),
+	}
+
+	children := taskGraphChildren(&root, tasks)
+	sortTaskGraphChildren(children, &taskGraph{Tasks: []taskGraphTask{{Name: "child"}}})
+
+	require.Len(t, children, 2)
+	require.Equal(t, "child", children[0].Name)
+	require.Equal(t, "grandchild", children[1].Name)
+}
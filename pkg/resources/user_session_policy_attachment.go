@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userSessionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "User name of the user you want to attach the session policy to.",
+	},
+	"session_policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the session policy to apply to the user.",
+	},
+}
+
+// UserSessionPolicyAttachment returns a pointer to the resource representing a session policy attached to a user.
+func UserSessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the session policy to use for a certain user. It overrides the session policy set on the account level.",
+
+		Create: CreateUserSessionPolicyAttachment,
+		Read:   ReadUserSessionPolicyAttachment,
+		Delete: DeleteUserSessionPolicyAttachment,
+
+		Schema: userSessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateUserSessionPolicyAttachment implements schema.CreateFunc.
+func CreateUserSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := d.Get("user_name").(string)
+	sessionPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("session_policy").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("session_policy %s is not a valid session policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("session_policy"))
+	}
+
+	err := client.Users.Alter(ctx, sdk.NewAccountObjectIdentifier(userName), &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			SessionPolicy: sdk.String(sessionPolicy.FullyQualifiedName()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(userName, sessionPolicy.DatabaseName(), sessionPolicy.SchemaName(), sessionPolicy.Name()))
+
+	return nil
+}
+
+func ReadUserSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName, sessionPolicy, err := userSessionPolicyAttachmentFromID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewAccountObjectIdentifier(userName), sdk.PolicyEntityDomainUser)
+	if err != nil {
+		return err
+	}
+	var attachedSessionPolicy *sdk.SchemaObjectIdentifier
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindSessionPolicy {
+			policyID := policyReference.PolicyID()
+			attachedSessionPolicy = &policyID
+			break
+		}
+	}
+	if attachedSessionPolicy == nil || attachedSessionPolicy.FullyQualifiedName() != sessionPolicy.FullyQualifiedName() {
+		// If the policy is no longer attached, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] session policy (%s) is not attached to user (%s)", sessionPolicy.FullyQualifiedName(), userName)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("user_name", userName); err != nil {
+		return err
+	}
+	if err := d.Set("session_policy", sessionPolicy.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUserSessionPolicyAttachment implements schema.DeleteFunc.
+func DeleteUserSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	err := client.Users.Alter(ctx, sdk.NewAccountObjectIdentifier(d.Get("user_name").(string)), &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			SessionPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// userSessionPolicyAttachmentFromID decodes the id in the form of user_name|policy_database|policy_schema|policy_name.
+func userSessionPolicyAttachmentFromID(id string) (string, sdk.SchemaObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return "", sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid user session policy attachment id %s, expected format: user_name|policy_database|policy_schema|policy_name", id)
+	}
+	return parts[0], sdk.NewSchemaObjectIdentifier(parts[1], parts[2], parts[3]), nil
+}
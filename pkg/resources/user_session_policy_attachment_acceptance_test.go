@@ -0,0 +1,55 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_UserSessionPolicyAttachment(t *testing.T) {
+	userName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	policyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: userSessionPolicyAttachmentConfig(userName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "user_name", userName),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "session_policy", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, policyName)),
+				),
+			},
+			{
+				ResourceName:      "snowflake_user_session_policy_attachment.spa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func userSessionPolicyAttachmentConfig(userName, policyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%s"
+}
+
+resource "snowflake_session_policy" "sp" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%s"
+}
+
+resource "snowflake_user_session_policy_attachment" "spa" {
+	user_name      = snowflake_user.user.name
+	session_policy = snowflake_session_policy.sp.qualified_name
+}
+`, userName, acc.TestDatabaseName, acc.TestSchemaName, policyName)
+}
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSessionPolicyAttachmentFromID(t *testing.T) {
+	t.Run("valid id", func(t *testing.T) {
+		userName, sessionPolicy, err := userSessionPolicyAttachmentFromID("user|db|schema|policy")
+		require.NoError(t, err)
+		require.Equal(t, "user", userName)
+		require.Equal(t, sdk.NewSchemaObjectIdentifier("db", "schema", "policy"), sessionPolicy)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, _, err := userSessionPolicyAttachmentFromID("user|policy")
+		require.ErrorContains(t, err, "invalid user session policy attachment id")
+	})
+}
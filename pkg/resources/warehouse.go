@@ -151,6 +151,8 @@ func Warehouse() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: sdkValidationCustomDiff(validateWarehouseOnPlan, "min_cluster_count", "max_cluster_count"),
 	}
 }
 
@@ -162,6 +164,22 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
+	createOptions, err := warehouseCreateOptions(d)
+	if err != nil {
+		return err
+	}
+
+	err = client.Warehouses.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadWarehouse(d, meta)
+}
+
+// warehouseCreateOptions builds the options used to create the warehouse from the given configuration.
+func warehouseCreateOptions(d resourceDataGetter) (*sdk.CreateWarehouseOptions, error) {
 	whType := sdk.WarehouseType(d.Get("warehouse_type").(string))
 	createOptions := &sdk.CreateWarehouseOptions{
 		Comment:                         sdk.String(d.Get("comment").(string)),
@@ -182,7 +200,7 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("warehouse_size"); ok {
 		size, err := sdk.ToWarehouseSize(v.(string))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		createOptions.WarehouseSize = &size
 	}
@@ -209,13 +227,19 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		createOptions.ResourceMonitor = sdk.String(v.(string))
 	}
 
-	err := client.Warehouses.Create(ctx, objectIdentifier, createOptions)
+	return createOptions, nil
+}
+
+// validateWarehouseOnPlan runs the SDK validations of the warehouse creation against the planned state.
+func validateWarehouseOnPlan(ctx context.Context, client *sdk.Client, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+	createOptions, err := warehouseCreateOptions(d)
 	if err != nil {
 		return err
 	}
-	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
-
-	return ReadWarehouse(d, meta)
+	return client.Warehouses.Create(ctx, sdk.NewAccountObjectIdentifier(d.Get("name").(string)), createOptions)
 }
 
 // ReadWarehouse implements schema.ReadFunc.
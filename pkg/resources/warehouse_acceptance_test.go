@@ -9,6 +9,7 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAcc_Warehouse(t *testing.T) {
@@ -20,9 +21,9 @@ func TestAcc_Warehouse(t *testing.T) {
 	prefix2 := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: wConfig(prefix),
@@ -79,9 +80,9 @@ func TestAcc_WarehousePattern(t *testing.T) {
 	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
-		Providers:    acc.TestAccProviders(),
-		PreCheck:     func() { acc.TestAccPreCheck(t) },
-		CheckDestroy: nil,
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: wConfigPattern(prefix),
@@ -94,6 +95,48 @@ func TestAcc_WarehousePattern(t *testing.T) {
 	})
 }
 
+// TestAcc_Warehouse_migrateFromSDKv2 checks that the warehouse served by the plugin framework provider
+// keeps the state of the SDKv2 implementation.
+func TestAcc_Warehouse_migrateFromSDKv2(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_WAREHOUSE_TESTS"); ok {
+		t.Skip("Skipping TestAcc_Warehouse_migrateFromSDKv2")
+	}
+
+	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	checks := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "id", prefix),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "name", prefix),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "comment", "test comment 2"),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "warehouse_size", "XLARGE"),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "auto_suspend", "60"),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "scaling_policy", "STANDARD"),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "warehouse_type", "STANDARD"),
+		resource.TestCheckResourceAttr("snowflake_warehouse.w", "statement_timeout_in_seconds", "172800"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acc.TestAccSDKv2ProtoV6ProviderFactories,
+				Config:                   wConfig2(prefix, "X-LARGE"),
+				Check:                    checks,
+			},
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				Config:                   wConfig2(prefix, "X-LARGE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: checks,
+			},
+		},
+	})
+}
+
 func wConfig(prefix string) string {
 	s := `
 resource "snowflake_warehouse" "w" {
@@ -0,0 +1,82 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateApplicationPackageOptions] = new(CreateApplicationPackageRequest)
+	_ optionsProvider[AlterApplicationPackageOptions]  = new(AlterApplicationPackageRequest)
+	_ optionsProvider[DropApplicationPackageOptions]   = new(DropApplicationPackageRequest)
+	_ optionsProvider[ShowApplicationPackageOptions]   = new(ShowApplicationPackageRequest)
+)
+
+type CreateApplicationPackageRequest struct {
+	IfNotExists                *bool
+	name                       AccountObjectIdentifier // required
+	DataRetentionTimeInDays    *int
+	MaxDataExtensionTimeInDays *int
+	DefaultDdlCollation        *string
+	Comment                    *string
+	Distribution               *Distribution
+	Tag                        []TagAssociation
+}
+
+type AlterApplicationPackageRequest struct {
+	IfExists                   *bool
+	name                       AccountObjectIdentifier // required
+	Set                        *ApplicationPackageSetRequest
+	Unset                      *ApplicationPackageUnsetRequest
+	SetDefaultReleaseDirective *SetDefaultReleaseDirectiveRequest
+	AddVersion                 *AddVersionRequest
+	DropVersion                *DropVersionRequest
+	AddPatchForVersion         *AddPatchForVersionRequest
+	SetTags                    []TagAssociation
+	UnsetTags                  []ObjectIdentifier
+}
+
+type ApplicationPackageSetRequest struct {
+	DataRetentionTimeInDays    *int
+	MaxDataExtensionTimeInDays *int
+	DefaultDdlCollation        *string
+	Comment                    *string
+	Distribution               *Distribution
+}
+
+type ApplicationPackageUnsetRequest struct {
+	DataRetentionTimeInDays    *bool
+	MaxDataExtensionTimeInDays *bool
+	DefaultDdlCollation        *bool
+	Comment                    *bool
+	Distribution               *bool
+}
+
+type SetDefaultReleaseDirectiveRequest struct {
+	Version string // required
+	Patch   int    // required
+}
+
+type AddVersionRequest struct {
+	VersionIdentifier *string
+	Using             string // required
+	Label             *string
+}
+
+type DropVersionRequest struct {
+	VersionIdentifier string // required
+}
+
+type AddPatchForVersionRequest struct {
+	VersionIdentifier string // required
+	Using             string // required
+	Label             *string
+}
+
+type DropApplicationPackageRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+}
+
+type ShowApplicationPackageRequest struct {
+	Like       *Like
+	StartsWith *string
+	Limit      *LimitFrom
+}
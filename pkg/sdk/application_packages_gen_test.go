@@ -0,0 +1,264 @@
+package sdk
+
+import "testing"
+
+func TestApplicationPackages_Create(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *CreateApplicationPackageOptions {
+		return &CreateApplicationPackageOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateApplicationPackageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.MaxDataExtensionTimeInDays = Int(2)
+		opts.DefaultDdlCollation = String("en_US")
+		opts.Comment = String("comment")
+		opts.Distribution = Pointer(DistributionInternal)
+		t1 := RandomSchemaObjectIdentifier()
+		opts.Tag = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION PACKAGE IF NOT EXISTS %s DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 DEFAULT_DDL_COLLATION = 'en_US' COMMENT = 'comment' DISTRIBUTION = INTERNAL TAG (%s = 'v1')`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestApplicationPackages_Alter(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *AlterApplicationPackageOptions {
+		return &AlterApplicationPackageOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterApplicationPackageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.UnsetTags = []ObjectIdentifier{RandomSchemaObjectIdentifier()}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApplicationPackageOptions", "Set", "Unset", "SetDefaultReleaseDirective", "AddVersion", "DropVersion", "AddPatchForVersion", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropVersion = &DropVersion{VersionIdentifier: "v1"}
+		opts.Unset = &ApplicationPackageUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApplicationPackageOptions", "Set", "Unset", "SetDefaultReleaseDirective", "AddVersion", "DropVersion", "AddPatchForVersion", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApplicationPackageSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApplicationPackageOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment", "Distribution"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApplicationPackageUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApplicationPackageOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment", "Distribution"))
+	})
+
+	t.Run("alter: set options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApplicationPackageSet{
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(2),
+			DefaultDdlCollation:        String("en_US"),
+			Comment:                    String("comment"),
+			Distribution:               Pointer(DistributionExternal),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s SET DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 DEFAULT_DDL_COLLATION = 'en_US' COMMENT = 'comment' DISTRIBUTION = EXTERNAL`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApplicationPackageUnset{
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			DefaultDdlCollation:        Bool(true),
+			Comment:                    Bool(true),
+			Distribution:               Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, DEFAULT_DDL_COLLATION, COMMENT, DISTRIBUTION`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set default release directive", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetDefaultReleaseDirective = &SetDefaultReleaseDirective{
+			Version: "v1",
+			Patch:   2,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s SET DEFAULT RELEASE DIRECTIVE VERSION = v1 PATCH = 2`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: add version", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddVersion = &AddVersion{
+			VersionIdentifier: String("v1"),
+			Using:             "@stage/path",
+			Label:             String("label"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s ADD VERSION v1 USING '@stage/path' LABEL = 'label'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: add version without identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddVersion = &AddVersion{
+			Using: "@stage/path",
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s ADD VERSION USING '@stage/path'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: drop version", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropVersion = &DropVersion{
+			VersionIdentifier: "v1",
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s DROP VERSION v1`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: add patch for version", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddPatchForVersion = &AddPatchForVersion{
+			VersionIdentifier: "v1",
+			Using:             "@stage/path",
+			Label:             String("label"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s ADD PATCH FOR VERSION v1 USING '@stage/path' LABEL = 'label'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		t1 := RandomSchemaObjectIdentifier()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s SET TAG %s = 'v1'`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		t1 := RandomSchemaObjectIdentifier()
+		opts.UnsetTags = []ObjectIdentifier{t1}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE IF EXISTS %s UNSET TAG %s`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestApplicationPackages_Drop(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *DropApplicationPackageOptions {
+		return &DropApplicationPackageOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropApplicationPackageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP APPLICATION PACKAGE IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestApplicationPackages_Show(t *testing.T) {
+	defaultOpts := func() *ShowApplicationPackageOptions {
+		return &ShowApplicationPackageOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowApplicationPackageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION PACKAGES`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.StartsWith = String("A")
+		opts.Limit = &LimitFrom{
+			Rows: Int(1),
+			From: String("B"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION PACKAGES LIKE 'pattern' STARTS WITH 'A' LIMIT 1 FROM 'B'`)
+	})
+}
+
+func TestApplicationPackages_ShowVersions(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *showApplicationPackageVersionsOptions {
+		return &showApplicationPackageVersionsOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *showApplicationPackageVersionsOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW VERSIONS IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,194 @@
+package sdk
+
+import "context"
+
+var _ ApplicationPackages = (*applicationPackages)(nil)
+
+type applicationPackages struct {
+	client *Client
+}
+
+func (v *applicationPackages) Create(ctx context.Context, request *CreateApplicationPackageRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *applicationPackages) Alter(ctx context.Context, request *AlterApplicationPackageRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *applicationPackages) Drop(ctx context.Context, request *DropApplicationPackageRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *applicationPackages) Show(ctx context.Context, request *ShowApplicationPackageRequest) ([]ApplicationPackage, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageRow, ApplicationPackage](dbRows)
+	return resultList, nil
+}
+
+func (v *applicationPackages) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error) {
+	applicationPackages, err := v.Show(ctx, NewShowApplicationPackageRequest().WithLike(&Like{String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	for _, applicationPackage := range applicationPackages {
+		if applicationPackage.Name == id.Name() {
+			return &applicationPackage, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *applicationPackages) ShowVersions(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageVersion, error) {
+	opts := &showApplicationPackageVersionsOptions{
+		name: id,
+	}
+	dbRows, err := validateAndQuery[applicationPackageVersionRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageVersionRow, ApplicationPackageVersion](dbRows)
+	return resultList, nil
+}
+
+func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOptions {
+	opts := &CreateApplicationPackageOptions{
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		DataRetentionTimeInDays:    r.DataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: r.MaxDataExtensionTimeInDays,
+		DefaultDdlCollation:        r.DefaultDdlCollation,
+		Comment:                    r.Comment,
+		Distribution:               r.Distribution,
+		Tag:                        r.Tag,
+	}
+	return opts
+}
+
+func (r *AlterApplicationPackageRequest) toOpts() *AlterApplicationPackageOptions {
+	opts := &AlterApplicationPackageOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &ApplicationPackageSet{
+			DataRetentionTimeInDays:    r.Set.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Set.MaxDataExtensionTimeInDays,
+			DefaultDdlCollation:        r.Set.DefaultDdlCollation,
+			Comment:                    r.Set.Comment,
+			Distribution:               r.Set.Distribution,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ApplicationPackageUnset{
+			DataRetentionTimeInDays:    r.Unset.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Unset.MaxDataExtensionTimeInDays,
+			DefaultDdlCollation:        r.Unset.DefaultDdlCollation,
+			Comment:                    r.Unset.Comment,
+			Distribution:               r.Unset.Distribution,
+		}
+	}
+	if r.SetDefaultReleaseDirective != nil {
+		opts.SetDefaultReleaseDirective = &SetDefaultReleaseDirective{
+			Version: r.SetDefaultReleaseDirective.Version,
+			Patch:   r.SetDefaultReleaseDirective.Patch,
+		}
+	}
+	if r.AddVersion != nil {
+		opts.AddVersion = &AddVersion{
+			VersionIdentifier: r.AddVersion.VersionIdentifier,
+			Using:             r.AddVersion.Using,
+			Label:             r.AddVersion.Label,
+		}
+	}
+	if r.DropVersion != nil {
+		opts.DropVersion = &DropVersion{
+			VersionIdentifier: r.DropVersion.VersionIdentifier,
+		}
+	}
+	if r.AddPatchForVersion != nil {
+		opts.AddPatchForVersion = &AddPatchForVersion{
+			VersionIdentifier: r.AddPatchForVersion.VersionIdentifier,
+			Using:             r.AddPatchForVersion.Using,
+			Label:             r.AddPatchForVersion.Label,
+		}
+	}
+	return opts
+}
+
+func (r *DropApplicationPackageRequest) toOpts() *DropApplicationPackageOptions {
+	opts := &DropApplicationPackageOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowApplicationPackageRequest) toOpts() *ShowApplicationPackageOptions {
+	opts := &ShowApplicationPackageOptions{
+		Like:       r.Like,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r applicationPackageRow) convert() *ApplicationPackage {
+	applicationPackage := ApplicationPackage{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		IsDefault:     r.IsDefault == "Y",
+		IsCurrent:     r.IsCurrent == "Y",
+		Distribution:  r.Distribution,
+		Owner:         r.Owner,
+		Comment:       r.Comment,
+		RetentionTime: r.RetentionTime,
+		Options:       r.Options,
+	}
+
+	if r.DroppedOn.Valid {
+		applicationPackage.DroppedOn = r.DroppedOn.String
+	}
+	if r.ApplicationClass.Valid {
+		applicationPackage.ApplicationClass = r.ApplicationClass.String
+	}
+
+	return &applicationPackage
+}
+
+func (r applicationPackageVersionRow) convert() *ApplicationPackageVersion {
+	version := ApplicationPackageVersion{
+		Version:      r.Version,
+		Patch:        r.Patch,
+		CreatedOn:    r.CreatedOn,
+		State:        r.State,
+		ReviewStatus: r.ReviewStatus,
+	}
+
+	if r.Label.Valid {
+		version.Label = r.Label.String
+	}
+	if r.Comment.Valid {
+		version.Comment = r.Comment.String
+	}
+	if r.DroppedOn.Valid {
+		version.DroppedOn = r.DroppedOn.String
+	}
+	if r.LogLevel.Valid {
+		version.LogLevel = r.LogLevel.String
+	}
+	if r.TraceLevel.Valid {
+		version.TraceLevel = r.TraceLevel.String
+	}
+
+	return &version
+}
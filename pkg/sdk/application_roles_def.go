@@ -4,11 +4,35 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
+var applicationRoleKindOfRole = g.NewQueryStruct("KindOfRole").
+	OptionalIdentifier("RoleName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("ROLE")).
+	OptionalIdentifier("ApplicationRoleName", g.KindOfT[DatabaseObjectIdentifier](), g.IdentifierOptions().SQL("APPLICATION ROLE")).
+	OptionalIdentifier("ApplicationName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("APPLICATION")).
+	WithValidation(g.ExactlyOneValueSet, "RoleName", "ApplicationRoleName", "ApplicationName")
+
 var ApplicationRolesDef = g.NewInterface(
 	"ApplicationRoles",
 	"ApplicationRole",
 	g.KindOfT[DatabaseObjectIdentifier](),
 ).
+	GrantOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/grant-application-role",
+		g.NewQueryStruct("GrantApplicationRole").
+			Grant().
+			SQL("APPLICATION ROLE").
+			Name().
+			QueryStructField("To", applicationRoleKindOfRole, g.KeywordOptions().SQL("TO").Required()).
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	RevokeOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/revoke-application-role",
+		g.NewQueryStruct("RevokeApplicationRole").
+			Revoke().
+			SQL("APPLICATION ROLE").
+			Name().
+			QueryStructField("From", applicationRoleKindOfRole, g.KeywordOptions().SQL("FROM").Required()).
+			WithValidation(g.ValidIdentifier, "name"),
+	).
 	ShowOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/show-application-roles",
 		g.DbStruct("applicationRoleDbRow").
@@ -29,4 +53,6 @@ var ApplicationRolesDef = g.NewInterface(
 			Identifier("ApplicationName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions()).
 			OptionalLimitFrom().
 			WithValidation(g.ValidIdentifier, "ApplicationName"),
-	)
+	).
+	ShowGrantsToOperation().
+	ShowGrantsOfOperation()
@@ -4,6 +4,45 @@ package sdk
 
 import ()
 
+func NewGrantApplicationRoleRequest(
+	name DatabaseObjectIdentifier,
+	To KindOfRoleRequest,
+) *GrantApplicationRoleRequest {
+	s := GrantApplicationRoleRequest{}
+	s.name = name
+	s.To = To
+	return &s
+}
+
+func NewKindOfRoleRequest() *KindOfRoleRequest {
+	return &KindOfRoleRequest{}
+}
+
+func (s *KindOfRoleRequest) WithRoleName(RoleName *AccountObjectIdentifier) *KindOfRoleRequest {
+	s.RoleName = RoleName
+	return s
+}
+
+func (s *KindOfRoleRequest) WithApplicationRoleName(ApplicationRoleName *DatabaseObjectIdentifier) *KindOfRoleRequest {
+	s.ApplicationRoleName = ApplicationRoleName
+	return s
+}
+
+func (s *KindOfRoleRequest) WithApplicationName(ApplicationName *AccountObjectIdentifier) *KindOfRoleRequest {
+	s.ApplicationName = ApplicationName
+	return s
+}
+
+func NewRevokeApplicationRoleRequest(
+	name DatabaseObjectIdentifier,
+	From KindOfRoleRequest,
+) *RevokeApplicationRoleRequest {
+	s := RevokeApplicationRoleRequest{}
+	s.name = name
+	s.From = From
+	return &s
+}
+
 func NewShowApplicationRoleRequest() *ShowApplicationRoleRequest {
 	return &ShowApplicationRoleRequest{}
 }
@@ -2,6 +2,116 @@ package sdk
 
 import "testing"
 
+func TestApplicationRoles_Grant(t *testing.T) {
+	id := RandomDatabaseObjectIdentifier()
+
+	// Minimal valid GrantApplicationRoleOptions
+	defaultOpts := func() *GrantApplicationRoleOptions {
+		return &GrantApplicationRoleOptions{
+			name: id,
+			To: KindOfRole{
+				RoleName: Pointer(RandomAccountObjectIdentifier()),
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *GrantApplicationRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewDatabaseObjectIdentifier("", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.To.RoleName opts.To.ApplicationRoleName opts.To.ApplicationName] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.To = KindOfRole{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("GrantApplicationRoleOptions.To", "RoleName", "ApplicationRoleName", "ApplicationName"))
+	})
+
+	t.Run("validation: exactly one field from [opts.To.RoleName opts.To.ApplicationRoleName opts.To.ApplicationName] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.To.ApplicationName = Pointer(RandomAccountObjectIdentifier())
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("GrantApplicationRoleOptions.To", "RoleName", "ApplicationRoleName", "ApplicationName"))
+	})
+
+	t.Run("to role", func(t *testing.T) {
+		roleId := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.To = KindOfRole{RoleName: &roleId}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT APPLICATION ROLE %s TO ROLE %s", id.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("to application role", func(t *testing.T) {
+		applicationRoleId := RandomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.To = KindOfRole{ApplicationRoleName: &applicationRoleId}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT APPLICATION ROLE %s TO APPLICATION ROLE %s", id.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("to application", func(t *testing.T) {
+		applicationId := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.To = KindOfRole{ApplicationName: &applicationId}
+		assertOptsValidAndSQLEquals(t, opts, "GRANT APPLICATION ROLE %s TO APPLICATION %s", id.FullyQualifiedName(), applicationId.FullyQualifiedName())
+	})
+}
+
+func TestApplicationRoles_Revoke(t *testing.T) {
+	id := RandomDatabaseObjectIdentifier()
+
+	// Minimal valid RevokeApplicationRoleOptions
+	defaultOpts := func() *RevokeApplicationRoleOptions {
+		return &RevokeApplicationRoleOptions{
+			name: id,
+			From: KindOfRole{
+				RoleName: Pointer(RandomAccountObjectIdentifier()),
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RevokeApplicationRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewDatabaseObjectIdentifier("", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.From.RoleName opts.From.ApplicationRoleName opts.From.ApplicationName] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.From = KindOfRole{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("RevokeApplicationRoleOptions.From", "RoleName", "ApplicationRoleName", "ApplicationName"))
+	})
+
+	t.Run("from role", func(t *testing.T) {
+		roleId := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.From = KindOfRole{RoleName: &roleId}
+		assertOptsValidAndSQLEquals(t, opts, "REVOKE APPLICATION ROLE %s FROM ROLE %s", id.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("from application role", func(t *testing.T) {
+		applicationRoleId := RandomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.From = KindOfRole{ApplicationRoleName: &applicationRoleId}
+		assertOptsValidAndSQLEquals(t, opts, "REVOKE APPLICATION ROLE %s FROM APPLICATION ROLE %s", id.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("from application", func(t *testing.T) {
+		applicationId := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.From = KindOfRole{ApplicationName: &applicationId}
+		assertOptsValidAndSQLEquals(t, opts, "REVOKE APPLICATION ROLE %s FROM APPLICATION %s", id.FullyQualifiedName(), applicationId.FullyQualifiedName())
+	})
+}
+
 func TestApplicationRoles_Show(t *testing.T) {
 	appId := RandomAccountObjectIdentifier()
 
@@ -12,6 +12,16 @@ type applicationRoles struct {
 	client *Client
 }
 
+func (v *applicationRoles) Grant(ctx context.Context, request *GrantApplicationRoleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *applicationRoles) Revoke(ctx context.Context, request *RevokeApplicationRoleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *applicationRoles) Show(ctx context.Context, request *ShowApplicationRoleRequest) ([]ApplicationRole, error) {
 	opts := request.toOpts()
 	dbRows, err := validateAndQuery[applicationRoleDbRow](v.client, ctx, opts)
@@ -30,6 +40,46 @@ func (v *applicationRoles) ShowByID(ctx context.Context, request *ShowByIDApplic
 	return collections.FindOne(appRoles, func(role ApplicationRole) bool { return role.Name == request.name.Name() })
 }
 
+func (v *applicationRoles) ShowGrantsTo(ctx context.Context, id DatabaseObjectIdentifier) ([]Grant, error) {
+	return v.client.Grants.Show(ctx, &ShowGrantOptions{
+		To: &ShowGrantsTo{
+			ApplicationRole: id,
+		},
+	})
+}
+
+func (v *applicationRoles) ShowGrantsOf(ctx context.Context, id DatabaseObjectIdentifier) ([]Grant, error) {
+	return v.client.Grants.Show(ctx, &ShowGrantOptions{
+		Of: &ShowGrantsOf{
+			ApplicationRole: id,
+		},
+	})
+}
+
+func (r *GrantApplicationRoleRequest) toOpts() *GrantApplicationRoleOptions {
+	opts := &GrantApplicationRoleOptions{
+		name: r.name,
+	}
+	opts.To = KindOfRole{
+		RoleName:            r.To.RoleName,
+		ApplicationRoleName: r.To.ApplicationRoleName,
+		ApplicationName:     r.To.ApplicationName,
+	}
+	return opts
+}
+
+func (r *RevokeApplicationRoleRequest) toOpts() *RevokeApplicationRoleOptions {
+	opts := &RevokeApplicationRoleOptions{
+		name: r.name,
+	}
+	opts.From = KindOfRole{
+		RoleName:            r.From.RoleName,
+		ApplicationRoleName: r.From.ApplicationRoleName,
+		ApplicationName:     r.From.ApplicationName,
+	}
+	return opts
+}
+
 func (r *ShowApplicationRoleRequest) toOpts() *ShowApplicationRoleOptions {
 	opts := &ShowApplicationRoleOptions{
 		ApplicationName: r.ApplicationName,
@@ -2,7 +2,39 @@ package sdk
 
 import "errors"
 
-var _ validatable = new(ShowApplicationRoleOptions)
+var (
+	_ validatable = new(GrantApplicationRoleOptions)
+	_ validatable = new(RevokeApplicationRoleOptions)
+	_ validatable = new(ShowApplicationRoleOptions)
+)
+
+func (opts *GrantApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.To.RoleName, opts.To.ApplicationRoleName, opts.To.ApplicationName) {
+		errs = append(errs, errExactlyOneOf("GrantApplicationRoleOptions.To", "RoleName", "ApplicationRoleName", "ApplicationName"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *RevokeApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.From.RoleName, opts.From.ApplicationRoleName, opts.From.ApplicationName) {
+		errs = append(errs, errExactlyOneOf("RevokeApplicationRoleOptions.From", "RoleName", "ApplicationRoleName", "ApplicationName"))
+	}
+	return errors.Join(errs...)
+}
 
 func (opts *ShowApplicationRoleOptions) validate() error {
 	if opts == nil {
@@ -0,0 +1,276 @@
+package sdk
+
+import "testing"
+
+func TestApplications_Create(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+	pid := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *CreateApplicationOptions {
+		return &CreateApplicationOptions{
+			name:        id,
+			PackageName: pid,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateApplicationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.PackageName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.PackageName = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Version.VersionDirectory opts.Version.VersionAndPatch] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Version = &ApplicationVersion{
+			VersionDirectory: String("@stage/path"),
+			VersionAndPatch: &VersionAndPatch{
+				Version: "v1",
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateApplicationOptions.Version", "VersionDirectory", "VersionAndPatch"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM APPLICATION PACKAGE %s`, id.FullyQualifiedName(), pid.FullyQualifiedName())
+	})
+
+	t.Run("using version directory", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Version = &ApplicationVersion{
+			VersionDirectory: String("@stage/path"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM APPLICATION PACKAGE %s USING '@stage/path'`, id.FullyQualifiedName(), pid.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Version = &ApplicationVersion{
+			VersionAndPatch: &VersionAndPatch{
+				Version: "v1",
+				Patch:   Int(1),
+			},
+		}
+		opts.DebugMode = Bool(true)
+		opts.Comment = String("comment")
+		t1 := RandomSchemaObjectIdentifier()
+		opts.Tag = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM APPLICATION PACKAGE %s USING VERSION v1 PATCH 1 DEBUG_MODE = true COMMENT = 'comment' TAG (%s = 'v1')`, id.FullyQualifiedName(), pid.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestApplications_Alter(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *AlterApplicationOptions {
+		return &AlterApplicationOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterApplicationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.Upgrade = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApplicationOptions", "Set", "Unset", "Upgrade", "UpgradeVersion", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Upgrade = Bool(true)
+		opts.Set = &ApplicationSet{Comment: String("comment")}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApplicationOptions", "Set", "Unset", "Upgrade", "UpgradeVersion", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.UpgradeVersion.VersionDirectory opts.UpgradeVersion.VersionAndPatch] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UpgradeVersion = &ApplicationVersion{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApplicationOptions.UpgradeVersion", "VersionDirectory", "VersionAndPatch"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApplicationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApplicationOptions.Set", "Comment", "ShareEventsWithProvider", "DebugMode"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApplicationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApplicationOptions.Unset", "Comment", "ShareEventsWithProvider", "DebugMode"))
+	})
+
+	t.Run("alter: set options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApplicationSet{
+			Comment:                 String("comment"),
+			ShareEventsWithProvider: Bool(true),
+			DebugMode:               Bool(false),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s SET COMMENT = 'comment' SHARE_EVENTS_WITH_PROVIDER = true DEBUG_MODE = false`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApplicationUnset{
+			Comment:                 Bool(true),
+			ShareEventsWithProvider: Bool(true),
+			DebugMode:               Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s UNSET COMMENT, SHARE_EVENTS_WITH_PROVIDER, DEBUG_MODE`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: upgrade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Upgrade = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s UPGRADE`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: upgrade using version directory", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UpgradeVersion = &ApplicationVersion{
+			VersionDirectory: String("@stage/path"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s UPGRADE USING '@stage/path'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: upgrade using version and patch", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UpgradeVersion = &ApplicationVersion{
+			VersionAndPatch: &VersionAndPatch{
+				Version: "v1",
+				Patch:   Int(2),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s UPGRADE USING VERSION v1 PATCH 2`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		t1 := RandomSchemaObjectIdentifier()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s SET TAG %s = 'v1'`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		t1 := RandomSchemaObjectIdentifier()
+		opts.UnsetTags = []ObjectIdentifier{t1}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION IF EXISTS %s UNSET TAG %s`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestApplications_Drop(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *DropApplicationOptions {
+		return &DropApplicationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropApplicationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP APPLICATION IF EXISTS %s CASCADE`, id.FullyQualifiedName())
+	})
+}
+
+func TestApplications_Show(t *testing.T) {
+	defaultOpts := func() *ShowApplicationOptions {
+		return &ShowApplicationOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowApplicationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATIONS`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.StartsWith = String("A")
+		opts.Limit = &LimitFrom{
+			Rows: Int(1),
+			From: String("B"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATIONS LIKE 'pattern' STARTS WITH 'A' LIMIT 1 FROM 'B'`)
+	})
+}
+
+func TestApplications_Describe(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *DescribeApplicationOptions {
+		return &DescribeApplicationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeApplicationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE APPLICATION %s`, id.FullyQualifiedName())
+	})
+}
@@ -19,49 +19,63 @@ type Client struct {
 	sessionID      string
 	accountLocator string
 	dryRun         bool
+	reads          *sql.DB
 	traceLogs      []string
+	retryPolicy    *RetryPolicy
+	batcher        *statementBatcher
+	showCache      *showCache
 
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
 	ConversionFunctions  ConversionFunctions
 	SystemFunctions      SystemFunctions
 	ReplicationFunctions ReplicationFunctions
+	PolicyReferences     PolicyReferences
 
 	// DDL Commands
-	Accounts          Accounts
-	Alerts            Alerts
-	ApplicationRoles  ApplicationRoles
-	Comments          Comments
-	ComputePools      ComputePools
-	DatabaseRoles     DatabaseRoles
-	Databases         Databases
-	DynamicTables     DynamicTables
-	ExternalTables    ExternalTables
-	EventTables       EventTables
-	FailoverGroups    FailoverGroups
-	FileFormats       FileFormats
-	Grants            Grants
-	ImageRepositories ImageRepositories
-	MaskingPolicies   MaskingPolicies
-	NetworkPolicies   NetworkPolicies
-	Parameters        Parameters
-	PasswordPolicies  PasswordPolicies
-	Pipes             Pipes
-	Procedures        Procedures
-	ResourceMonitors  ResourceMonitors
-	Roles             Roles
-	Schemas           Schemas
-	SessionPolicies   SessionPolicies
-	Sessions          Sessions
-	Shares            Shares
-	Stages            Stages
-	Streams           Streams
-	Tables            Tables
-	Tags              Tags
-	Tasks             Tasks
-	Users             Users
-	Views             Views
-	Warehouses        Warehouses
+	Accounts                   Accounts
+	Alerts                     Alerts
+	ApplicationPackages        ApplicationPackages
+	ApplicationRoles           ApplicationRoles
+	Applications               Applications
+	Comments                   Comments
+	ComputePools               ComputePools
+	DatabaseRoles              DatabaseRoles
+	Databases                  Databases
+	DynamicTables              DynamicTables
+	ExternalAccessIntegrations ExternalAccessIntegrations
+	ExternalTables             ExternalTables
+	ExternalVolumes            ExternalVolumes
+	EventTables                EventTables
+	FailoverGroups             FailoverGroups
+	FileFormats                FileFormats
+	Functions                  Functions
+	Grants                     Grants
+	IcebergTables              IcebergTables
+	ImageRepositories          ImageRepositories
+	MaskingPolicies            MaskingPolicies
+	NetworkPolicies            NetworkPolicies
+	NetworkRules               NetworkRules
+	Parameters                 Parameters
+	PasswordPolicies           PasswordPolicies
+	Pipes                      Pipes
+	Procedures                 Procedures
+	ReplicationGroups          ReplicationGroups
+	ResourceMonitors           ResourceMonitors
+	Roles                      Roles
+	Schemas                    Schemas
+	Secrets                    Secrets
+	SessionPolicies            SessionPolicies
+	Sessions                   Sessions
+	Shares                     Shares
+	Stages                     Stages
+	Streams                    Streams
+	Tables                     Tables
+	Tags                       Tags
+	Tasks                      Tasks
+	Users                      Users
+	Views                      Views
+	Warehouses                 Warehouses
 }
 
 func (c *Client) GetAccountLocator() string {
@@ -85,10 +99,20 @@ func NewDryRunClient() *Client {
 		dryRun:    true,
 		traceLogs: []string{},
 	}
+	client.db = sqlx.NewDb(sql.OpenDB(&dryRunConnector{client: client}), "snowflake").Unsafe()
 	client.initialize()
 	return client
 }
 
+// NewDryRunClientReadingFrom returns a dry run client which records the statements like NewDryRunClient,
+// but runs the queries (SHOW, DESCRIBE, SELECT) on db, so that the operations see the current state of the objects.
+// Only the statements executed with exec are recorded. If db is nil, it is the same as NewDryRunClient.
+func NewDryRunClientReadingFrom(db *sql.DB) *Client {
+	client := NewDryRunClient()
+	client.reads = db
+	return client
+}
+
 func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 	var err error
 	if cfg == nil {
@@ -122,8 +146,9 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 
 	client = &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
-		db:     db.Unsafe(),
-		config: cfg,
+		db:          db.Unsafe(),
+		config:      cfg,
+		retryPolicy: DefaultRetryPolicy(),
 	}
 	client.initialize()
 
@@ -151,7 +176,10 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 func NewClientFromDB(db *sql.DB) *Client {
 	dbx := sqlx.NewDb(db, "snowflake")
 	client := &Client{
-		db: dbx.Unsafe(),
+		db:          dbx.Unsafe(),
+		retryPolicy: retryPolicyFor(db),
+		batcher:     statementBatcherFor(db),
+		showCache:   showCacheFor(db),
 	}
 	client.initialize()
 	return client
@@ -160,7 +188,9 @@ func NewClientFromDB(db *sql.DB) *Client {
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
 	c.Alerts = &alerts{client: c}
+	c.ApplicationPackages = &applicationPackages{client: c}
 	c.ApplicationRoles = &applicationRoles{client: c}
+	c.Applications = &applications{client: c}
 	c.Comments = &comments{client: c}
 	c.ComputePools = &computePools{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
@@ -168,22 +198,30 @@ func (c *Client) initialize() {
 	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
 	c.ExternalTables = &externalTables{client: c}
+	c.ExternalVolumes = &externalVolumes{client: c}
 	c.EventTables = &eventTables{client: c}
 	c.FailoverGroups = &failoverGroups{client: c}
 	c.FileFormats = &fileFormats{client: c}
+	c.Functions = &functions{client: c}
 	c.Grants = &grants{client: c}
+	c.IcebergTables = &icebergTables{client: c}
 	c.ImageRepositories = &imageRepositories{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
 	c.NetworkPolicies = &networkPolicies{client: c}
+	c.NetworkRules = &networkRules{client: c}
 	c.Parameters = &parameters{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
 	c.Pipes = &pipes{client: c}
+	c.PolicyReferences = &policyReferences{client: c}
 	c.Procedures = &procedures{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
+	c.ReplicationGroups = &replicationGroups{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
 	c.Schemas = &schemas{client: c}
+	c.Secrets = &secrets{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
@@ -220,35 +258,55 @@ const (
 )
 
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, sql string) (result sql.Result, err error) {
 	if c.dryRun {
 		c.traceLogs = append(c.traceLogs, sql)
 		log.Printf("[DEBUG] sql-conn-exec-dry: %v\n", sql)
 		return nil, nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	result, err := c.db.ExecContext(ctx, sql)
-	return result, decodeDriverError(err)
+	err = c.withRetries(ctx, sql, func() error {
+		var execErr error
+		result, execErr = c.db.ExecContext(ctx, sql)
+		return execErr
+	})
+	c.InvalidateShowCache()
+	return result, err
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
-	if c.dryRun {
+	if c.dryRun && c.reads == nil {
 		c.traceLogs = append(c.traceLogs, sql)
 		log.Printf("[DEBUG] sql-conn-query-dry: %v\n", sql)
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	load := func(dest interface{}, sql string) error {
+		retried := false
+		return c.withRetries(ctx, sql, func() error {
+			if retried {
+				resetDestination(dest)
+			}
+			retried = true
+			return c.db.SelectContext(ctx, dest, sql)
+		})
+	}
+	if c.showCache != nil && isShowStatement(sql) {
+		return c.showCache.query(ctx, sql, dest, load)
+	}
+	return load(dest, sql)
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) error {
-	if c.dryRun {
+	if c.dryRun && c.reads == nil {
 		c.traceLogs = append(c.traceLogs, sql)
 		log.Printf("[DEBUG] sql-conn-query-one-dry: %v\n", sql)
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	return c.withRetries(ctx, sql, func() error {
+		return c.db.GetContext(ctx, dest, sql)
+	})
 }
@@ -0,0 +1,161 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+)
+
+var (
+	_ driver.Connector      = new(dryRunConnector)
+	_ driver.ExecerContext  = new(dryRunConn)
+	_ driver.QueryerContext = new(dryRunConn)
+	_ driver.ConnBeginTx    = new(dryRunConn)
+)
+
+// dryRunConnector backs the *sql.DB returned by GetConn on a dry run client, so that code
+// working directly on the connection (i.e. the legacy pkg/snowflake builders) records its
+// statements in the same trace logs as the SDK objects instead of executing them.
+type dryRunConnector struct {
+	client *Client
+}
+
+func (c *dryRunConnector) Connect(context.Context) (driver.Conn, error) {
+	return &dryRunConn{client: c.client}, nil
+}
+
+func (c *dryRunConnector) Driver() driver.Driver {
+	return dryRunDriver{}
+}
+
+type dryRunDriver struct{}
+
+func (dryRunDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("dry run connections can only be opened through the dry run client")
+}
+
+type dryRunConn struct {
+	client *Client
+}
+
+// ExecContext records the statement. Arguments are not interpolated, so statements using placeholders are recorded as is.
+func (c *dryRunConn) ExecContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if _, err := c.client.exec(ctx, query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+// QueryContext runs the query on the connection the client reads from, if any. Otherwise, it records the statement and returns no rows.
+func (c *dryRunConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if c.client.reads != nil {
+		return queryBuffered(ctx, c.client.reads, query, args)
+	}
+	c.client.traceLogs = append(c.client.traceLogs, query)
+	log.Printf("[DEBUG] sql-conn-query-dry: %v\n", query)
+	return dryRunRows{}, nil
+}
+
+func (c *dryRunConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported in dry run mode")
+}
+
+func (c *dryRunConn) Begin() (driver.Tx, error) {
+	return dryRunTx{}, nil
+}
+
+func (c *dryRunConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return dryRunTx{}, nil
+}
+
+func (c *dryRunConn) Close() error {
+	return nil
+}
+
+type dryRunTx struct{}
+
+func (dryRunTx) Commit() error {
+	return nil
+}
+
+func (dryRunTx) Rollback() error {
+	return nil
+}
+
+type dryRunRows struct{}
+
+func (dryRunRows) Columns() []string {
+	return []string{}
+}
+
+func (dryRunRows) Close() error {
+	return nil
+}
+
+func (dryRunRows) Next([]driver.Value) error {
+	return io.EOF
+}
+
+// queryBuffered runs the query on db and reads all the rows, so the connection is released before they are returned.
+func queryBuffered(ctx context.Context, db *sql.DB, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryArgs := make([]any, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			queryArgs[i] = sql.Named(arg.Name, arg.Value)
+		} else {
+			queryArgs[i] = arg.Value
+		}
+	}
+	rows, err := db.QueryContext(ctx, query, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	buffered := &bufferedRows{columns: columns}
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make([]driver.Value, len(columns))
+		for i, value := range values {
+			row[i] = value
+		}
+		buffered.rows = append(buffered.rows, row)
+	}
+	return buffered, rows.Err()
+}
+
+type bufferedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *bufferedRows) Columns() []string {
+	return r.columns
+}
+
+func (r *bufferedRows) Close() error {
+	return nil
+}
+
+func (r *bufferedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records statements executed through the SDK", func(t *testing.T) {
+		client := NewDryRunClient()
+		id := RandomAccountObjectIdentifier()
+
+		err := client.Warehouses.Create(ctx, id, nil)
+		require.NoError(t, err)
+		err = client.Warehouses.Drop(ctx, id, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"CREATE WAREHOUSE " + id.FullyQualifiedName(),
+			"DROP WAREHOUSE " + id.FullyQualifiedName(),
+		}, client.TraceLogs())
+	})
+
+	t.Run("records statements executed directly on the connection", func(t *testing.T) {
+		client := NewDryRunClient()
+		db := client.GetConn().DB
+
+		_, err := db.Exec("CREATE ROLE \"role\"")
+		require.NoError(t, err)
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+		_, err = tx.Exec("GRANT ROLE \"role\" TO USER \"user\"")
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+
+		rows, err := db.Query("SHOW ROLES LIKE 'role'")
+		require.NoError(t, err)
+		assert.False(t, rows.Next())
+		require.NoError(t, rows.Close())
+
+		assert.Equal(t, []string{
+			"CREATE ROLE \"role\"",
+			"GRANT ROLE \"role\" TO USER \"user\"",
+			"SHOW ROLES LIKE 'role'",
+		}, client.TraceLogs())
+	})
+
+	t.Run("shares trace logs with clients created from the connection", func(t *testing.T) {
+		client := NewDryRunClient()
+		id := RandomAccountObjectIdentifier()
+
+		err := NewClientFromDB(client.GetConn().DB).Roles.Drop(ctx, NewDropRoleRequest(id))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"DROP ROLE " + id.FullyQualifiedName()}, client.TraceLogs())
+	})
+
+	t.Run("runs queries on the connection it reads from", func(t *testing.T) {
+		reads := NewDryRunClient()
+		client := NewDryRunClientReadingFrom(reads.GetConn().DB)
+		id := RandomAccountObjectIdentifier()
+
+		err := client.Warehouses.Create(ctx, id, nil)
+		require.NoError(t, err)
+		_, err = client.Warehouses.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		_, err = NewClientFromDB(client.GetConn().DB).Warehouses.Show(ctx, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"CREATE WAREHOUSE " + id.FullyQualifiedName()}, client.TraceLogs())
+		assert.Equal(t, []string{
+			"SHOW WAREHOUSES LIKE '" + id.Name() + "'",
+			"SHOW WAREHOUSES",
+		}, reads.TraceLogs())
+	})
+}
@@ -168,11 +168,33 @@ func NullInputBehaviorPointer(v NullInputBehavior) *NullInputBehavior {
 
 const (
 	NullInputBehaviorCalledOnNullInput NullInputBehavior = "CALLED ON NULL INPUT"
-	NullInputBehaviorReturnNullInput   NullInputBehavior = "RETURN NULL ON NULL INPUT"
+	NullInputBehaviorReturnNullInput   NullInputBehavior = "RETURNS NULL ON NULL INPUT"
 	NullInputBehaviorStrict            NullInputBehavior = "STRICT"
 )
 
-type Secret struct {
+type ReturnNullValues string
+
+func ReturnNullValuesPointer(v ReturnNullValues) *ReturnNullValues {
+	return &v
+}
+
+const (
+	ReturnNullValuesNull    ReturnNullValues = "NULL"
+	ReturnNullValuesNotNull ReturnNullValues = "NOT NULL"
+)
+
+type ReturnResultsBehavior string
+
+func ReturnResultsBehaviorPointer(v ReturnResultsBehavior) *ReturnResultsBehavior {
+	return &v
+}
+
+const (
+	ReturnResultsBehaviorVolatile  ReturnResultsBehavior = "VOLATILE"
+	ReturnResultsBehaviorImmutable ReturnResultsBehavior = "IMMUTABLE"
+)
+
+type SecretReference struct {
 	VariableName string `ddl:"keyword,single_quotes"`
 	Name         string `ddl:"parameter,no_quotes"`
 }
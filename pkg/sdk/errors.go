@@ -4,9 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"runtime"
 	"strings"
+
+	"github.com/snowflakedb/gosnowflake"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -16,6 +20,15 @@ var (
 	// go-snowflake errors.
 	ErrObjectNotExistOrAuthorized = NewError("object does not exist or not authorized")
 	ErrAccountIsEmpty             = NewError("account is empty")
+	ErrObjectAlreadyExists        = NewError("object already exists")
+	ErrInsufficientPrivileges     = NewError("insufficient privileges")
+	ErrSyntaxError                = NewError("SQL syntax error")
+	ErrWarehouseSuspended         = NewError("no active warehouse, it may be suspended or not selected in the current session")
+	ErrStatementTimeout           = NewError("statement reached its timeout and was canceled")
+	ErrConcurrencyConflict        = NewError("statement conflicts with a concurrent transaction")
+	ErrSessionExpired             = NewError("session expired")
+	ErrInternalError              = NewError("SQL execution internal error")
+	ErrServiceUnavailable         = NewError("service unavailable")
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = NewError("invalid object identifier")
@@ -72,11 +85,77 @@ func errInvalidValue(structName string, fieldName string, invalidValue string) e
 	return newError(fmt.Sprintf("invalid value %s of struct %s field: %s", invalidValue, structName, fieldName), 2)
 }
 
+// snowflakeErrorCodes classify errors by the Snowflake error codes (https://docs.snowflake.com/en/developer-guide/sql-api/reference#error-codes).
+var snowflakeErrorCodes = map[int]error{
+	2003:                                  ErrObjectNotExistOrAuthorized,
+	2002:                                  ErrObjectAlreadyExists,
+	3001:                                  ErrInsufficientPrivileges,
+	1003:                                  ErrSyntaxError,
+	606:                                   ErrWarehouseSuspended,
+	630:                                   ErrStatementTimeout,
+	625:                                   ErrConcurrencyConflict,
+	390112:                                ErrSessionExpired,
+	390114:                                ErrSessionExpired,
+	603:                                   ErrInternalError,
+	gosnowflake.ErrCodeEmptyAccountCode:   ErrAccountIsEmpty,
+	gosnowflake.ErrCodeServiceUnavailable: ErrServiceUnavailable,
+}
+
+// snowflakeSQLStates classify errors by SQLSTATE when the error code is not known.
+var snowflakeSQLStates = map[string]error{
+	"02000": ErrObjectNotExistOrAuthorized,
+	"42710": ErrObjectAlreadyExists,
+	"42501": ErrInsufficientPrivileges,
+	"42601": ErrSyntaxError,
+	"57P03": ErrWarehouseSuspended,
+	"57014": ErrStatementTimeout,
+	"40001": ErrConcurrencyConflict,
+	"XX000": ErrInternalError,
+}
+
+// unavailableHTTPStatuses are the gateway responses reported by the driver with gosnowflake.ErrFailedToPostQuery
+// that are worth retrying.
+var unavailableHTTPStatuses = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// driverError keeps the original driver error (and its message), while being classified as one of the sentinel errors.
+type driverError struct {
+	kind error
+	err  error
+}
+
+func (e *driverError) Error() string {
+	return e.err.Error()
+}
+
+func (e *driverError) Is(target error) bool {
+	return target == e.kind //nolint:errorlint // sentinel errors are compared directly
+}
+
+func (e *driverError) Unwrap() error {
+	return e.err
+}
+
+// decodeDriverError classifies the driver errors, so that they can be checked with errors.Is against the sentinel
+// errors above (e.g. ErrObjectNotExistOrAuthorized), while errors.As still gives access to *gosnowflake.SnowflakeError.
 func decodeDriverError(err error) error {
 	if err == nil {
 		return nil
 	}
 	log.Printf("[DEBUG] err: %v\n", err)
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		if snowflakeErr.Number == gosnowflake.ErrFailedToPostQuery && len(snowflakeErr.MessageArgs) > 0 {
+			if status, ok := snowflakeErr.MessageArgs[0].(int); ok && slices.Contains(unavailableHTTPStatuses, status) {
+				return &driverError{kind: ErrServiceUnavailable, err: err}
+			}
+		}
+		if kind, ok := snowflakeErrorCodes[snowflakeErr.Number]; ok {
+			return &driverError{kind: kind, err: err}
+		}
+		if kind, ok := snowflakeSQLStates[snowflakeErr.SQLState]; ok {
+			return &driverError{kind: kind, err: err}
+		}
+	}
 	m := map[string]error{
 		"does not exist or not authorized": ErrObjectNotExistOrAuthorized,
 		"account is empty":                 ErrAccountIsEmpty,
@@ -90,6 +169,12 @@ func decodeDriverError(err error) error {
 	return err
 }
 
+// DecodeDriverError classifies the errors returned by the driver outside of the SDK client
+// (e.g. by the pkg/snowflake builders) the same way as the SDK does.
+func DecodeDriverError(err error) error {
+	return decodeDriverError(err)
+}
+
 const errorIndentRune = '›'
 
 var errorFileInfoRegexp = regexp.MustCompile(`\[\w+\.\w+:\d+\] `)
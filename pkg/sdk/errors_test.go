@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -132,3 +133,106 @@ func TestWriteTree(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeDriverError(t *testing.T) {
+	snowflakeError := func(number int, sqlState string, message string) error {
+		return &gosnowflake.SnowflakeError{Number: number, SQLState: sqlState, Message: message}
+	}
+
+	testCases := map[string]struct {
+		Error    error
+		Expected error
+	}{
+		"object does not exist": {
+			Error:    snowflakeError(2003, "02000", "SQL compilation error:\nDatabase 'DB' does not exist or not authorized."),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+		"object already exists": {
+			Error:    snowflakeError(2002, "42710", "SQL compilation error:\nObject 'DB' already exists."),
+			Expected: ErrObjectAlreadyExists,
+		},
+		"insufficient privileges": {
+			Error:    snowflakeError(3001, "42501", "SQL access control error:\nInsufficient privileges to operate on database 'DB'"),
+			Expected: ErrInsufficientPrivileges,
+		},
+		"syntax error": {
+			Error:    snowflakeError(1003, "42000", "SQL compilation error:\nsyntax error line 1 at position 0 unexpected 'CRATE'."),
+			Expected: ErrSyntaxError,
+		},
+		"warehouse suspended": {
+			Error:    snowflakeError(606, "57P03", "No active warehouse selected in the current session."),
+			Expected: ErrWarehouseSuspended,
+		},
+		"statement timeout": {
+			Error:    snowflakeError(630, "57014", "Statement reached its statement or warehouse timeout of 10 second(s) and was canceled."),
+			Expected: ErrStatementTimeout,
+		},
+		"concurrency conflict": {
+			Error:    snowflakeError(625, "57014", "Statement '01' has locked table 'T' in transaction 1 and this lock has not yet been released."),
+			Expected: ErrConcurrencyConflict,
+		},
+		"session expired": {
+			Error:    snowflakeError(390112, "08001", "Your session has expired. Please login again."),
+			Expected: ErrSessionExpired,
+		},
+		"internal error": {
+			Error:    snowflakeError(603, "XX000", "SQL execution internal error: Processing aborted due to error 300002:2523065932; incident 1234."),
+			Expected: ErrInternalError,
+		},
+		"service unavailable": {
+			Error:    snowflakeError(gosnowflake.ErrCodeServiceUnavailable, "", "service is unavailable. check your connectivity."),
+			Expected: ErrServiceUnavailable,
+		},
+		"query post failed with unavailable HTTP status": {
+			Error:    &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, Message: "failed to POST. HTTP: %v, URL: %v", MessageArgs: []interface{}{503, "https://example.snowflakecomputing.com"}},
+			Expected: ErrServiceUnavailable,
+		},
+		"unknown code classified by SQLSTATE": {
+			Error:    snowflakeError(1, "42501", "Insufficient privileges"),
+			Expected: ErrInsufficientPrivileges,
+		},
+		"account is empty": {
+			Error:    snowflakeError(gosnowflake.ErrCodeEmptyAccountCode, "", "account is empty"),
+			Expected: ErrAccountIsEmpty,
+		},
+		"wrapped driver error": {
+			Error:    fmt.Errorf("show warehouses: %w", snowflakeError(3001, "42501", "Insufficient privileges")),
+			Expected: ErrInsufficientPrivileges,
+		},
+		"other error matched by message": {
+			Error:    errors.New("schema 'S' does not exist or not authorized"),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := decodeDriverError(tc.Error)
+
+			require.ErrorIs(t, err, tc.Expected)
+			for _, sentinel := range []error{ErrObjectNotExistOrAuthorized, ErrObjectAlreadyExists, ErrInsufficientPrivileges, ErrSyntaxError, ErrWarehouseSuspended, ErrStatementTimeout, ErrConcurrencyConflict, ErrSessionExpired, ErrAccountIsEmpty, ErrInternalError, ErrServiceUnavailable} {
+				if sentinel != tc.Expected { //nolint:errorlint // sentinel errors are compared directly
+					require.NotErrorIs(t, err, sentinel)
+				}
+			}
+		})
+	}
+
+	t.Run("keeps the driver error", func(t *testing.T) {
+		driverErr := snowflakeError(2003, "02000", "Database 'DB' does not exist or not authorized.")
+
+		err := decodeDriverError(driverErr)
+
+		require.Equal(t, driverErr.Error(), err.Error())
+		var snowflakeErr *gosnowflake.SnowflakeError
+		require.ErrorAs(t, err, &snowflakeErr)
+		require.Equal(t, 2003, snowflakeErr.Number)
+	})
+
+	t.Run("unclassified errors are returned as is", func(t *testing.T) {
+		driverErr := snowflakeError(100038, "22018", "Numeric value 'abc' is not recognized")
+
+		require.Equal(t, driverErr, decodeDriverError(driverErr))
+		require.NoError(t, decodeDriverError(nil))
+	})
+}
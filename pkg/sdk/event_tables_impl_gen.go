@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ EventTables = (*eventTables)(nil)
 
@@ -28,12 +24,17 @@ func (v *eventTables) Show(ctx context.Context, request *ShowEventTableRequest)
 }
 
 func (v *eventTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*EventTable, error) {
-	request := NewShowEventTableRequest().WithIn(&In{Database: NewAccountObjectIdentifier(id.DatabaseName())}).WithLike(&Like{String(id.Name())})
+	request := NewShowEventTableRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
 	eventTables, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(eventTables, func(r EventTable) bool { return r.Name == id.Name() })
+	for _, eventTable := range eventTables {
+		if eventTable.Name == id.Name() {
+			return &eventTable, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
 }
 
 func (v *eventTables) Describe(ctx context.Context, id SchemaObjectIdentifier) (*EventTableDetails, error) {
@@ -0,0 +1,105 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var externalAccessIntegrationSet = g.NewQueryStruct("ExternalAccessIntegrationSet").
+	ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+	ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+	ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment")
+
+var externalAccessIntegrationUnset = g.NewQueryStruct("ExternalAccessIntegrationUnset").
+	OptionalSQL("ALLOWED_API_AUTHENTICATION_INTEGRATIONS").
+	OptionalSQL("ALLOWED_AUTHENTICATION_SECRETS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment")
+
+var ExternalAccessIntegrationsDef = g.NewInterface(
+	"ExternalAccessIntegrations",
+	"ExternalAccessIntegration",
+	g.KindOfT[AccountObjectIdentifier](),
+).CreateOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration",
+	g.NewQueryStruct("CreateExternalAccessIntegration").
+		Create().
+		OrReplace().
+		SQL("EXTERNAL ACCESS INTEGRATION").
+		IfNotExists().
+		Name().
+		ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses().Required()).
+		ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+		ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+		BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).AlterOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-external-access-integration",
+	g.NewQueryStruct("AlterExternalAccessIntegration").
+		Alter().
+		SQL("EXTERNAL ACCESS INTEGRATION").
+		IfExists().
+		Name().
+		OptionalQueryStructField(
+			"Set",
+			externalAccessIntegrationSet,
+			g.KeywordOptions().SQL("SET"),
+		).
+		OptionalQueryStructField(
+			"Unset",
+			externalAccessIntegrationUnset,
+			g.ListOptions().NoParentheses().SQL("UNSET"),
+		).
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
+	g.NewQueryStruct("DropExternalAccessIntegration").
+		Drop().
+		SQL("EXTERNAL ACCESS INTEGRATION").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-integrations",
+	g.DbStruct("externalAccessIntegrationRow").
+		Field("name", "string").
+		Field("type", "string").
+		Field("category", "string").
+		Field("enabled", "bool").
+		Field("comment", "sql.NullString").
+		Field("created_on", "time.Time"),
+	g.PlainStruct("ExternalAccessIntegration").
+		Field("Name", "string").
+		Field("Type", "string").
+		Field("Category", "string").
+		Field("Enabled", "bool").
+		Field("Comment", "string").
+		Field("CreatedOn", "time.Time"),
+	g.NewQueryStruct("ShowExternalAccessIntegrations").
+		Show().
+		SQL("EXTERNAL ACCESS INTEGRATIONS").
+		OptionalLike(),
+).ShowByIdOperation().DescribeOperation(
+	g.DescriptionMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
+	g.DbStruct("externalAccessIntegrationPropertyRow").
+		Field("property", "string").
+		Field("property_type", "string").
+		Field("property_value", "string").
+		Field("property_default", "string"),
+	g.PlainStruct("ExternalAccessIntegrationProperty").
+		Field("Property", "string").
+		Field("PropertyType", "string").
+		Field("PropertyValue", "string").
+		Field("PropertyDefault", "string"),
+	g.NewQueryStruct("DescribeExternalAccessIntegration").
+		Describe().
+		SQL("EXTERNAL ACCESS INTEGRATION").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+)
@@ -0,0 +1,56 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateExternalAccessIntegrationOptions]   = new(CreateExternalAccessIntegrationRequest)
+	_ optionsProvider[AlterExternalAccessIntegrationOptions]    = new(AlterExternalAccessIntegrationRequest)
+	_ optionsProvider[DropExternalAccessIntegrationOptions]     = new(DropExternalAccessIntegrationRequest)
+	_ optionsProvider[ShowExternalAccessIntegrationOptions]     = new(ShowExternalAccessIntegrationRequest)
+	_ optionsProvider[DescribeExternalAccessIntegrationOptions] = new(DescribeExternalAccessIntegrationRequest)
+)
+
+type CreateExternalAccessIntegrationRequest struct {
+	OrReplace                            *bool
+	IfNotExists                          *bool
+	name                                 AccountObjectIdentifier  // required
+	AllowedNetworkRules                  []SchemaObjectIdentifier // required
+	AllowedApiAuthenticationIntegrations []AccountObjectIdentifier
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier
+	Enabled                              bool // required
+	Comment                              *string
+}
+
+type AlterExternalAccessIntegrationRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+	Set      *ExternalAccessIntegrationSetRequest
+	Unset    *ExternalAccessIntegrationUnsetRequest
+}
+
+type ExternalAccessIntegrationSetRequest struct {
+	AllowedNetworkRules                  []SchemaObjectIdentifier
+	AllowedApiAuthenticationIntegrations []AccountObjectIdentifier
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier
+	Enabled                              *bool
+	Comment                              *string
+}
+
+type ExternalAccessIntegrationUnsetRequest struct {
+	AllowedApiAuthenticationIntegrations *bool
+	AllowedAuthenticationSecrets         *bool
+	Comment                              *bool
+}
+
+type DropExternalAccessIntegrationRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+}
+
+type ShowExternalAccessIntegrationRequest struct {
+	Like *Like
+}
+
+type DescribeExternalAccessIntegrationRequest struct {
+	name AccountObjectIdentifier // required
+}
@@ -0,0 +1,141 @@
+package sdk
+
+import "context"
+
+var _ ExternalAccessIntegrations = (*externalAccessIntegrations)(nil)
+
+type externalAccessIntegrations struct {
+	client *Client
+}
+
+func (v *externalAccessIntegrations) Create(ctx context.Context, request *CreateExternalAccessIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalAccessIntegrations) Alter(ctx context.Context, request *AlterExternalAccessIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalAccessIntegrations) Drop(ctx context.Context, request *DropExternalAccessIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalAccessIntegrations) Show(ctx context.Context, request *ShowExternalAccessIntegrationRequest) ([]ExternalAccessIntegration, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[externalAccessIntegrationRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[externalAccessIntegrationRow, ExternalAccessIntegration](dbRows)
+	return resultList, nil
+}
+
+func (v *externalAccessIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegration, error) {
+	externalAccessIntegrations, err := v.Show(ctx, NewShowExternalAccessIntegrationRequest().WithLike(&Like{String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	for _, externalAccessIntegration := range externalAccessIntegrations {
+		if externalAccessIntegration.Name == id.Name() {
+			return &externalAccessIntegration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *externalAccessIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ExternalAccessIntegrationProperty, error) {
+	opts := &DescribeExternalAccessIntegrationOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[externalAccessIntegrationPropertyRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[externalAccessIntegrationPropertyRow, ExternalAccessIntegrationProperty](rows), nil
+}
+
+func (r *CreateExternalAccessIntegrationRequest) toOpts() *CreateExternalAccessIntegrationOptions {
+	opts := &CreateExternalAccessIntegrationOptions{
+		OrReplace:                            r.OrReplace,
+		IfNotExists:                          r.IfNotExists,
+		name:                                 r.name,
+		AllowedNetworkRules:                  r.AllowedNetworkRules,
+		AllowedApiAuthenticationIntegrations: r.AllowedApiAuthenticationIntegrations,
+		AllowedAuthenticationSecrets:         r.AllowedAuthenticationSecrets,
+		Enabled:                              r.Enabled,
+		Comment:                              r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterExternalAccessIntegrationRequest) toOpts() *AlterExternalAccessIntegrationOptions {
+	opts := &AlterExternalAccessIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &ExternalAccessIntegrationSet{
+			AllowedNetworkRules:                  r.Set.AllowedNetworkRules,
+			AllowedApiAuthenticationIntegrations: r.Set.AllowedApiAuthenticationIntegrations,
+			AllowedAuthenticationSecrets:         r.Set.AllowedAuthenticationSecrets,
+			Enabled:                              r.Set.Enabled,
+			Comment:                              r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ExternalAccessIntegrationUnset{
+			AllowedApiAuthenticationIntegrations: r.Unset.AllowedApiAuthenticationIntegrations,
+			AllowedAuthenticationSecrets:         r.Unset.AllowedAuthenticationSecrets,
+			Comment:                              r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropExternalAccessIntegrationRequest) toOpts() *DropExternalAccessIntegrationOptions {
+	opts := &DropExternalAccessIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowExternalAccessIntegrationRequest) toOpts() *ShowExternalAccessIntegrationOptions {
+	opts := &ShowExternalAccessIntegrationOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r externalAccessIntegrationRow) convert() *ExternalAccessIntegration {
+	externalAccessIntegration := &ExternalAccessIntegration{
+		Name:      r.Name,
+		Type:      r.Type,
+		Category:  r.Category,
+		Enabled:   r.Enabled,
+		CreatedOn: r.CreatedOn,
+	}
+	if r.Comment.Valid {
+		externalAccessIntegration.Comment = r.Comment.String
+	}
+	return externalAccessIntegration
+}
+
+func (r *DescribeExternalAccessIntegrationRequest) toOpts() *DescribeExternalAccessIntegrationOptions {
+	opts := &DescribeExternalAccessIntegrationOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r externalAccessIntegrationPropertyRow) convert() *ExternalAccessIntegrationProperty {
+	return &ExternalAccessIntegrationProperty{
+		Property:        r.Property,
+		PropertyType:    r.PropertyType,
+		PropertyValue:   r.PropertyValue,
+		PropertyDefault: r.PropertyDefault,
+	}
+}
@@ -0,0 +1,129 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type ExternalVolumeStorageProvider string
+
+var (
+	ExternalVolumeStorageProviderS3    ExternalVolumeStorageProvider = "S3"
+	ExternalVolumeStorageProviderS3Gov ExternalVolumeStorageProvider = "S3GOV"
+	ExternalVolumeStorageProviderGCS   ExternalVolumeStorageProvider = "GCS"
+	ExternalVolumeStorageProviderAzure ExternalVolumeStorageProvider = "AZURE"
+)
+
+var externalVolumeStorageLocationEncryption = g.NewQueryStruct("ExternalVolumeStorageLocationEncryption").
+	TextAssignment("TYPE", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalTextAssignment("KMS_KEY_ID", g.ParameterOptions().SingleQuotes())
+
+var externalVolumeStorageLocation = g.NewQueryStruct("ExternalVolumeStorageLocation").
+	TextAssignment("NAME", g.ParameterOptions().SingleQuotes().Required()).
+	Assignment("STORAGE_PROVIDER", g.KindOfT[ExternalVolumeStorageProvider](), g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("STORAGE_BASE_URL", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalTextAssignment("STORAGE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("STORAGE_AWS_EXTERNAL_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalQueryStructField(
+		"Encryption",
+		externalVolumeStorageLocationEncryption,
+		g.ListOptions().Parentheses().NoComma().SQL("ENCRYPTION ="),
+	)
+
+// externalVolumeStorageLocationItem wraps a single storage location, because every location in the STORAGE_LOCATIONS list has to be enclosed in its own parentheses.
+var externalVolumeStorageLocationItem = g.NewQueryStruct("ExternalVolumeStorageLocationItem").
+	QueryStructField(
+		"StorageLocation",
+		externalVolumeStorageLocation,
+		g.ListOptions().Parentheses().NoComma().Required(),
+	)
+
+var externalVolumeSet = g.NewQueryStruct("ExternalVolumeSet").
+	OptionalBooleanAssignment("ALLOW_WRITES", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "AllowWrites", "Comment")
+
+var ExternalVolumesDef = g.NewInterface(
+	"ExternalVolumes",
+	"ExternalVolume",
+	g.KindOfT[AccountObjectIdentifier](),
+).CreateOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/create-external-volume",
+	g.NewQueryStruct("CreateExternalVolume").
+		Create().
+		OrReplace().
+		SQL("EXTERNAL VOLUME").
+		IfNotExists().
+		Name().
+		ListQueryStructField(
+			"StorageLocations",
+			externalVolumeStorageLocationItem,
+			g.ParameterOptions().Parentheses().SQL("STORAGE_LOCATIONS").Required(),
+		).
+		OptionalBooleanAssignment("ALLOW_WRITES", g.ParameterOptions()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).AlterOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-external-volume",
+	g.NewQueryStruct("AlterExternalVolume").
+		Alter().
+		SQL("EXTERNAL VOLUME").
+		IfExists().
+		Name().
+		OptionalQueryStructField(
+			"AddStorageLocation",
+			externalVolumeStorageLocation,
+			g.ListOptions().Parentheses().NoComma().SQL("ADD STORAGE_LOCATION ="),
+		).
+		OptionalTextAssignment("REMOVE STORAGE_LOCATION", g.ParameterOptions().SingleQuotes().NoEquals()).
+		OptionalQueryStructField(
+			"Set",
+			externalVolumeSet,
+			g.KeywordOptions().SQL("SET"),
+		).
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ExactlyOneValueSet, "AddStorageLocation", "RemoveStorageLocation", "Set"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/drop-external-volume",
+	g.NewQueryStruct("DropExternalVolume").
+		Drop().
+		SQL("EXTERNAL VOLUME").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-external-volumes",
+	g.DbStruct("externalVolumeRow").
+		Field("name", "string").
+		Field("allow_writes", "string").
+		Field("comment", "sql.NullString"),
+	g.PlainStruct("ExternalVolume").
+		Field("Name", "string").
+		Field("AllowWrites", "bool").
+		Field("Comment", "string"),
+	g.NewQueryStruct("ShowExternalVolumes").
+		Show().
+		SQL("EXTERNAL VOLUMES").
+		OptionalLike(),
+).ShowByIdOperation().DescribeOperation(
+	g.DescriptionMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/desc-external-volume",
+	g.DbStruct("externalVolumePropertyRow").
+		Field("parent_property", "string").
+		Field("property", "string").
+		Field("property_type", "string").
+		Field("property_value", "string").
+		Field("property_default", "string"),
+	g.PlainStruct("ExternalVolumeProperty").
+		Field("ParentProperty", "string").
+		Field("Property", "string").
+		Field("PropertyType", "string").
+		Field("PropertyValue", "string").
+		Field("PropertyDefault", "string"),
+	g.NewQueryStruct("DescribeExternalVolume").
+		Describe().
+		SQL("EXTERNAL VOLUME").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+)
@@ -0,0 +1,61 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateExternalVolumeOptions]   = new(CreateExternalVolumeRequest)
+	_ optionsProvider[AlterExternalVolumeOptions]    = new(AlterExternalVolumeRequest)
+	_ optionsProvider[DropExternalVolumeOptions]     = new(DropExternalVolumeRequest)
+	_ optionsProvider[ShowExternalVolumeOptions]     = new(ShowExternalVolumeRequest)
+	_ optionsProvider[DescribeExternalVolumeOptions] = new(DescribeExternalVolumeRequest)
+)
+
+type CreateExternalVolumeRequest struct {
+	OrReplace        *bool
+	IfNotExists      *bool
+	name             AccountObjectIdentifier                // required
+	StorageLocations []ExternalVolumeStorageLocationRequest // required
+	AllowWrites      *bool
+	Comment          *string
+}
+
+type ExternalVolumeStorageLocationRequest struct {
+	Name                 string                        // required
+	StorageProvider      ExternalVolumeStorageProvider // required
+	StorageBaseUrl       string                        // required
+	StorageAwsRoleArn    *string
+	StorageAwsExternalId *string
+	AzureTenantId        *string
+	Encryption           *ExternalVolumeStorageLocationEncryptionRequest
+}
+
+type ExternalVolumeStorageLocationEncryptionRequest struct {
+	Type     string // required
+	KmsKeyId *string
+}
+
+type AlterExternalVolumeRequest struct {
+	IfExists              *bool
+	name                  AccountObjectIdentifier // required
+	AddStorageLocation    *ExternalVolumeStorageLocationRequest
+	RemoveStorageLocation *string
+	Set                   *ExternalVolumeSetRequest
+}
+
+type ExternalVolumeSetRequest struct {
+	AllowWrites *bool
+	Comment     *string
+}
+
+type DropExternalVolumeRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+}
+
+type ShowExternalVolumeRequest struct {
+	Like *Like
+}
+
+type DescribeExternalVolumeRequest struct {
+	name AccountObjectIdentifier // required
+}
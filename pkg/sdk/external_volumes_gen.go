@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ExternalVolumes interface {
+	Create(ctx context.Context, request *CreateExternalVolumeRequest) error
+	Alter(ctx context.Context, request *AlterExternalVolumeRequest) error
+	Drop(ctx context.Context, request *DropExternalVolumeRequest) error
+	Show(ctx context.Context, request *ShowExternalVolumeRequest) ([]ExternalVolume, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolume, error)
+	Describe(ctx context.Context, id AccountObjectIdentifier) ([]ExternalVolumeProperty, error)
+}
+
+// CreateExternalVolumeOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-external-volume.
+type CreateExternalVolumeOptions struct {
+	create           bool                                `ddl:"static" sql:"CREATE"`
+	OrReplace        *bool                               `ddl:"keyword" sql:"OR REPLACE"`
+	externalVolume   bool                                `ddl:"static" sql:"EXTERNAL VOLUME"`
+	IfNotExists      *bool                               `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             AccountObjectIdentifier             `ddl:"identifier"`
+	StorageLocations []ExternalVolumeStorageLocationItem `ddl:"parameter,parentheses" sql:"STORAGE_LOCATIONS"`
+	AllowWrites      *bool                               `ddl:"parameter" sql:"ALLOW_WRITES"`
+	Comment          *string                             `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ExternalVolumeStorageLocationItem struct {
+	StorageLocation ExternalVolumeStorageLocation `ddl:"list,parentheses,no_comma"`
+}
+
+type ExternalVolumeStorageLocation struct {
+	Name                 string                                   `ddl:"parameter,single_quotes" sql:"NAME"`
+	StorageProvider      ExternalVolumeStorageProvider            `ddl:"parameter,single_quotes" sql:"STORAGE_PROVIDER"`
+	StorageBaseUrl       string                                   `ddl:"parameter,single_quotes" sql:"STORAGE_BASE_URL"`
+	StorageAwsRoleArn    *string                                  `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
+	StorageAwsExternalId *string                                  `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_EXTERNAL_ID"`
+	AzureTenantId        *string                                  `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+	Encryption           *ExternalVolumeStorageLocationEncryption `ddl:"list,parentheses,no_comma" sql:"ENCRYPTION ="`
+}
+
+type ExternalVolumeStorageLocationEncryption struct {
+	Type     string  `ddl:"parameter,single_quotes" sql:"TYPE"`
+	KmsKeyId *string `ddl:"parameter,single_quotes" sql:"KMS_KEY_ID"`
+}
+
+// AlterExternalVolumeOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-external-volume.
+type AlterExternalVolumeOptions struct {
+	alter                 bool                           `ddl:"static" sql:"ALTER"`
+	externalVolume        bool                           `ddl:"static" sql:"EXTERNAL VOLUME"`
+	IfExists              *bool                          `ddl:"keyword" sql:"IF EXISTS"`
+	name                  AccountObjectIdentifier        `ddl:"identifier"`
+	AddStorageLocation    *ExternalVolumeStorageLocation `ddl:"list,parentheses,no_comma" sql:"ADD STORAGE_LOCATION ="`
+	RemoveStorageLocation *string                        `ddl:"parameter,single_quotes,no_equals" sql:"REMOVE STORAGE_LOCATION"`
+	Set                   *ExternalVolumeSet             `ddl:"keyword" sql:"SET"`
+}
+
+type ExternalVolumeSet struct {
+	AllowWrites *bool   `ddl:"parameter" sql:"ALLOW_WRITES"`
+	Comment     *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// DropExternalVolumeOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-external-volume.
+type DropExternalVolumeOptions struct {
+	drop           bool                    `ddl:"static" sql:"DROP"`
+	externalVolume bool                    `ddl:"static" sql:"EXTERNAL VOLUME"`
+	IfExists       *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name           AccountObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowExternalVolumeOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-external-volumes.
+type ShowExternalVolumeOptions struct {
+	show            bool  `ddl:"static" sql:"SHOW"`
+	externalVolumes bool  `ddl:"static" sql:"EXTERNAL VOLUMES"`
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+type externalVolumeRow struct {
+	Name        string         `db:"name"`
+	AllowWrites string         `db:"allow_writes"`
+	Comment     sql.NullString `db:"comment"`
+}
+
+type ExternalVolume struct {
+	Name        string
+	AllowWrites bool
+	Comment     string
+}
+
+// DescribeExternalVolumeOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-external-volume.
+type DescribeExternalVolumeOptions struct {
+	describe       bool                    `ddl:"static" sql:"DESCRIBE"`
+	externalVolume bool                    `ddl:"static" sql:"EXTERNAL VOLUME"`
+	name           AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type externalVolumePropertyRow struct {
+	ParentProperty  string `db:"parent_property"`
+	Property        string `db:"property"`
+	PropertyType    string `db:"property_type"`
+	PropertyValue   string `db:"property_value"`
+	PropertyDefault string `db:"property_default"`
+}
+
+type ExternalVolumeProperty struct {
+	ParentProperty  string
+	Property        string
+	PropertyType    string
+	PropertyValue   string
+	PropertyDefault string
+}
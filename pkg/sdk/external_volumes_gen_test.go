@@ -0,0 +1,228 @@
+package sdk
+
+import "testing"
+
+func TestExternalVolumes_Create(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	s3StorageLocation := ExternalVolumeStorageLocation{
+		Name:              "s3-location",
+		StorageProvider:   ExternalVolumeStorageProviderS3,
+		StorageBaseUrl:    "s3://bucket/path/",
+		StorageAwsRoleArn: String("arn:aws:iam::123456789012:role/role"),
+	}
+
+	defaultOpts := func() *CreateExternalVolumeOptions {
+		return &CreateExternalVolumeOptions{
+			name: id,
+			StorageLocations: []ExternalVolumeStorageLocationItem{
+				{StorageLocation: s3StorageLocation},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalVolumeOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: storage locations not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.StorageLocations = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateExternalVolumeOptions", "StorageLocations"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE EXTERNAL VOLUME %s STORAGE_LOCATIONS = ((NAME = 's3-location' STORAGE_PROVIDER = 'S3' STORAGE_BASE_URL = 's3://bucket/path/' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/role'))`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		s3StorageLocation := s3StorageLocation
+		s3StorageLocation.StorageAwsExternalId = String("external_id")
+		s3StorageLocation.Encryption = &ExternalVolumeStorageLocationEncryption{
+			Type:     "AWS_SSE_KMS",
+			KmsKeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		}
+		opts.StorageLocations = []ExternalVolumeStorageLocationItem{
+			{StorageLocation: s3StorageLocation},
+			{
+				StorageLocation: ExternalVolumeStorageLocation{
+					Name:            "azure-location",
+					StorageProvider: ExternalVolumeStorageProviderAzure,
+					StorageBaseUrl:  "azure://account.blob.core.windows.net/container/path/",
+					AzureTenantId:   String("tenant_id"),
+				},
+			},
+		}
+		opts.AllowWrites = Bool(true)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE EXTERNAL VOLUME %s STORAGE_LOCATIONS = ((NAME = 's3-location' STORAGE_PROVIDER = 'S3' STORAGE_BASE_URL = 's3://bucket/path/' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/role' STORAGE_AWS_EXTERNAL_ID = 'external_id' ENCRYPTION = (TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '1234abcd-12ab-34cd-56ef-1234567890ab')), (NAME = 'azure-location' STORAGE_PROVIDER = 'AZURE' STORAGE_BASE_URL = 'azure://account.blob.core.windows.net/container/path/' AZURE_TENANT_ID = 'tenant_id')) ALLOW_WRITES = true COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestExternalVolumes_Alter(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *AlterExternalVolumeOptions {
+		return &AlterExternalVolumeOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.RemoveStorageLocation = String("location")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalVolumeOptions", "AddStorageLocation", "RemoveStorageLocation", "Set"))
+	})
+
+	t.Run("validation: exactly one field should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RemoveStorageLocation = String("location")
+		opts.Set = &ExternalVolumeSet{Comment: String("comment")}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalVolumeOptions", "AddStorageLocation", "RemoveStorageLocation", "Set"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalVolumeSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalVolumeOptions.Set", "AllowWrites", "Comment"))
+	})
+
+	t.Run("alter: add storage location", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddStorageLocation = &ExternalVolumeStorageLocation{
+			Name:            "gcs-location",
+			StorageProvider: ExternalVolumeStorageProviderGCS,
+			StorageBaseUrl:  "gcs://bucket/path/",
+			Encryption: &ExternalVolumeStorageLocationEncryption{
+				Type: "GCS_SSE_KMS",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL VOLUME IF EXISTS %s ADD STORAGE_LOCATION = (NAME = 'gcs-location' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://bucket/path/' ENCRYPTION = (TYPE = 'GCS_SSE_KMS'))`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: remove storage location", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RemoveStorageLocation = String("gcs-location")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL VOLUME IF EXISTS %s REMOVE STORAGE_LOCATION 'gcs-location'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalVolumeSet{
+			AllowWrites: Bool(false),
+			Comment:     String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL VOLUME IF EXISTS %s SET ALLOW_WRITES = false COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestExternalVolumes_Drop(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *DropExternalVolumeOptions {
+		return &DropExternalVolumeOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP EXTERNAL VOLUME %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP EXTERNAL VOLUME IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestExternalVolumes_Show(t *testing.T) {
+	defaultOpts := func() *ShowExternalVolumeOptions {
+		return &ShowExternalVolumeOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW EXTERNAL VOLUMES`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW EXTERNAL VOLUMES LIKE 'pattern'`)
+	})
+}
+
+func TestExternalVolumes_Describe(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *DescribeExternalVolumeOptions {
+		return &DescribeExternalVolumeOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE EXTERNAL VOLUME %s`, id.FullyQualifiedName())
+	})
+}
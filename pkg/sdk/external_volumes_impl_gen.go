@@ -0,0 +1,161 @@
+package sdk
+
+import "context"
+
+var _ ExternalVolumes = (*externalVolumes)(nil)
+
+type externalVolumes struct {
+	client *Client
+}
+
+func (v *externalVolumes) Create(ctx context.Context, request *CreateExternalVolumeRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalVolumes) Alter(ctx context.Context, request *AlterExternalVolumeRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalVolumes) Drop(ctx context.Context, request *DropExternalVolumeRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalVolumes) Show(ctx context.Context, request *ShowExternalVolumeRequest) ([]ExternalVolume, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[externalVolumeRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[externalVolumeRow, ExternalVolume](dbRows)
+	return resultList, nil
+}
+
+func (v *externalVolumes) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolume, error) {
+	externalVolumes, err := v.Show(ctx, NewShowExternalVolumeRequest().WithLike(&Like{
+		Pattern: String(id.Name()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	for _, externalVolume := range externalVolumes {
+		if externalVolume.Name == id.Name() {
+			return &externalVolume, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *externalVolumes) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ExternalVolumeProperty, error) {
+	opts := &DescribeExternalVolumeOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[externalVolumePropertyRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[externalVolumePropertyRow, ExternalVolumeProperty](rows), nil
+}
+
+func (r *CreateExternalVolumeRequest) toOpts() *CreateExternalVolumeOptions {
+	opts := &CreateExternalVolumeOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+
+		AllowWrites: r.AllowWrites,
+		Comment:     r.Comment,
+	}
+	if r.StorageLocations != nil {
+		s := make([]ExternalVolumeStorageLocationItem, len(r.StorageLocations))
+		for i, v := range r.StorageLocations {
+			s[i] = ExternalVolumeStorageLocationItem{
+				StorageLocation: *v.toOpts(),
+			}
+		}
+		opts.StorageLocations = s
+	}
+	return opts
+}
+
+func (r *ExternalVolumeStorageLocationRequest) toOpts() *ExternalVolumeStorageLocation {
+	opts := &ExternalVolumeStorageLocation{
+		Name:                 r.Name,
+		StorageProvider:      r.StorageProvider,
+		StorageBaseUrl:       r.StorageBaseUrl,
+		StorageAwsRoleArn:    r.StorageAwsRoleArn,
+		StorageAwsExternalId: r.StorageAwsExternalId,
+		AzureTenantId:        r.AzureTenantId,
+	}
+	if r.Encryption != nil {
+		opts.Encryption = &ExternalVolumeStorageLocationEncryption{
+			Type:     r.Encryption.Type,
+			KmsKeyId: r.Encryption.KmsKeyId,
+		}
+	}
+	return opts
+}
+
+func (r *AlterExternalVolumeRequest) toOpts() *AlterExternalVolumeOptions {
+	opts := &AlterExternalVolumeOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+
+		RemoveStorageLocation: r.RemoveStorageLocation,
+	}
+	if r.AddStorageLocation != nil {
+		opts.AddStorageLocation = r.AddStorageLocation.toOpts()
+	}
+	if r.Set != nil {
+		opts.Set = &ExternalVolumeSet{
+			AllowWrites: r.Set.AllowWrites,
+			Comment:     r.Set.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropExternalVolumeRequest) toOpts() *DropExternalVolumeOptions {
+	opts := &DropExternalVolumeOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowExternalVolumeRequest) toOpts() *ShowExternalVolumeOptions {
+	opts := &ShowExternalVolumeOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r externalVolumeRow) convert() *ExternalVolume {
+	e := &ExternalVolume{
+		Name:        r.Name,
+		AllowWrites: r.AllowWrites == "true",
+	}
+	if r.Comment.Valid {
+		e.Comment = r.Comment.String
+	}
+	return e
+}
+
+func (r *DescribeExternalVolumeRequest) toOpts() *DescribeExternalVolumeOptions {
+	opts := &DescribeExternalVolumeOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r externalVolumePropertyRow) convert() *ExternalVolumeProperty {
+	return &ExternalVolumeProperty{
+		ParentProperty:  r.ParentProperty,
+		Property:        r.Property,
+		PropertyType:    r.PropertyType,
+		PropertyValue:   r.PropertyValue,
+		PropertyDefault: r.PropertyDefault,
+	}
+}
@@ -0,0 +1,75 @@
+package sdk
+
+var (
+	_ validatable = new(CreateExternalVolumeOptions)
+	_ validatable = new(AlterExternalVolumeOptions)
+	_ validatable = new(DropExternalVolumeOptions)
+	_ validatable = new(ShowExternalVolumeOptions)
+	_ validatable = new(DescribeExternalVolumeOptions)
+)
+
+func (opts *CreateExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateExternalVolumeOptions", "OrReplace", "IfNotExists"))
+	}
+	if len(opts.StorageLocations) == 0 {
+		errs = append(errs, errNotSet("CreateExternalVolumeOptions", "StorageLocations"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.AddStorageLocation, opts.RemoveStorageLocation, opts.Set) {
+		errs = append(errs, errExactlyOneOf("AlterExternalVolumeOptions", "AddStorageLocation", "RemoveStorageLocation", "Set"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.AllowWrites, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterExternalVolumeOptions.Set", "AllowWrites", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
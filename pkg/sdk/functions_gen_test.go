@@ -0,0 +1,576 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunctions_CreateForJava(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateForJavaFunctionOptions {
+		return &CreateForJavaFunctionOptions{
+			name: id,
+			Returns: FunctionReturns{
+				ResultDataType: &FunctionReturnsResultDataType{
+					ResultDataType: DataTypeVARCHAR,
+				},
+			},
+			Handler: "TestFunc.echoVarchar",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateForJavaFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: handler", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Handler = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateForJavaFunctionOptions", "Handler"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateForJavaFunctionOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: returns", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Returns = FunctionReturns{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateForJavaFunctionOptions.Returns", "ResultDataType", "Table"))
+	})
+
+	t.Run("validation: function definition", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TargetPath = String("@~/testfunc.jar")
+		assertOptsInvalidJoinedErrors(t, opts, NewError("TARGET_PATH must be nil when AS is nil"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE FUNCTION %s () RETURNS VARCHAR LANGUAGE JAVA HANDLER = 'TestFunc.echoVarchar'`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Temporary = Bool(true)
+		opts.Secure = Bool(true)
+		opts.Arguments = []FunctionArgument{
+			{
+				ArgName:     "id",
+				ArgDataType: DataTypeNumber,
+			},
+			{
+				ArgName:      "name",
+				ArgDataType:  DataTypeVARCHAR,
+				DefaultValue: String("'test'"),
+			},
+		}
+		opts.CopyGrants = Bool(true)
+		opts.Returns = FunctionReturns{
+			Table: &FunctionReturnsTable{
+				Columns: []FunctionColumn{
+					{
+						ColumnName:     "country_code",
+						ColumnDataType: DataTypeVARCHAR,
+					},
+				},
+			},
+		}
+		opts.ReturnNullValues = ReturnNullValuesPointer(ReturnNullValuesNotNull)
+		opts.NullInputBehavior = NullInputBehaviorPointer(NullInputBehaviorCalledOnNullInput)
+		opts.ReturnResultsBehavior = ReturnResultsBehaviorPointer(ReturnResultsBehaviorImmutable)
+		opts.RuntimeVersion = String("2.0")
+		opts.Comment = String("comment")
+		opts.Imports = []FunctionImport{
+			{
+				Import: "@~/my_decrement_udf_package_dir/my_decrement_udf_jar.jar",
+			},
+		}
+		opts.Packages = []FunctionPackage{
+			{
+				Package: "com.snowflake:snowpark:1.2.0",
+			},
+		}
+		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
+			NewAccountObjectIdentifier("ext_integration"),
+		}
+		opts.Secrets = []SecretReference{
+			{
+				VariableName: "variable1",
+				Name:         "name1",
+			},
+		}
+		opts.TargetPath = String("@~/testfunc.jar")
+		opts.FunctionDefinition = String("return id + name;")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY SECURE FUNCTION %s (id NUMBER, name VARCHAR DEFAULT 'test') COPY GRANTS RETURNS TABLE (country_code VARCHAR) NOT NULL LANGUAGE JAVA CALLED ON NULL INPUT IMMUTABLE RUNTIME_VERSION = '2.0' COMMENT = 'comment' IMPORTS = ('@~/my_decrement_udf_package_dir/my_decrement_udf_jar.jar') PACKAGES = ('com.snowflake:snowpark:1.2.0') HANDLER = 'TestFunc.echoVarchar' EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration") SECRETS = ('variable1' = name1) TARGET_PATH = '@~/testfunc.jar' AS 'return id + name;'`, id.FullyQualifiedName())
+	})
+}
+
+func TestFunctions_CreateForJavascript(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateForJavascriptFunctionOptions {
+		return &CreateForJavascriptFunctionOptions{
+			name: id,
+			Returns: FunctionReturns{
+				ResultDataType: &FunctionReturnsResultDataType{
+					ResultDataType: DataTypeFloat,
+				},
+			},
+			FunctionDefinition: "return D;",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateForJavascriptFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: function definition", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.FunctionDefinition = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateForJavascriptFunctionOptions", "FunctionDefinition"))
+	})
+
+	t.Run("validation: returns", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Returns = FunctionReturns{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateForJavascriptFunctionOptions.Returns", "ResultDataType", "Table"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Secure = Bool(true)
+		opts.Arguments = []FunctionArgument{
+			{
+				ArgName:     "d",
+				ArgDataType: DataTypeFloat,
+			},
+		}
+		opts.CopyGrants = Bool(true)
+		opts.ReturnNullValues = ReturnNullValuesPointer(ReturnNullValuesNull)
+		opts.NullInputBehavior = NullInputBehaviorPointer(NullInputBehaviorStrict)
+		opts.ReturnResultsBehavior = ReturnResultsBehaviorPointer(ReturnResultsBehaviorVolatile)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SECURE FUNCTION %s (d FLOAT) COPY GRANTS RETURNS FLOAT NULL LANGUAGE JAVASCRIPT STRICT VOLATILE COMMENT = 'comment' AS 'return D;'`, id.FullyQualifiedName())
+	})
+}
+
+func TestFunctions_CreateForPython(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateForPythonFunctionOptions {
+		return &CreateForPythonFunctionOptions{
+			name: id,
+			Returns: FunctionReturns{
+				ResultDataType: &FunctionReturnsResultDataType{
+					ResultDataType: DataTypeNumber,
+				},
+			},
+			RuntimeVersion: "3.8",
+			Handler:        "addone_py",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateForPythonFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: options are missing", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RuntimeVersion = ""
+		opts.Handler = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateForPythonFunctionOptions", "RuntimeVersion"))
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateForPythonFunctionOptions", "Handler"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateForPythonFunctionOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Arguments = []FunctionArgument{
+			{
+				ArgName:     "i",
+				ArgDataType: DataTypeNumber,
+			},
+		}
+		opts.ReturnNullValues = ReturnNullValuesPointer(ReturnNullValuesNotNull)
+		opts.NullInputBehavior = NullInputBehaviorPointer(NullInputBehaviorReturnNullInput)
+		opts.Comment = String("comment")
+		opts.Imports = []FunctionImport{
+			{
+				Import: "numpy",
+			},
+		}
+		opts.Packages = []FunctionPackage{
+			{
+				Package: "numpy",
+			},
+			{
+				Package: "pandas",
+			},
+		}
+		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
+			NewAccountObjectIdentifier("ext_integration"),
+		}
+		opts.Secrets = []SecretReference{
+			{
+				VariableName: "variable1",
+				Name:         "name1",
+			},
+		}
+		opts.FunctionDefinition = String("def addone_py(i): return i+1")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE FUNCTION IF NOT EXISTS %s (i NUMBER) RETURNS NUMBER NOT NULL LANGUAGE PYTHON RETURNS NULL ON NULL INPUT RUNTIME_VERSION = '3.8' COMMENT = 'comment' IMPORTS = ('numpy') PACKAGES = ('numpy', 'pandas') HANDLER = 'addone_py' EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration") SECRETS = ('variable1' = name1) AS 'def addone_py(i): return i+1'`, id.FullyQualifiedName())
+	})
+}
+
+func TestFunctions_CreateForScala(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateForScalaFunctionOptions {
+		return &CreateForScalaFunctionOptions{
+			name:           id,
+			ResultDataType: DataTypeVARCHAR,
+			Handler:        "Echo.echoVarchar",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateForScalaFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: handler", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Handler = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateForScalaFunctionOptions", "Handler"))
+	})
+
+	t.Run("validation: function definition", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TargetPath = String("@~/testfunc.jar")
+		assertOptsInvalidJoinedErrors(t, opts, NewError("TARGET_PATH must be nil when AS is nil"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Arguments = []FunctionArgument{
+			{
+				ArgName:     "x",
+				ArgDataType: DataTypeVARCHAR,
+			},
+		}
+		opts.NullInputBehavior = NullInputBehaviorPointer(NullInputBehaviorCalledOnNullInput)
+		opts.RuntimeVersion = String("2.12")
+		opts.Comment = String("comment")
+		opts.Imports = []FunctionImport{
+			{
+				Import: "@udf_libs/echohandler.jar",
+			},
+		}
+		opts.TargetPath = String("@~/testfunc.jar")
+		opts.FunctionDefinition = String("class Echo { def echoVarchar(x : String): String = { return x } }")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE FUNCTION %s (x VARCHAR) RETURNS VARCHAR LANGUAGE SCALA CALLED ON NULL INPUT RUNTIME_VERSION = '2.12' COMMENT = 'comment' IMPORTS = ('@udf_libs/echohandler.jar') HANDLER = 'Echo.echoVarchar' TARGET_PATH = '@~/testfunc.jar' AS 'class Echo { def echoVarchar(x : String): String = { return x } }'`, id.FullyQualifiedName())
+	})
+}
+
+func TestFunctions_CreateForSQL(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateForSQLFunctionOptions {
+		return &CreateForSQLFunctionOptions{
+			name: id,
+			Returns: FunctionReturns{
+				ResultDataType: &FunctionReturnsResultDataType{
+					ResultDataType: DataTypeFloat,
+				},
+			},
+			FunctionDefinition: "3.141592654::FLOAT",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateForSQLFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: function definition", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.FunctionDefinition = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateForSQLFunctionOptions", "FunctionDefinition"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE FUNCTION %s () RETURNS FLOAT AS '3.141592654::FLOAT'`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Temporary = Bool(true)
+		opts.Secure = Bool(true)
+		opts.Arguments = []FunctionArgument{
+			{
+				ArgName:      "x",
+				ArgDataType:  DataTypeFloat,
+				DefaultValue: String("1.0"),
+			},
+		}
+		opts.CopyGrants = Bool(true)
+		opts.ReturnNullValues = ReturnNullValuesPointer(ReturnNullValuesNotNull)
+		opts.ReturnResultsBehavior = ReturnResultsBehaviorPointer(ReturnResultsBehaviorImmutable)
+		opts.Memoizable = Bool(true)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY SECURE FUNCTION %s (x FLOAT DEFAULT 1.0) COPY GRANTS RETURNS FLOAT NOT NULL IMMUTABLE MEMOIZABLE COMMENT = 'comment' AS '3.141592654::FLOAT'`, id.FullyQualifiedName())
+	})
+}
+
+func TestFunctions_Alter(t *testing.T) {
+	id := NewSchemaObjectIdentifierWithArguments("db", "schema", "fn", []DataType{DataTypeNumber, DataTypeVARCHAR})
+
+	defaultOpts := func() *AlterFunctionOptions {
+		return &AlterFunctionOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetSecure = Bool(true)
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("alter: rename to", func(t *testing.T) {
+		opts := defaultOpts()
+		target := NewSchemaObjectIdentifier("db", "schema", "new_fn")
+		opts.RenameTo = &target
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) RENAME TO "db"."schema"."new_fn"`)
+	})
+
+	t.Run("alter: set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) SET COMMENT = 'comment'`)
+	})
+
+	t.Run("alter: set log level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetLogLevel = String("DEBUG")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) SET LOG_LEVEL = 'DEBUG'`)
+	})
+
+	t.Run("alter: set trace level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTraceLevel = String("ALWAYS")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) SET TRACE_LEVEL = 'ALWAYS'`)
+	})
+
+	t.Run("alter: set secure", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetSecure = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) SET SECURE`)
+	})
+
+	t.Run("alter: unset secure", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetSecure = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) UNSET SECURE`)
+	})
+
+	t.Run("alter: unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) UNSET COMMENT`)
+	})
+
+	t.Run("alter: set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) SET TAG "tag1" = 'value1'`)
+	})
+
+	t.Run("alter: unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER, VARCHAR) UNSET TAG "tag1"`)
+	})
+}
+
+func TestFunctions_Drop(t *testing.T) {
+	id := NewSchemaObjectIdentifierWithArguments("db", "schema", "fn", []DataType{DataTypeNumber})
+
+	defaultOpts := func() *DropFunctionOptions {
+		return &DropFunctionOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP FUNCTION IF EXISTS "db"."schema"."fn"(NUMBER)`)
+	})
+
+	t.Run("without arguments", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifierWithArguments("db", "schema", "fn", []DataType{})
+		assertOptsValidAndSQLEquals(t, opts, `DROP FUNCTION "db"."schema"."fn"()`)
+	})
+}
+
+func TestFunctions_Show(t *testing.T) {
+	defaultOpts := func() *ShowFunctionOptions {
+		return &ShowFunctionOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("show with empty options", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW USER FUNCTIONS`)
+	})
+
+	t.Run("show with like and in", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW USER FUNCTIONS LIKE 'pattern' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestFunctions_Describe(t *testing.T) {
+	id := NewSchemaObjectIdentifierWithArguments("db", "schema", "fn", []DataType{DataTypeVARCHAR, DataTypeNumber})
+
+	defaultOpts := func() *DescribeFunctionOptions {
+		return &DescribeFunctionOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION "db"."schema"."fn"(VARCHAR, NUMBER)`)
+	})
+}
+
+func TestFunction_ArgumentDataTypes(t *testing.T) {
+	testCases := []struct {
+		signature string
+		want      []DataType
+	}{
+		{signature: "MY_FUNCTION() RETURN NUMBER", want: []DataType{}},
+		{signature: "MY_FUNCTION(NUMBER) RETURN NUMBER", want: []DataType{DataTypeNumber}},
+		{signature: "MY_FUNCTION(NUMBER, VARCHAR) RETURN VARCHAR", want: []DataType{DataTypeNumber, DataTypeVARCHAR}},
+		{signature: "MY_FUNCTION(NUMBER, [VARCHAR]) RETURN VARCHAR", want: []DataType{DataTypeNumber, DataTypeVARCHAR}},
+		{signature: "MY_FUNCTION(FLOAT) RETURN TABLE (A NUMBER, B VARCHAR)", want: []DataType{DataTypeFloat}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.signature, func(t *testing.T) {
+			function := Function{Arguments: tc.signature}
+			assert.Equal(t, tc.want, function.ArgumentDataTypes())
+		})
+	}
+
+	t.Run("has argument data types", func(t *testing.T) {
+		function := Function{Arguments: "MY_FUNCTION(NUMBER, VARCHAR) RETURN VARCHAR"}
+		assert.True(t, function.HasArgumentDataTypes([]DataType{"INT", "VARCHAR(100)"}))
+		assert.False(t, function.HasArgumentDataTypes([]DataType{DataTypeNumber}))
+		assert.False(t, function.HasArgumentDataTypes([]DataType{DataTypeVARCHAR, DataTypeNumber}))
+	})
+}
@@ -179,15 +179,17 @@ type ShowGrantsOn struct {
 }
 
 type ShowGrantsTo struct {
-	Role         AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
-	User         AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
-	Share        AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
-	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	Role            AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	User            AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
+	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+	DatabaseRole    DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	ApplicationRole DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
 }
 
 type ShowGrantsOf struct {
-	Role  AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
-	Share AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	Role            AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+	ApplicationRole DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
 }
 
 type grantRow struct {
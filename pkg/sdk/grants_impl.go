@@ -21,7 +21,7 @@ func (v *grants) GrantPrivilegesToAccountRole(ctx context.Context, privileges *A
 	opts.on = on
 	opts.accountRole = role
 	logging.DebugLogger.Printf("[DEBUG] Grant privileges to account role: opts %+v", opts)
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecBatched(v.client, ctx, opts)
 }
 
 func (v *grants) RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error {
@@ -33,7 +33,7 @@ func (v *grants) RevokePrivilegesFromAccountRole(ctx context.Context, privileges
 	opts.on = on
 	opts.accountRole = role
 	logging.DebugLogger.Printf("[DEBUG] Revoke privileges from account role: opts %+v", opts)
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecBatched(v.client, ctx, opts)
 }
 
 func (v *grants) GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error {
@@ -43,7 +43,7 @@ func (v *grants) GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *
 	opts.privileges = privileges
 	opts.on = on
 	opts.databaseRole = role
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecBatched(v.client, ctx, opts)
 }
 
 func (v *grants) RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error {
@@ -53,7 +53,7 @@ func (v *grants) RevokePrivilegesFromDatabaseRole(ctx context.Context, privilege
 	opts.privileges = privileges
 	opts.on = on
 	opts.databaseRole = role
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecBatched(v.client, ctx, opts)
 }
 
 func (v *grants) GrantPrivilegeToShare(ctx context.Context, privilege ObjectPrivilege, on *GrantPrivilegeToShareOn, to AccountObjectIdentifier) error {
@@ -62,7 +62,7 @@ func (v *grants) GrantPrivilegeToShare(ctx context.Context, privilege ObjectPriv
 		On:        on,
 		to:        to,
 	}
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecBatched(v.client, ctx, opts)
 }
 
 func (v *grants) RevokePrivilegeFromShare(ctx context.Context, privilege ObjectPrivilege, on *RevokePrivilegeFromShareOn, id AccountObjectIdentifier) error {
@@ -71,7 +71,7 @@ func (v *grants) RevokePrivilegeFromShare(ctx context.Context, privilege ObjectP
 		On:        on,
 		from:      id,
 	}
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecBatched(v.client, ctx, opts)
 }
 
 func (v *grants) GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error {
@@ -1018,4 +1018,24 @@ func TestGrantShow(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS OF SHARE %s", shareID.FullyQualifiedName())
 	})
+
+	t.Run("to application role", func(t *testing.T) {
+		applicationRoleID := RandomDatabaseObjectIdentifier()
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				ApplicationRole: applicationRoleID,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS TO APPLICATION ROLE %s", applicationRoleID.FullyQualifiedName())
+	})
+
+	t.Run("of application role", func(t *testing.T) {
+		applicationRoleID := RandomDatabaseObjectIdentifier()
+		opts := &ShowGrantOptions{
+			Of: &ShowGrantsOf{
+				ApplicationRole: applicationRoleID,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS OF APPLICATION ROLE %s", applicationRoleID.FullyQualifiedName())
+	})
 }
@@ -23,6 +23,18 @@ func validateAndExec(client *Client, ctx context.Context, opts validatable) erro
 	return err
 }
 
+// validateAndExecBatched is validateAndExec for statements that can be batched with SetStatementBatching.
+func validateAndExecBatched(client *Client, ctx context.Context, opts validatable) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	return client.ExecBatch(ctx, sql)
+}
+
 // validateAndQuery is just a proposal how we can remove some of the boilerplate.
 func validateAndQuery[T any](client *Client, ctx context.Context, opts validatable) ([]T, error) {
 	if err := opts.validate(); err != nil {
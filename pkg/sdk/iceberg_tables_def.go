@@ -0,0 +1,187 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type IcebergTableStorageSerializationPolicy string
+
+var (
+	IcebergTableStorageSerializationPolicyCompatible IcebergTableStorageSerializationPolicy = "COMPATIBLE"
+	IcebergTableStorageSerializationPolicyOptimized  IcebergTableStorageSerializationPolicy = "OPTIMIZED"
+)
+
+var icebergTableColumn = g.NewQueryStruct("IcebergTableColumn").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("Type", "DataType", g.KeywordOptions().NoQuotes().Required()).
+	OptionalSQL("NOT NULL").
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var icebergTableConvertToManaged = g.NewQueryStruct("IcebergTableConvertToManaged").
+	OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes()).
+	OptionalAssignment("STORAGE_SERIALIZATION_POLICY", g.KindOfTPointer[IcebergTableStorageSerializationPolicy](), g.ParameterOptions())
+
+var icebergTableRefresh = g.NewQueryStruct("IcebergTableRefresh").
+	OptionalText("MetadataFileRelativePath", g.KeywordOptions().SingleQuotes())
+
+var icebergTableSet = g.NewQueryStruct("IcebergTableSet").
+	OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalBooleanAssignment("CHANGE_TRACKING", g.ParameterOptions()).
+	OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "Comment")
+
+var icebergTableUnset = g.NewQueryStruct("IcebergTableUnset").
+	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
+	OptionalSQL("MAX_DATA_EXTENSION_TIME_IN_DAYS").
+	OptionalSQL("CHANGE_TRACKING").
+	OptionalSQL("DEFAULT_DDL_COLLATION").
+	OptionalSQL("REPLACE_INVALID_CHARACTERS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "Comment")
+
+var IcebergTablesDef = g.NewInterface(
+	"IcebergTables",
+	"IcebergTable",
+	g.KindOfT[SchemaObjectIdentifier](),
+).CustomOperation(
+	"CreateWithSnowflakeCatalog",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-snowflake",
+	g.NewQueryStruct("CreateIcebergTableWithSnowflakeCatalog").
+		Create().
+		OrReplace().
+		SQL("ICEBERG TABLE").
+		IfNotExists().
+		Name().
+		ListQueryStructField(
+			"Columns",
+			icebergTableColumn,
+			g.ListOptions().Parentheses().Required(),
+		).
+		NamedListWithParens("CLUSTER BY", g.KindOfT[string](), g.KeywordOptions()).
+		OptionalTextAssignment("EXTERNAL_VOLUME", g.ParameterOptions().SingleQuotes()).
+		TextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes().Required()).
+		OptionalAssignment("STORAGE_SERIALIZATION_POLICY", g.KindOfTPointer[IcebergTableStorageSerializationPolicy](), g.ParameterOptions()).
+		OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+		OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions()).
+		OptionalBooleanAssignment("CHANGE_TRACKING", g.ParameterOptions()).
+		OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+		OptionalCopyGrants().
+		OptionalComment().
+		OptionalTags().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).CustomOperation(
+	"CreateWithGlueCatalog",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-aws-glue",
+	g.NewQueryStruct("CreateIcebergTableWithGlueCatalog").
+		Create().
+		OrReplace().
+		SQL("ICEBERG TABLE").
+		IfNotExists().
+		Name().
+		OptionalTextAssignment("EXTERNAL_VOLUME", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("CATALOG", g.ParameterOptions().SingleQuotes()).
+		TextAssignment("CATALOG_TABLE_NAME", g.ParameterOptions().SingleQuotes().Required()).
+		OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+		OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+		OptionalComment().
+		OptionalTags().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).CustomOperation(
+	"CreateFromObjectStore",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-iceberg-files",
+	g.NewQueryStruct("CreateIcebergTableFromObjectStore").
+		Create().
+		OrReplace().
+		SQL("ICEBERG TABLE").
+		IfNotExists().
+		Name().
+		OptionalTextAssignment("EXTERNAL_VOLUME", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("CATALOG", g.ParameterOptions().SingleQuotes()).
+		TextAssignment("METADATA_FILE_PATH", g.ParameterOptions().SingleQuotes().Required()).
+		OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+		OptionalComment().
+		OptionalTags().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).AlterOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table",
+	g.NewQueryStruct("AlterIcebergTable").
+		Alter().
+		SQL("ICEBERG TABLE").
+		IfExists().
+		Name().
+		OptionalQueryStructField(
+			"Refresh",
+			icebergTableRefresh,
+			g.KeywordOptions().SQL("REFRESH"),
+		).
+		OptionalQueryStructField(
+			"ConvertToManaged",
+			icebergTableConvertToManaged,
+			g.KeywordOptions().SQL("CONVERT TO MANAGED"),
+		).
+		OptionalQueryStructField(
+			"Set",
+			icebergTableSet,
+			g.KeywordOptions().SQL("SET"),
+		).
+		OptionalQueryStructField(
+			"Unset",
+			icebergTableUnset,
+			g.ListOptions().NoParentheses().SQL("UNSET"),
+		).
+		OptionalSetTags().
+		OptionalUnsetTags().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ExactlyOneValueSet, "Refresh", "ConvertToManaged", "Set", "Unset", "SetTags", "UnsetTags"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table",
+	g.NewQueryStruct("DropIcebergTable").
+		Drop().
+		SQL("ICEBERG TABLE").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables",
+	g.DbStruct("icebergTableRow").
+		Field("created_on", "time.Time").
+		Field("name", "string").
+		Field("database_name", "string").
+		Field("schema_name", "string").
+		Field("owner", "sql.NullString").
+		Field("external_volume_name", "sql.NullString").
+		Field("catalog_name", "sql.NullString").
+		Field("iceberg_table_type", "sql.NullString").
+		Field("catalog_table_name", "sql.NullString").
+		Field("catalog_namespace", "sql.NullString").
+		Field("base_location", "sql.NullString").
+		Field("comment", "sql.NullString").
+		Field("owner_role_type", "sql.NullString"),
+	g.PlainStruct("IcebergTable").
+		Field("CreatedOn", "time.Time").
+		Field("Name", "string").
+		Field("DatabaseName", "string").
+		Field("SchemaName", "string").
+		Field("Owner", "string").
+		Field("ExternalVolumeName", "string").
+		Field("CatalogName", "string").
+		Field("IcebergTableType", "string").
+		Field("CatalogTableName", "string").
+		Field("CatalogNamespace", "string").
+		Field("BaseLocation", "string").
+		Field("Comment", "string").
+		Field("OwnerRoleType", "string"),
+	g.NewQueryStruct("ShowIcebergTables").
+		Show().
+		SQL("ICEBERG TABLES").
+		OptionalLike().
+		OptionalIn().
+		OptionalStartsWith().
+		OptionalLimit(),
+).ShowByIdOperation()
@@ -0,0 +1,112 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateWithSnowflakeCatalogIcebergTableOptions] = new(CreateWithSnowflakeCatalogIcebergTableRequest)
+	_ optionsProvider[CreateWithGlueCatalogIcebergTableOptions]      = new(CreateWithGlueCatalogIcebergTableRequest)
+	_ optionsProvider[CreateFromObjectStoreIcebergTableOptions]      = new(CreateFromObjectStoreIcebergTableRequest)
+	_ optionsProvider[AlterIcebergTableOptions]                      = new(AlterIcebergTableRequest)
+	_ optionsProvider[DropIcebergTableOptions]                       = new(DropIcebergTableRequest)
+	_ optionsProvider[ShowIcebergTableOptions]                       = new(ShowIcebergTableRequest)
+)
+
+type CreateWithSnowflakeCatalogIcebergTableRequest struct {
+	OrReplace                  *bool
+	IfNotExists                *bool
+	name                       SchemaObjectIdentifier      // required
+	Columns                    []IcebergTableColumnRequest // required
+	ClusterBy                  []string
+	ExternalVolume             *string
+	BaseLocation               string // required
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy
+	DataRetentionTimeInDays    *int
+	MaxDataExtensionTimeInDays *int
+	ChangeTracking             *bool
+	DefaultDdlCollation        *string
+	CopyGrants                 *bool
+	Comment                    *string
+	Tag                        []TagAssociation
+}
+
+type IcebergTableColumnRequest struct {
+	Name    string   // required
+	Type    DataType // required
+	NotNull *bool
+	Comment *string
+}
+
+type CreateWithGlueCatalogIcebergTableRequest struct {
+	OrReplace                *bool
+	IfNotExists              *bool
+	name                     SchemaObjectIdentifier // required
+	ExternalVolume           *string
+	Catalog                  *string
+	CatalogTableName         string // required
+	CatalogNamespace         *string
+	ReplaceInvalidCharacters *bool
+	Comment                  *string
+	Tag                      []TagAssociation
+}
+
+type CreateFromObjectStoreIcebergTableRequest struct {
+	OrReplace                *bool
+	IfNotExists              *bool
+	name                     SchemaObjectIdentifier // required
+	ExternalVolume           *string
+	Catalog                  *string
+	MetadataFilePath         string // required
+	ReplaceInvalidCharacters *bool
+	Comment                  *string
+	Tag                      []TagAssociation
+}
+
+type AlterIcebergTableRequest struct {
+	IfExists         *bool
+	name             SchemaObjectIdentifier // required
+	Refresh          *IcebergTableRefreshRequest
+	ConvertToManaged *IcebergTableConvertToManagedRequest
+	Set              *IcebergTableSetRequest
+	Unset            *IcebergTableUnsetRequest
+	SetTags          []TagAssociation
+	UnsetTags        []ObjectIdentifier
+}
+
+type IcebergTableRefreshRequest struct {
+	MetadataFileRelativePath *string
+}
+
+type IcebergTableConvertToManagedRequest struct {
+	BaseLocation               *string
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy
+}
+
+type IcebergTableSetRequest struct {
+	DataRetentionTimeInDays    *int
+	MaxDataExtensionTimeInDays *int
+	ChangeTracking             *bool
+	DefaultDdlCollation        *string
+	ReplaceInvalidCharacters   *bool
+	Comment                    *string
+}
+
+type IcebergTableUnsetRequest struct {
+	DataRetentionTimeInDays    *bool
+	MaxDataExtensionTimeInDays *bool
+	ChangeTracking             *bool
+	DefaultDdlCollation        *bool
+	ReplaceInvalidCharacters   *bool
+	Comment                    *bool
+}
+
+type DropIcebergTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowIcebergTableRequest struct {
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type IcebergTables interface {
+	CreateWithSnowflakeCatalog(ctx context.Context, request *CreateWithSnowflakeCatalogIcebergTableRequest) error
+	CreateWithGlueCatalog(ctx context.Context, request *CreateWithGlueCatalogIcebergTableRequest) error
+	CreateFromObjectStore(ctx context.Context, request *CreateFromObjectStoreIcebergTableRequest) error
+	Alter(ctx context.Context, request *AlterIcebergTableRequest) error
+	Drop(ctx context.Context, request *DropIcebergTableRequest) error
+	Show(ctx context.Context, request *ShowIcebergTableRequest) ([]IcebergTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error)
+}
+
+// CreateWithSnowflakeCatalogIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-snowflake.
+type CreateWithSnowflakeCatalogIcebergTableOptions struct {
+	create                     bool                                    `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                                   `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable               bool                                    `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists                *bool                                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier                  `ddl:"identifier"`
+	Columns                    []IcebergTableColumn                    `ddl:"list,parentheses"`
+	ClusterBy                  []string                                `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	ExternalVolume             *string                                 `ddl:"parameter,single_quotes" sql:"EXTERNAL_VOLUME"`
+	BaseLocation               string                                  `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy `ddl:"parameter" sql:"STORAGE_SERIALIZATION_POLICY"`
+	DataRetentionTimeInDays    *int                                    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                                    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool                                   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDdlCollation        *string                                 `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	CopyGrants                 *bool                                   `ddl:"keyword" sql:"COPY GRANTS"`
+	Comment                    *string                                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                        []TagAssociation                        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+type IcebergTableColumn struct {
+	Name    string   `ddl:"keyword,double_quotes"`
+	Type    DataType `ddl:"keyword,no_quotes"`
+	NotNull *bool    `ddl:"keyword" sql:"NOT NULL"`
+	Comment *string  `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+// CreateWithGlueCatalogIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-aws-glue.
+type CreateWithGlueCatalogIcebergTableOptions struct {
+	create                   bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace                *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable             bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists              *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                     SchemaObjectIdentifier `ddl:"identifier"`
+	ExternalVolume           *string                `ddl:"parameter,single_quotes" sql:"EXTERNAL_VOLUME"`
+	Catalog                  *string                `ddl:"parameter,single_quotes" sql:"CATALOG"`
+	CatalogTableName         string                 `ddl:"parameter,single_quotes" sql:"CATALOG_TABLE_NAME"`
+	CatalogNamespace         *string                `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	ReplaceInvalidCharacters *bool                  `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                  *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                      []TagAssociation       `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+// CreateFromObjectStoreIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-iceberg-files.
+type CreateFromObjectStoreIcebergTableOptions struct {
+	create                   bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace                *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable             bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists              *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                     SchemaObjectIdentifier `ddl:"identifier"`
+	ExternalVolume           *string                `ddl:"parameter,single_quotes" sql:"EXTERNAL_VOLUME"`
+	Catalog                  *string                `ddl:"parameter,single_quotes" sql:"CATALOG"`
+	MetadataFilePath         string                 `ddl:"parameter,single_quotes" sql:"METADATA_FILE_PATH"`
+	ReplaceInvalidCharacters *bool                  `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                  *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                      []TagAssociation       `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+// AlterIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table.
+type AlterIcebergTableOptions struct {
+	alter            bool                          `ddl:"static" sql:"ALTER"`
+	icebergTable     bool                          `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists         *bool                         `ddl:"keyword" sql:"IF EXISTS"`
+	name             SchemaObjectIdentifier        `ddl:"identifier"`
+	Refresh          *IcebergTableRefresh          `ddl:"keyword" sql:"REFRESH"`
+	ConvertToManaged *IcebergTableConvertToManaged `ddl:"keyword" sql:"CONVERT TO MANAGED"`
+	Set              *IcebergTableSet              `ddl:"keyword" sql:"SET"`
+	Unset            *IcebergTableUnset            `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags          []TagAssociation              `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags        []ObjectIdentifier            `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type IcebergTableRefresh struct {
+	MetadataFileRelativePath *string `ddl:"keyword,single_quotes"`
+}
+
+type IcebergTableConvertToManaged struct {
+	BaseLocation               *string                                 `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy `ddl:"parameter" sql:"STORAGE_SERIALIZATION_POLICY"`
+}
+
+type IcebergTableSet struct {
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDdlCollation        *string `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	ReplaceInvalidCharacters   *bool   `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type IcebergTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool `ddl:"keyword" sql:"CHANGE_TRACKING"`
+	DefaultDdlCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
+	ReplaceInvalidCharacters   *bool `ddl:"keyword" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table.
+type DropIcebergTableOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables.
+type ShowIcebergTableOptions struct {
+	show          bool       `ddl:"static" sql:"SHOW"`
+	icebergTables bool       `ddl:"static" sql:"ICEBERG TABLES"`
+	Like          *Like      `ddl:"keyword" sql:"LIKE"`
+	In            *In        `ddl:"keyword" sql:"IN"`
+	StartsWith    *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit         *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type icebergTableRow struct {
+	CreatedOn          time.Time      `db:"created_on"`
+	Name               string         `db:"name"`
+	DatabaseName       string         `db:"database_name"`
+	SchemaName         string         `db:"schema_name"`
+	Owner              sql.NullString `db:"owner"`
+	ExternalVolumeName sql.NullString `db:"external_volume_name"`
+	CatalogName        sql.NullString `db:"catalog_name"`
+	IcebergTableType   sql.NullString `db:"iceberg_table_type"`
+	CatalogTableName   sql.NullString `db:"catalog_table_name"`
+	CatalogNamespace   sql.NullString `db:"catalog_namespace"`
+	BaseLocation       sql.NullString `db:"base_location"`
+	Comment            sql.NullString `db:"comment"`
+	OwnerRoleType      sql.NullString `db:"owner_role_type"`
+}
+
+type IcebergTable struct {
+	CreatedOn          time.Time
+	Name               string
+	DatabaseName       string
+	SchemaName         string
+	Owner              string
+	ExternalVolumeName string
+	CatalogName        string
+	IcebergTableType   string
+	CatalogTableName   string
+	CatalogNamespace   string
+	BaseLocation       string
+	Comment            string
+	OwnerRoleType      string
+}
+
+func (v *IcebergTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
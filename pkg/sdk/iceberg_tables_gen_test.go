@@ -0,0 +1,353 @@
+package sdk
+
+import "testing"
+
+func TestIcebergTables_CreateWithSnowflakeCatalog(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateWithSnowflakeCatalogIcebergTableOptions {
+		return &CreateWithSnowflakeCatalogIcebergTableOptions{
+			name: id,
+			Columns: []IcebergTableColumn{
+				{Name: "id", Type: DataTypeNumber},
+			},
+			BaseLocation: "path/",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithSnowflakeCatalogIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithSnowflakeCatalogIcebergTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: columns not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateWithSnowflakeCatalogIcebergTableOptions", "Columns"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ICEBERG TABLE %s ("id" NUMBER) BASE_LOCATION = 'path/'`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Columns = []IcebergTableColumn{
+			{Name: "id", Type: DataTypeNumber, NotNull: Bool(true), Comment: String("identifier")},
+			{Name: "name", Type: DataTypeString},
+		}
+		opts.ClusterBy = []string{"id"}
+		opts.ExternalVolume = String("volume")
+		opts.StorageSerializationPolicy = Pointer(IcebergTableStorageSerializationPolicyCompatible)
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.MaxDataExtensionTimeInDays = Int(2)
+		opts.ChangeTracking = Bool(true)
+		opts.DefaultDdlCollation = String("en_US")
+		opts.CopyGrants = Bool(true)
+		opts.Comment = String("comment")
+		t1 := RandomSchemaObjectIdentifier()
+		opts.Tag = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE ICEBERG TABLE %s ("id" NUMBER NOT NULL COMMENT 'identifier', "name" STRING) CLUSTER BY (id) EXTERNAL_VOLUME = 'volume' BASE_LOCATION = 'path/' STORAGE_SERIALIZATION_POLICY = COMPATIBLE DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en_US' COPY GRANTS COMMENT = 'comment' TAG (%s = 'v1')`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_CreateWithGlueCatalog(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateWithGlueCatalogIcebergTableOptions {
+		return &CreateWithGlueCatalogIcebergTableOptions{
+			name:             id,
+			CatalogTableName: "table",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithGlueCatalogIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithGlueCatalogIcebergTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ICEBERG TABLE %s CATALOG_TABLE_NAME = 'table'`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.ExternalVolume = String("volume")
+		opts.Catalog = String("glue_catalog")
+		opts.CatalogNamespace = String("namespace")
+		opts.ReplaceInvalidCharacters = Bool(true)
+		opts.Comment = String("comment")
+		t1 := RandomSchemaObjectIdentifier()
+		opts.Tag = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ICEBERG TABLE IF NOT EXISTS %s EXTERNAL_VOLUME = 'volume' CATALOG = 'glue_catalog' CATALOG_TABLE_NAME = 'table' CATALOG_NAMESPACE = 'namespace' REPLACE_INVALID_CHARACTERS = true COMMENT = 'comment' TAG (%s = 'v1')`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_CreateFromObjectStore(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateFromObjectStoreIcebergTableOptions {
+		return &CreateFromObjectStoreIcebergTableOptions{
+			name:             id,
+			MetadataFilePath: "path/metadata/v1.metadata.json",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateFromObjectStoreIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateFromObjectStoreIcebergTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ICEBERG TABLE %s METADATA_FILE_PATH = 'path/metadata/v1.metadata.json'`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.ExternalVolume = String("volume")
+		opts.Catalog = String("object_store_catalog")
+		opts.ReplaceInvalidCharacters = Bool(false)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE ICEBERG TABLE %s EXTERNAL_VOLUME = 'volume' CATALOG = 'object_store_catalog' METADATA_FILE_PATH = 'path/metadata/v1.metadata.json' REPLACE_INVALID_CHARACTERS = false COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Alter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *AlterIcebergTableOptions {
+		return &AlterIcebergTableOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Refresh = &IcebergTableRefresh{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterIcebergTableOptions", "Refresh", "ConvertToManaged", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = &IcebergTableRefresh{}
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterIcebergTableOptions", "Refresh", "ConvertToManaged", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &IcebergTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterIcebergTableOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &IcebergTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterIcebergTableOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "Comment"))
+	})
+
+	t.Run("alter: refresh", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = &IcebergTableRefresh{}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s REFRESH`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: refresh with metadata file", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = &IcebergTableRefresh{
+			MetadataFileRelativePath: String("metadata/v2.metadata.json"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s REFRESH 'metadata/v2.metadata.json'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: convert to managed", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s CONVERT TO MANAGED`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: convert to managed with options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{
+			BaseLocation:               String("path/"),
+			StorageSerializationPolicy: Pointer(IcebergTableStorageSerializationPolicyOptimized),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s CONVERT TO MANAGED BASE_LOCATION = 'path/' STORAGE_SERIALIZATION_POLICY = OPTIMIZED`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &IcebergTableSet{
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(2),
+			ChangeTracking:             Bool(true),
+			DefaultDdlCollation:        String("en_US"),
+			ReplaceInvalidCharacters:   Bool(true),
+			Comment:                    String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s SET DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en_US' REPLACE_INVALID_CHARACTERS = true COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &IcebergTableUnset{
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			ChangeTracking:             Bool(true),
+			DefaultDdlCollation:        Bool(true),
+			ReplaceInvalidCharacters:   Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, CHANGE_TRACKING, DEFAULT_DDL_COLLATION, REPLACE_INVALID_CHARACTERS, COMMENT`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		t1 := RandomSchemaObjectIdentifier()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  t1,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s SET TAG %s = 'v1'`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		t1 := RandomSchemaObjectIdentifier()
+		opts.UnsetTags = []ObjectIdentifier{t1}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s UNSET TAG %s`, id.FullyQualifiedName(), t1.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Drop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *DropIcebergTableOptions {
+		return &DropIcebergTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP ICEBERG TABLE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP ICEBERG TABLE IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Show(t *testing.T) {
+	defaultOpts := func() *ShowIcebergTableOptions {
+		return &ShowIcebergTableOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ICEBERG TABLES`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		opts.StartsWith = String("A")
+		opts.Limit = &LimitFrom{
+			Rows: Int(1),
+			From: String("B"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ICEBERG TABLES LIKE 'pattern' IN SCHEMA "db"."schema" STARTS WITH 'A' LIMIT 1 FROM 'B'`)
+	})
+}
@@ -0,0 +1,222 @@
+package sdk
+
+import "context"
+
+var _ IcebergTables = (*icebergTables)(nil)
+
+type icebergTables struct {
+	client *Client
+}
+
+func (v *icebergTables) CreateWithSnowflakeCatalog(ctx context.Context, request *CreateWithSnowflakeCatalogIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) CreateWithGlueCatalog(ctx context.Context, request *CreateWithGlueCatalogIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) CreateFromObjectStore(ctx context.Context, request *CreateFromObjectStoreIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Alter(ctx context.Context, request *AlterIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Drop(ctx context.Context, request *DropIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Show(ctx context.Context, request *ShowIcebergTableRequest) ([]IcebergTable, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[icebergTableRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[icebergTableRow, IcebergTable](dbRows)
+	return resultList, nil
+}
+
+func (v *icebergTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error) {
+	icebergTables, err := v.Show(ctx, NewShowIcebergTableRequest().
+		WithIn(&In{
+			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		}).
+		WithLike(&Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	for _, icebergTable := range icebergTables {
+		if icebergTable.Name == id.Name() {
+			return &icebergTable, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (r *CreateWithSnowflakeCatalogIcebergTableRequest) toOpts() *CreateWithSnowflakeCatalogIcebergTableOptions {
+	opts := &CreateWithSnowflakeCatalogIcebergTableOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+
+		ClusterBy:                  r.ClusterBy,
+		ExternalVolume:             r.ExternalVolume,
+		BaseLocation:               r.BaseLocation,
+		StorageSerializationPolicy: r.StorageSerializationPolicy,
+		DataRetentionTimeInDays:    r.DataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: r.MaxDataExtensionTimeInDays,
+		ChangeTracking:             r.ChangeTracking,
+		DefaultDdlCollation:        r.DefaultDdlCollation,
+		CopyGrants:                 r.CopyGrants,
+		Comment:                    r.Comment,
+		Tag:                        r.Tag,
+	}
+	if r.Columns != nil {
+		s := make([]IcebergTableColumn, len(r.Columns))
+		for i, v := range r.Columns {
+			s[i] = IcebergTableColumn{
+				Name:    v.Name,
+				Type:    v.Type,
+				NotNull: v.NotNull,
+				Comment: v.Comment,
+			}
+		}
+		opts.Columns = s
+	}
+	return opts
+}
+
+func (r *CreateWithGlueCatalogIcebergTableRequest) toOpts() *CreateWithGlueCatalogIcebergTableOptions {
+	opts := &CreateWithGlueCatalogIcebergTableOptions{
+		OrReplace:                r.OrReplace,
+		IfNotExists:              r.IfNotExists,
+		name:                     r.name,
+		ExternalVolume:           r.ExternalVolume,
+		Catalog:                  r.Catalog,
+		CatalogTableName:         r.CatalogTableName,
+		CatalogNamespace:         r.CatalogNamespace,
+		ReplaceInvalidCharacters: r.ReplaceInvalidCharacters,
+		Comment:                  r.Comment,
+		Tag:                      r.Tag,
+	}
+	return opts
+}
+
+func (r *CreateFromObjectStoreIcebergTableRequest) toOpts() *CreateFromObjectStoreIcebergTableOptions {
+	opts := &CreateFromObjectStoreIcebergTableOptions{
+		OrReplace:                r.OrReplace,
+		IfNotExists:              r.IfNotExists,
+		name:                     r.name,
+		ExternalVolume:           r.ExternalVolume,
+		Catalog:                  r.Catalog,
+		MetadataFilePath:         r.MetadataFilePath,
+		ReplaceInvalidCharacters: r.ReplaceInvalidCharacters,
+		Comment:                  r.Comment,
+		Tag:                      r.Tag,
+	}
+	return opts
+}
+
+func (r *AlterIcebergTableRequest) toOpts() *AlterIcebergTableOptions {
+	opts := &AlterIcebergTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Refresh != nil {
+		opts.Refresh = &IcebergTableRefresh{
+			MetadataFileRelativePath: r.Refresh.MetadataFileRelativePath,
+		}
+	}
+	if r.ConvertToManaged != nil {
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{
+			BaseLocation:               r.ConvertToManaged.BaseLocation,
+			StorageSerializationPolicy: r.ConvertToManaged.StorageSerializationPolicy,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &IcebergTableSet{
+			DataRetentionTimeInDays:    r.Set.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Set.MaxDataExtensionTimeInDays,
+			ChangeTracking:             r.Set.ChangeTracking,
+			DefaultDdlCollation:        r.Set.DefaultDdlCollation,
+			ReplaceInvalidCharacters:   r.Set.ReplaceInvalidCharacters,
+			Comment:                    r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &IcebergTableUnset{
+			DataRetentionTimeInDays:    r.Unset.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Unset.MaxDataExtensionTimeInDays,
+			ChangeTracking:             r.Unset.ChangeTracking,
+			DefaultDdlCollation:        r.Unset.DefaultDdlCollation,
+			ReplaceInvalidCharacters:   r.Unset.ReplaceInvalidCharacters,
+			Comment:                    r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropIcebergTableRequest) toOpts() *DropIcebergTableOptions {
+	opts := &DropIcebergTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowIcebergTableRequest) toOpts() *ShowIcebergTableOptions {
+	opts := &ShowIcebergTableOptions{
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r icebergTableRow) convert() *IcebergTable {
+	t := &IcebergTable{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+	}
+	if r.Owner.Valid {
+		t.Owner = r.Owner.String
+	}
+	if r.ExternalVolumeName.Valid {
+		t.ExternalVolumeName = r.ExternalVolumeName.String
+	}
+	if r.CatalogName.Valid {
+		t.CatalogName = r.CatalogName.String
+	}
+	if r.IcebergTableType.Valid {
+		t.IcebergTableType = r.IcebergTableType.String
+	}
+	if r.CatalogTableName.Valid {
+		t.CatalogTableName = r.CatalogTableName.String
+	}
+	if r.CatalogNamespace.Valid {
+		t.CatalogNamespace = r.CatalogNamespace.String
+	}
+	if r.BaseLocation.Valid {
+		t.BaseLocation = r.BaseLocation.String
+	}
+	if r.Comment.Valid {
+		t.Comment = r.Comment.String
+	}
+	if r.OwnerRoleType.Valid {
+		t.OwnerRoleType = r.OwnerRoleType.String
+	}
+	return t
+}
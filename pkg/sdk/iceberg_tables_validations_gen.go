@@ -0,0 +1,98 @@
+package sdk
+
+var (
+	_ validatable = new(CreateWithSnowflakeCatalogIcebergTableOptions)
+	_ validatable = new(CreateWithGlueCatalogIcebergTableOptions)
+	_ validatable = new(CreateFromObjectStoreIcebergTableOptions)
+	_ validatable = new(AlterIcebergTableOptions)
+	_ validatable = new(DropIcebergTableOptions)
+	_ validatable = new(ShowIcebergTableOptions)
+)
+
+func (opts *CreateWithSnowflakeCatalogIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithSnowflakeCatalogIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if len(opts.Columns) == 0 {
+		errs = append(errs, errNotSet("CreateWithSnowflakeCatalogIcebergTableOptions", "Columns"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithGlueCatalogIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithGlueCatalogIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateFromObjectStoreIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateFromObjectStoreIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Refresh, opts.ConvertToManaged, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterIcebergTableOptions", "Refresh", "ConvertToManaged", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.ChangeTracking, opts.Set.DefaultDdlCollation, opts.Set.ReplaceInvalidCharacters, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterIcebergTableOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.ChangeTracking, opts.Unset.DefaultDdlCollation, opts.Unset.ReplaceInvalidCharacters, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterIcebergTableOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "ReplaceInvalidCharacters", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
@@ -243,6 +243,11 @@ func (i SchemaObjectIdentifier) Arguments() []DataType {
 	return i.arguments
 }
 
+// WithoutArguments returns the identifier of a function or procedure without its signature (e.g. to rename it).
+func (i SchemaObjectIdentifier) WithoutArguments() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(i.databaseName, i.schemaName, i.name)
+}
+
 func (i SchemaObjectIdentifier) SchemaIdentifier() DatabaseObjectIdentifier {
 	return NewDatabaseObjectIdentifier(i.databaseName, i.schemaName)
 }
@@ -251,10 +256,10 @@ func (i SchemaObjectIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.name == "" {
 		return ""
 	}
-	if len(i.arguments) == 0 {
+	if i.arguments == nil {
 		return fmt.Sprintf(`"%v"."%v"."%v"`, i.databaseName, i.schemaName, i.name)
 	}
-	// if this is a function or procedure, we need to include the arguments
+	// if this is a function or procedure, we need to include the arguments (even if there are none)
 	args := make([]string, len(i.arguments))
 	for i, arg := range i.arguments {
 		args[i] = string(arg)
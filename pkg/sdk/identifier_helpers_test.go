@@ -82,3 +82,24 @@ func TestDatabaseObjectIdentifier(t *testing.T) {
 		assert.Equal(t, `"aaa"."bbb"`, identifier.FullyQualifiedName())
 	})
 }
+
+func TestSchemaObjectIdentifier(t *testing.T) {
+	t.Run("get fully qualified name", func(t *testing.T) {
+		identifier := NewSchemaObjectIdentifier("aaa", "bbb", "ccc")
+
+		assert.Equal(t, `"aaa"."bbb"."ccc"`, identifier.FullyQualifiedName())
+	})
+
+	t.Run("get fully qualified name with arguments", func(t *testing.T) {
+		identifier := NewSchemaObjectIdentifierWithArguments("aaa", "bbb", "ccc", []DataType{DataTypeNumber, DataTypeVARCHAR})
+
+		assert.Equal(t, `"aaa"."bbb"."ccc"(NUMBER, VARCHAR)`, identifier.FullyQualifiedName())
+		assert.Equal(t, `"aaa"."bbb"."ccc"`, identifier.WithoutArguments().FullyQualifiedName())
+	})
+
+	t.Run("get fully qualified name without arguments", func(t *testing.T) {
+		identifier := NewSchemaObjectIdentifierWithArguments("aaa", "bbb", "ccc", []DataType{})
+
+		assert.Equal(t, `"aaa"."bbb"."ccc"()`, identifier.FullyQualifiedName())
+	})
+}
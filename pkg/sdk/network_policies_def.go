@@ -8,6 +8,13 @@ var (
 	ip = g.NewQueryStruct("IP").
 		Text("IP", g.KeywordOptions().SingleQuotes().Required())
 
+	networkPolicyNetworkRules = func(name string) *g.QueryStruct {
+		return g.NewQueryStruct(name).
+			ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+			ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+			WithValidation(g.ExactlyOneValueSet, "AllowedNetworkRuleList", "BlockedNetworkRuleList")
+	}
+
 	NetworkPoliciesDef = g.NewInterface(
 		"NetworkPolicies",
 		"NetworkPolicy",
@@ -22,6 +29,8 @@ var (
 				Name().
 				ListQueryStructField("AllowedIpList", ip, g.ParameterOptions().SQL("ALLOWED_IP_LIST").Parentheses()).
 				ListQueryStructField("BlockedIpList", ip, g.ParameterOptions().SQL("BLOCKED_IP_LIST").Parentheses()).
+				ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+				ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
 				OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 				WithValidation(g.ValidIdentifier, "name"),
 		).
@@ -37,14 +46,28 @@ var (
 					g.NewQueryStruct("NetworkPolicySet").
 						ListQueryStructField("AllowedIpList", ip, g.ParameterOptions().SQL("ALLOWED_IP_LIST").Parentheses()).
 						ListQueryStructField("BlockedIpList", ip, g.ParameterOptions().SQL("BLOCKED_IP_LIST").Parentheses()).
+						ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+						ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
 						OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
-						WithValidation(g.AtLeastOneValueSet, "AllowedIpList", "BlockedIpList", "Comment"),
+						WithValidation(g.AtLeastOneValueSet, "AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "Comment"),
 					g.KeywordOptions().SQL("SET"),
 				).
+				OptionalSQL("UNSET ALLOWED_IP_LIST").
+				OptionalSQL("UNSET BLOCKED_IP_LIST").
 				OptionalSQL("UNSET COMMENT").
+				OptionalQueryStructField(
+					"Add",
+					networkPolicyNetworkRules("AddNetworkRule"),
+					g.KeywordOptions().SQL("ADD"),
+				).
+				OptionalQueryStructField(
+					"Remove",
+					networkPolicyNetworkRules("RemoveNetworkRule"),
+					g.KeywordOptions().SQL("REMOVE"),
+				).
 				Identifier("RenameTo", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
 				WithValidation(g.ValidIdentifier, "name").
-				WithValidation(g.ExactlyOneValueSet, "Set", "UnsetComment", "RenameTo").
+				WithValidation(g.ExactlyOneValueSet, "Set", "UnsetAllowedIpList", "UnsetBlockedIpList", "UnsetComment", "Add", "Remove", "RenameTo").
 				WithValidation(g.ValidIdentifierIfSet, "RenameTo"),
 		).
 		DropOperation(
@@ -63,13 +86,17 @@ var (
 				Field("name", "string").
 				Field("comment", "string").
 				Field("entries_in_allowed_ip_list", "int").
-				Field("entries_in_blocked_ip_list", "int"),
+				Field("entries_in_blocked_ip_list", "int").
+				Field("entries_in_allowed_network_rules", "int").
+				Field("entries_in_blocked_network_rules", "int"),
 			g.PlainStruct("NetworkPolicy").
 				Field("CreatedOn", "string").
 				Field("Name", "string").
 				Field("Comment", "string").
 				Field("EntriesInAllowedIpList", "int").
-				Field("EntriesInBlockedIpList", "int"),
+				Field("EntriesInBlockedIpList", "int").
+				Field("EntriesInAllowedNetworkRules", "int").
+				Field("EntriesInBlockedNetworkRules", "int"),
 			g.NewQueryStruct("ShowNetworkPolicies").
 				Show().
 				SQL("NETWORK POLICIES"),
@@ -2,6 +2,8 @@
 
 package sdk
 
+import ()
+
 func NewCreateNetworkPolicyRequest(
 	name AccountObjectIdentifier,
 ) *CreateNetworkPolicyRequest {
@@ -25,6 +27,16 @@ func (s *CreateNetworkPolicyRequest) WithBlockedIpList(BlockedIpList []IPRequest
 	return s
 }
 
+func (s *CreateNetworkPolicyRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *CreateNetworkPolicyRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *CreateNetworkPolicyRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *CreateNetworkPolicyRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func (s *CreateNetworkPolicyRequest) WithComment(Comment *string) *CreateNetworkPolicyRequest {
 	s.Comment = Comment
 	return s
@@ -56,11 +68,31 @@ func (s *AlterNetworkPolicyRequest) WithSet(Set *NetworkPolicySetRequest) *Alter
 	return s
 }
 
+func (s *AlterNetworkPolicyRequest) WithUnsetAllowedIpList(UnsetAllowedIpList *bool) *AlterNetworkPolicyRequest {
+	s.UnsetAllowedIpList = UnsetAllowedIpList
+	return s
+}
+
+func (s *AlterNetworkPolicyRequest) WithUnsetBlockedIpList(UnsetBlockedIpList *bool) *AlterNetworkPolicyRequest {
+	s.UnsetBlockedIpList = UnsetBlockedIpList
+	return s
+}
+
 func (s *AlterNetworkPolicyRequest) WithUnsetComment(UnsetComment *bool) *AlterNetworkPolicyRequest {
 	s.UnsetComment = UnsetComment
 	return s
 }
 
+func (s *AlterNetworkPolicyRequest) WithAdd(Add *AddNetworkRuleRequest) *AlterNetworkPolicyRequest {
+	s.Add = Add
+	return s
+}
+
+func (s *AlterNetworkPolicyRequest) WithRemove(Remove *RemoveNetworkRuleRequest) *AlterNetworkPolicyRequest {
+	s.Remove = Remove
+	return s
+}
+
 func (s *AlterNetworkPolicyRequest) WithRenameTo(RenameTo *AccountObjectIdentifier) *AlterNetworkPolicyRequest {
 	s.RenameTo = RenameTo
 	return s
@@ -80,11 +112,49 @@ func (s *NetworkPolicySetRequest) WithBlockedIpList(BlockedIpList []IPRequest) *
 	return s
 }
 
+func (s *NetworkPolicySetRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicySetRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *NetworkPolicySetRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicySetRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func (s *NetworkPolicySetRequest) WithComment(Comment *string) *NetworkPolicySetRequest {
 	s.Comment = Comment
 	return s
 }
 
+func NewAddNetworkRuleRequest() *AddNetworkRuleRequest {
+	return &AddNetworkRuleRequest{}
+}
+
+func (s *AddNetworkRuleRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *AddNetworkRuleRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *AddNetworkRuleRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *AddNetworkRuleRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
+func NewRemoveNetworkRuleRequest() *RemoveNetworkRuleRequest {
+	return &RemoveNetworkRuleRequest{}
+}
+
+func (s *RemoveNetworkRuleRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *RemoveNetworkRuleRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *RemoveNetworkRuleRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *RemoveNetworkRuleRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func NewDropNetworkPolicyRequest(
 	name AccountObjectIdentifier,
 ) *DropNetworkPolicyRequest {
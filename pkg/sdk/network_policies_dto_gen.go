@@ -11,11 +11,13 @@ var (
 )
 
 type CreateNetworkPolicyRequest struct {
-	OrReplace     *bool
-	name          AccountObjectIdentifier // required
-	AllowedIpList []IPRequest
-	BlockedIpList []IPRequest
-	Comment       *string
+	OrReplace              *bool
+	name                   AccountObjectIdentifier // required
+	AllowedIpList          []IPRequest
+	BlockedIpList          []IPRequest
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+	Comment                *string
 }
 
 func (r *CreateNetworkPolicyRequest) GetName() AccountObjectIdentifier {
@@ -27,17 +29,33 @@ type IPRequest struct {
 }
 
 type AlterNetworkPolicyRequest struct {
-	IfExists     *bool
-	name         AccountObjectIdentifier // required
-	Set          *NetworkPolicySetRequest
-	UnsetComment *bool
-	RenameTo     *AccountObjectIdentifier
+	IfExists           *bool
+	name               AccountObjectIdentifier // required
+	Set                *NetworkPolicySetRequest
+	UnsetAllowedIpList *bool
+	UnsetBlockedIpList *bool
+	UnsetComment       *bool
+	Add                *AddNetworkRuleRequest
+	Remove             *RemoveNetworkRuleRequest
+	RenameTo           *AccountObjectIdentifier
 }
 
 type NetworkPolicySetRequest struct {
-	AllowedIpList []IPRequest
-	BlockedIpList []IPRequest
-	Comment       *string
+	AllowedIpList          []IPRequest
+	BlockedIpList          []IPRequest
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+	Comment                *string
+}
+
+type AddNetworkRuleRequest struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+}
+
+type RemoveNetworkRuleRequest struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
 }
 
 type DropNetworkPolicyRequest struct {
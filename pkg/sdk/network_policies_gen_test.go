@@ -6,6 +6,8 @@ import (
 
 func TestNetworkPolicies_Create(t *testing.T) {
 	id := RandomAccountObjectIdentifier()
+	allowedRuleId := RandomSchemaObjectIdentifier()
+	blockedRuleId := RandomSchemaObjectIdentifier()
 
 	// Minimal valid CreateNetworkPolicyOptions
 	defaultOpts := func() *CreateNetworkPolicyOptions {
@@ -33,10 +35,20 @@ func TestNetworkPolicies_Create(t *testing.T) {
 		opts := defaultOpts()
 		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE NETWORK POLICY %s ALLOWED_IP_LIST = ('123.0.0.1', '321.0.0.1') BLOCKED_IP_LIST = ('123.0.0.1', '321.0.0.1') COMMENT = 'some_comment'", opts.name.FullyQualifiedName())
 	})
+
+	t.Run("with network rules", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AllowedIpList = nil
+		opts.BlockedIpList = nil
+		opts.AllowedNetworkRuleList = []SchemaObjectIdentifier{allowedRuleId}
+		opts.BlockedNetworkRuleList = []SchemaObjectIdentifier{blockedRuleId}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE NETWORK POLICY %s ALLOWED_NETWORK_RULE_LIST = (%s) BLOCKED_NETWORK_RULE_LIST = (%s) COMMENT = 'some_comment'", id.FullyQualifiedName(), allowedRuleId.FullyQualifiedName(), blockedRuleId.FullyQualifiedName())
+	})
 }
 
 func TestNetworkPolicies_Alter(t *testing.T) {
 	id := RandomAccountObjectIdentifier()
+	ruleId := RandomSchemaObjectIdentifier()
 
 	// Minimal valid AlterNetworkPolicyOptions
 	defaultOpts := func() *AlterNetworkPolicyOptions {
@@ -58,15 +70,30 @@ func TestNetworkPolicies_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: exactly one field from [opts.Set opts.UnsetComment opts.RenameTo] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.Set opts.UnsetAllowedIpList opts.UnsetBlockedIpList opts.UnsetComment opts.Add opts.Remove opts.RenameTo] should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterNetworkPolicyOptions", "Set", "UnsetComment", "RenameTo"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterNetworkPolicyOptions", "Set", "UnsetAllowedIpList", "UnsetBlockedIpList", "UnsetComment", "Add", "Remove", "RenameTo"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.AllowedIpList opts.Set.BlockedIpList opts.Set.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.AllowedIpList opts.Set.BlockedIpList opts.Set.AllowedNetworkRuleList opts.Set.BlockedNetworkRuleList opts.Set.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &NetworkPolicySet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedIpList", "BlockedIpList", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "Comment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Add.AllowedNetworkRuleList opts.Add.BlockedNetworkRuleList] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &AddNetworkRule{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterNetworkPolicyOptions.Add", "AllowedNetworkRuleList", "BlockedNetworkRuleList"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Remove.AllowedNetworkRuleList opts.Remove.BlockedNetworkRuleList] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Remove = &RemoveNetworkRule{
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{ruleId},
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{ruleId},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterNetworkPolicyOptions.Remove", "AllowedNetworkRuleList", "BlockedNetworkRuleList"))
 	})
 
 	t.Run("set allowed ip list", func(t *testing.T) {
@@ -85,6 +112,31 @@ func TestNetworkPolicies_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET BLOCKED_IP_LIST = ('123.0.0.1')", id.FullyQualifiedName())
 	})
 
+	t.Run("set network rule lists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &NetworkPolicySet{
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{ruleId},
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{ruleId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET ALLOWED_NETWORK_RULE_LIST = (%s) BLOCKED_NETWORK_RULE_LIST = (%s)", id.FullyQualifiedName(), ruleId.FullyQualifiedName(), ruleId.FullyQualifiedName())
+	})
+
+	t.Run("add allowed network rule", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &AddNetworkRule{
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{ruleId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s ADD ALLOWED_NETWORK_RULE_LIST = (%s)", id.FullyQualifiedName(), ruleId.FullyQualifiedName())
+	})
+
+	t.Run("remove blocked network rule", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Remove = &RemoveNetworkRule{
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{ruleId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s REMOVE BLOCKED_NETWORK_RULE_LIST = (%s)", id.FullyQualifiedName(), ruleId.FullyQualifiedName())
+	})
+
 	t.Run("set comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &NetworkPolicySet{
@@ -93,6 +145,18 @@ func TestNetworkPolicies_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET COMMENT = 'some_comment'", id.FullyQualifiedName())
 	})
 
+	t.Run("unset allowed ip list", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetAllowedIpList = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s UNSET ALLOWED_IP_LIST", id.FullyQualifiedName())
+	})
+
+	t.Run("unset blocked ip list", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetBlockedIpList = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s UNSET BLOCKED_IP_LIST", id.FullyQualifiedName())
+	})
+
 	t.Run("unset comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.UnsetComment = Bool(true)
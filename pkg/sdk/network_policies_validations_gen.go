@@ -29,15 +29,25 @@ func (opts *AlterNetworkPolicyOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Set, opts.UnsetComment, opts.RenameTo); !ok {
-		errs = append(errs, errExactlyOneOf("AlterNetworkPolicyOptions", "Set", "UnsetComment", "RenameTo"))
+	if ok := exactlyOneValueSet(opts.Set, opts.UnsetAllowedIpList, opts.UnsetBlockedIpList, opts.UnsetComment, opts.Add, opts.Remove, opts.RenameTo); !ok {
+		errs = append(errs, errExactlyOneOf("AlterNetworkPolicyOptions", "Set", "UnsetAllowedIpList", "UnsetBlockedIpList", "UnsetComment", "Add", "Remove", "RenameTo"))
 	}
 	if valueSet(opts.RenameTo) && !ValidObjectIdentifier(opts.RenameTo) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if valueSet(opts.Set) {
-		if ok := anyValueSet(opts.Set.AllowedIpList, opts.Set.BlockedIpList, opts.Set.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedIpList", "BlockedIpList", "Comment"))
+		if ok := anyValueSet(opts.Set.AllowedIpList, opts.Set.BlockedIpList, opts.Set.AllowedNetworkRuleList, opts.Set.BlockedNetworkRuleList, opts.Set.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "Comment"))
+		}
+	}
+	if valueSet(opts.Add) {
+		if ok := exactlyOneValueSet(opts.Add.AllowedNetworkRuleList, opts.Add.BlockedNetworkRuleList); !ok {
+			errs = append(errs, errExactlyOneOf("AlterNetworkPolicyOptions.Add", "AllowedNetworkRuleList", "BlockedNetworkRuleList"))
+		}
+	}
+	if valueSet(opts.Remove) {
+		if ok := exactlyOneValueSet(opts.Remove.AllowedNetworkRuleList, opts.Remove.BlockedNetworkRuleList); !ok {
+			errs = append(errs, errExactlyOneOf("AlterNetworkPolicyOptions.Remove", "AllowedNetworkRuleList", "BlockedNetworkRuleList"))
 		}
 	}
 	return errors.Join(errs...)
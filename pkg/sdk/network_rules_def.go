@@ -0,0 +1,139 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type NetworkRuleType string
+
+var (
+	NetworkRuleTypeIpv4        NetworkRuleType = "IPV4"
+	NetworkRuleTypeAwsVpceId   NetworkRuleType = "AWSVPCEID"
+	NetworkRuleTypeAzureLinkId NetworkRuleType = "AZURELINKID"
+	NetworkRuleTypeHostPort    NetworkRuleType = "HOST_PORT"
+)
+
+type NetworkRuleMode string
+
+var (
+	NetworkRuleModeIngress       NetworkRuleMode = "INGRESS"
+	NetworkRuleModeInternalStage NetworkRuleMode = "INTERNAL_STAGE"
+	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
+)
+
+var networkRuleValue = g.NewQueryStruct("NetworkRuleValue").
+	Text("Value", g.KeywordOptions().SingleQuotes().Required())
+
+var networkRuleSet = g.NewQueryStruct("NetworkRuleSet").
+	ListQueryStructField("ValueList", networkRuleValue, g.ParameterOptions().Parentheses().SQL("VALUE_LIST")).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "ValueList", "Comment")
+
+var networkRuleUnset = g.NewQueryStruct("NetworkRuleUnset").
+	OptionalSQL("VALUE_LIST").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "ValueList", "Comment")
+
+var NetworkRulesDef = g.NewInterface(
+	"NetworkRules",
+	"NetworkRule",
+	g.KindOfT[SchemaObjectIdentifier](),
+).CreateOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/create-network-rule",
+	g.NewQueryStruct("CreateNetworkRule").
+		Create().
+		OrReplace().
+		SQL("NETWORK RULE").
+		Name().
+		Assignment("TYPE", g.KindOfT[NetworkRuleType](), g.ParameterOptions().NoQuotes().Required()).
+		ListQueryStructField("ValueList", networkRuleValue, g.ParameterOptions().MustParentheses().SQL("VALUE_LIST")).
+		Assignment("MODE", g.KindOfT[NetworkRuleMode](), g.ParameterOptions().NoQuotes().Required()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name"),
+).AlterOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-network-rule",
+	g.NewQueryStruct("AlterNetworkRule").
+		Alter().
+		SQL("NETWORK RULE").
+		IfExists().
+		Name().
+		OptionalQueryStructField(
+			"Set",
+			networkRuleSet,
+			g.KeywordOptions().SQL("SET"),
+		).
+		OptionalQueryStructField(
+			"Unset",
+			networkRuleUnset,
+			g.ListOptions().NoParentheses().SQL("UNSET"),
+		).
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/drop-network-rule",
+	g.NewQueryStruct("DropNetworkRule").
+		Drop().
+		SQL("NETWORK RULE").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-network-rules",
+	g.DbStruct("showNetworkRulesRow").
+		Field("created_on", "time.Time").
+		Field("name", "string").
+		Field("database_name", "string").
+		Field("schema_name", "string").
+		Field("owner", "string").
+		Field("comment", "string").
+		Field("type", "string").
+		Field("mode", "string").
+		Field("entries_in_valuelist", "int").
+		Field("owner_role_type", "string"),
+	g.PlainStruct("NetworkRule").
+		Field("CreatedOn", "time.Time").
+		Field("Name", "string").
+		Field("DatabaseName", "string").
+		Field("SchemaName", "string").
+		Field("Owner", "string").
+		Field("Comment", "string").
+		Field("Type", "NetworkRuleType").
+		Field("Mode", "NetworkRuleMode").
+		Field("EntriesInValueList", "int").
+		Field("OwnerRoleType", "string"),
+	g.NewQueryStruct("ShowNetworkRules").
+		Show().
+		SQL("NETWORK RULES").
+		OptionalLike().
+		OptionalIn().
+		OptionalStartsWith().
+		OptionalLimit(),
+).ShowByIdOperation().DescribeOperation(
+	g.DescriptionMappingKindSingleValue,
+	"https://docs.snowflake.com/en/sql-reference/sql/desc-network-rule",
+	g.DbStruct("describeNetworkRulesRow").
+		Field("created_on", "time.Time").
+		Field("name", "string").
+		Field("database_name", "string").
+		Field("schema_name", "string").
+		Field("owner", "string").
+		Field("comment", "string").
+		Field("type", "string").
+		Field("mode", "string").
+		Field("value_list", "string"),
+	g.PlainStruct("NetworkRuleDetails").
+		Field("CreatedOn", "time.Time").
+		Field("Name", "string").
+		Field("DatabaseName", "string").
+		Field("SchemaName", "string").
+		Field("Owner", "string").
+		Field("Comment", "string").
+		Field("Type", "NetworkRuleType").
+		Field("Mode", "NetworkRuleMode").
+		Field("ValueList", "[]string"),
+	g.NewQueryStruct("DescribeNetworkRule").
+		Describe().
+		SQL("NETWORK RULE").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+)
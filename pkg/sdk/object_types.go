@@ -61,6 +61,7 @@ const (
 	ObjectTypeColumn             ObjectType = "COLUMN"
 	ObjectTypeIcebergTable       ObjectType = "ICEBERG TABLE"
 	ObjectTypeExternalVolume     ObjectType = "EXTERNAL VOLUME"
+	ObjectTypeComputePool        ObjectType = "COMPUTE POOL"
 )
 
 func (o ObjectType) String() string {
@@ -113,6 +114,7 @@ func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 		ObjectTypeStreamlit:          PluralObjectTypeStreamlits,
 		ObjectTypeIcebergTable:       PluralObjectTypeIcebergTables,
 		ObjectTypeExternalVolume:     PluralObjectTypeExternalVolumes,
+		ObjectTypeComputePool:        PluralObjectTypeComputePools,
 	}
 }
 
@@ -205,6 +207,7 @@ const (
 	PluralObjectTypeStreamlits          PluralObjectType = "STREAMLITS"
 	PluralObjectTypeIcebergTables       PluralObjectType = "ICEBERG TABLES"
 	PluralObjectTypeExternalVolumes     PluralObjectType = "EXTERNAL VOLUMES"
+	PluralObjectTypeComputePools        PluralObjectType = "COMPUTE POOLS"
 )
 
 func (p PluralObjectType) String() string {
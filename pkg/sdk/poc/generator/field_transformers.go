@@ -64,6 +64,7 @@ type ParameterTransformer struct {
 	quotes      string
 	parentheses string
 	equals      string
+	reverse     string
 }
 
 func ParameterOptions() *ParameterTransformer {
@@ -120,6 +121,16 @@ func (v *ParameterTransformer) Parentheses() *ParameterTransformer {
 	return v
 }
 
+func (v *ParameterTransformer) MustParentheses() *ParameterTransformer {
+	v.quotes = "must_parentheses"
+	return v
+}
+
+func (v *ParameterTransformer) Reverse() *ParameterTransformer {
+	v.reverse = "reverse"
+	return v
+}
+
 func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", "parameter")
 	if v.required {
@@ -129,6 +140,7 @@ func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", v.quotes)
 	addTagIfMissing(f.Tags, "ddl", v.parentheses)
 	addTagIfMissing(f.Tags, "ddl", v.equals)
+	addTagIfMissing(f.Tags, "ddl", v.reverse)
 	return f
 }
 
@@ -11,6 +11,10 @@ const (
 	OperationKindDescribe OperationKind = "Describe"
 	OperationKindGrant    OperationKind = "Grant"
 	OperationKindRevoke   OperationKind = "Revoke"
+	// OperationKindShowGrantsTo and OperationKindShowGrantsOf list the grants using Grants.Show, so they are not
+	// backed by options structs of their own
+	OperationKindShowGrantsTo OperationKind = "ShowGrantsTo"
+	OperationKindShowGrantsOf OperationKind = "ShowGrantsOf"
 )
 
 type DescriptionMappingKind string
@@ -164,6 +168,14 @@ func (i *Interface) ShowByIdOperation() *Interface {
 	return i.newNoSqlOperation(string(OperationKindShowByID))
 }
 
+func (i *Interface) ShowGrantsToOperation() *Interface {
+	return i.newNoSqlOperation(string(OperationKindShowGrantsTo))
+}
+
+func (i *Interface) ShowGrantsOfOperation() *Interface {
+	return i.newNoSqlOperation(string(OperationKindShowGrantsOf))
+}
+
 func (i *Interface) DescribeOperation(describeKind DescriptionMappingKind, doc string, dbRepresentation *dbStruct, resourceRepresentation *plainStruct, queryStruct *QueryStruct) *Interface {
 	op := i.newOperationWithDBMapping(string(OperationKindDescribe), doc, dbRepresentation, resourceRepresentation, queryStruct, addDescriptionMapping)
 	op.DescribeKind = &describeKind
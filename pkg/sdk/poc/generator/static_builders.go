@@ -5,6 +5,12 @@ func (v *QueryStruct) SQL(sql string) *QueryStruct {
 	return v
 }
 
+// SQLWithCustomFieldName adds static SQL under the given field name, for the SQL from which no field name can be derived, e.g. TYPE = PASSWORD.
+func (v *QueryStruct) SQLWithCustomFieldName(fieldName string, sql string) *QueryStruct {
+	v.fields = append(v.fields, NewField(fieldName, "bool", Tags().Static().SQL(sql), nil))
+	return v
+}
+
 func (v *QueryStruct) Create() *QueryStruct {
 	return v.SQL("CREATE")
 }
@@ -19,6 +19,8 @@ type {{ .Name }} interface {
 			{{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) ([]{{ .ShowMapping.To.Name }}, error)
 		{{- else if eq .Name "ShowByID" }}
 			{{ .Name }}(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.NameSingular }}, error)
+		{{- else if or (eq .Name "ShowGrantsTo") (eq .Name "ShowGrantsOf") }}
+			{{ .Name }}(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) ([]Grant, error)
 		{{- else if and (eq .Name "Describe") .DescribeMapping }}
 			{{- if .DescribeKind }}
 				{{- if eq (deref .DescribeKind) "single_value" }}
@@ -147,6 +149,22 @@ type {{ $impl }} struct {
 			}
 			return collections.FindOne({{ $impl }}, func(r {{ .ObjectInterface.NameSingular }}) bool { return r.Name == id.Name() })
 		}
+	{{ else if eq .Name "ShowGrantsTo" }}
+		func (v *{{ $impl }}) ShowGrantsTo(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) ([]Grant, error) {
+			return v.client.Grants.Show(ctx, &ShowGrantOptions{
+				To: &ShowGrantsTo{
+					{{ .ObjectInterface.NameSingular }}: id,
+				},
+			})
+		}
+	{{ else if eq .Name "ShowGrantsOf" }}
+		func (v *{{ $impl }}) ShowGrantsOf(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) ([]Grant, error) {
+			return v.client.Grants.Show(ctx, &ShowGrantOptions{
+				Of: &ShowGrantsOf{
+					{{ .ObjectInterface.NameSingular }}: id,
+				},
+			})
+		}
 	{{ else if and (eq .Name "Describe") .DescribeMapping }}
 		{{ if .DescribeKind }}
 			{{ if eq (deref .DescribeKind) "single_value" }}
@@ -16,18 +16,27 @@ import (
 )
 
 var definitionMapping = map[string]*generator.Interface{
-	"database_role_def.go":      example.DatabaseRole,
-	"network_policies_def.go":   sdk.NetworkPoliciesDef,
-	"session_policies_def.go":   sdk.SessionPoliciesDef,
-	"tasks_def.go":              sdk.TasksDef,
-	"streams_def.go":            sdk.StreamsDef,
-	"application_roles_def.go":  sdk.ApplicationRolesDef,
-	"views_def.go":              sdk.ViewsDef,
-	"stages_def.go":             sdk.StagesDef,
-	"procedures_def.go":         sdk.ProceduresDef,
-	"event_tables_def.go":       sdk.EventTablesDef,
-	"compute_pools_def.go":      sdk.ComputePoolsDef,
-	"image_repositories_def.go": sdk.ImageRepositoriesDef,
+	"database_role_def.go":                example.DatabaseRole,
+	"network_policies_def.go":             sdk.NetworkPoliciesDef,
+	"session_policies_def.go":             sdk.SessionPoliciesDef,
+	"tasks_def.go":                        sdk.TasksDef,
+	"streams_def.go":                      sdk.StreamsDef,
+	"application_roles_def.go":            sdk.ApplicationRolesDef,
+	"views_def.go":                        sdk.ViewsDef,
+	"stages_def.go":                       sdk.StagesDef,
+	"procedures_def.go":                   sdk.ProceduresDef,
+	"functions_def.go":                    sdk.FunctionsDef,
+	"event_tables_def.go":                 sdk.EventTablesDef,
+	"compute_pools_def.go":                sdk.ComputePoolsDef,
+	"image_repositories_def.go":           sdk.ImageRepositoriesDef,
+	"replication_groups_def.go":           sdk.ReplicationGroupsDef,
+	"application_packages_def.go":         sdk.ApplicationPackagesDef,
+	"applications_def.go":                 sdk.ApplicationsDef,
+	"external_volumes_def.go":             sdk.ExternalVolumesDef,
+	"iceberg_tables_def.go":               sdk.IcebergTablesDef,
+	"secrets_def.go":                      sdk.SecretsDef,
+	"network_rules_def.go":                sdk.NetworkRulesDef,
+	"external_access_integrations_def.go": sdk.ExternalAccessIntegrationsDef,
 }
 
 func main() {
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type PolicyReferences interface {
+	// GetForEntity lists the policies set on the given object, e.g. the session policy of a user.
+	GetForEntity(ctx context.Context, entityName ObjectIdentifier, entityDomain PolicyEntityDomain) ([]PolicyReference, error)
+}
+
+var _ PolicyReferences = (*policyReferences)(nil)
+
+type policyReferences struct {
+	client *Client
+}
+
+type PolicyEntityDomain string
+
+const (
+	PolicyEntityDomainAccount PolicyEntityDomain = "ACCOUNT"
+	PolicyEntityDomainUser    PolicyEntityDomain = "USER"
+	PolicyEntityDomainTable   PolicyEntityDomain = "TABLE"
+	PolicyEntityDomainView    PolicyEntityDomain = "VIEW"
+)
+
+type PolicyKind string
+
+const (
+	PolicyKindSessionPolicy  PolicyKind = "SESSION_POLICY"
+	PolicyKindPasswordPolicy PolicyKind = "PASSWORD_POLICY"
+)
+
+type PolicyReference struct {
+	PolicyDb        string
+	PolicySchema    string
+	PolicyName      string
+	PolicyKind      PolicyKind
+	RefEntityName   string
+	RefEntityDomain string
+}
+
+func (v *PolicyReference) PolicyID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.PolicyDb, v.PolicySchema, v.PolicyName)
+}
+
+type policyReferenceRow struct {
+	PolicyDb        string `db:"POLICY_DB"`
+	PolicySchema    string `db:"POLICY_SCHEMA"`
+	PolicyName      string `db:"POLICY_NAME"`
+	PolicyKind      string `db:"POLICY_KIND"`
+	RefEntityName   string `db:"REF_ENTITY_NAME"`
+	RefEntityDomain string `db:"REF_ENTITY_DOMAIN"`
+}
+
+func (row policyReferenceRow) convert() *PolicyReference {
+	return &PolicyReference{
+		PolicyDb:        row.PolicyDb,
+		PolicySchema:    row.PolicySchema,
+		PolicyName:      row.PolicyName,
+		PolicyKind:      PolicyKind(row.PolicyKind),
+		RefEntityName:   row.RefEntityName,
+		RefEntityDomain: row.RefEntityDomain,
+	}
+}
+
+// GetForEntity is based on https://docs.snowflake.com/en/sql-reference/functions/policy_references.
+func (v *policyReferences) GetForEntity(ctx context.Context, entityName ObjectIdentifier, entityDomain PolicyEntityDomain) ([]PolicyReference, error) {
+	rows := []policyReferenceRow{}
+	sql := fmt.Sprintf(
+		`SELECT POLICY_DB, POLICY_SCHEMA, POLICY_NAME, POLICY_KIND, REF_ENTITY_NAME, REF_ENTITY_DOMAIN FROM TABLE(SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '%s', REF_ENTITY_DOMAIN => '%s'))`,
+		strings.ReplaceAll(entityName.FullyQualifiedName(), `'`, `\'`), entityDomain,
+	)
+	if err := v.client.query(ctx, &rows, sql); err != nil {
+		return nil, err
+	}
+	return convertRows[policyReferenceRow, PolicyReference](rows), nil
+}
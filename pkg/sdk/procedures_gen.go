@@ -34,7 +34,7 @@ type CreateForJavaProcedureOptions struct {
 	Imports                    []ProcedureImport         `ddl:"parameter,parentheses" sql:"IMPORTS"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	Secrets                    []SecretReference         `ddl:"parameter,parentheses" sql:"SECRETS"`
 	TargetPath                 *string                   `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
 	NullInputBehavior          *NullInputBehavior        `ddl:"keyword"`
 	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
@@ -110,7 +110,7 @@ type CreateForPythonProcedureOptions struct {
 	Imports                    []ProcedureImport         `ddl:"parameter,parentheses" sql:"IMPORTS"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	Secrets                    []SecretReference         `ddl:"parameter,parentheses" sql:"SECRETS"`
 	NullInputBehavior          *NullInputBehavior        `ddl:"keyword"`
 	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
 	ExecuteAs                  *ExecuteAs                `ddl:"keyword"`
@@ -230,6 +230,11 @@ type Procedure struct {
 	IsSecure           bool
 }
 
+// ArgumentDataTypes parses the argument types from the signature returned by SHOW PROCEDURES, e.g. MY_PROCEDURE(NUMBER) RETURN NUMBER.
+func (v *Procedure) ArgumentDataTypes() []DataType {
+	return parseSignatureArgumentDataTypes(v.Arguments)
+}
+
 // DescribeProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-procedure.
 type DescribeProcedureOptions struct {
 	describe          bool                   `ddl:"static" sql:"DESCRIBE"`
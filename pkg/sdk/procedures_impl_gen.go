@@ -63,7 +63,9 @@ func (v *procedures) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(procedures, func(r Procedure) bool { return r.Name == id.Name() })
+	return collections.FindOne(procedures, func(r Procedure) bool {
+		return r.Name == id.Name() && (id.Arguments() == nil || argumentDataTypesEqual(r.ArgumentDataTypes(), id.Arguments()))
+	})
 }
 
 func (v *procedures) Describe(ctx context.Context, request *DescribeProcedureRequest) ([]ProcedureDetail, error) {
@@ -1,4 +1,106 @@
 package sdk
 
-// note: once Replication Groups is implemented, Databases Integration test for CreateSecondary needs to be implemented
+import (
+	"context"
+	"errors"
+)
+
+// note: Databases Integration test for CreateSecondary still needs to be implemented using Replication Groups
 // also: TestInt_AlterReplication
+
+var (
+	_ validatable = new(showReplicationGroupDatabasesOptions)
+	_ validatable = new(showReplicationGroupSharesOptions)
+)
+
+type ReplicationGroupSecondaryState string
+
+const (
+	ReplicationGroupSecondaryStateSuspended ReplicationGroupSecondaryState = "SUSPENDED"
+	ReplicationGroupSecondaryStateStarted   ReplicationGroupSecondaryState = "STARTED"
+	ReplicationGroupSecondaryStateNull      ReplicationGroupSecondaryState = "NULL"
+)
+
+// showReplicationGroupDatabasesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-replication-group.
+type showReplicationGroupDatabasesOptions struct {
+	show      bool                    `ddl:"static" sql:"SHOW"`
+	databases bool                    `ddl:"static" sql:"DATABASES"`
+	in        AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupDatabasesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.in) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
+
+// showReplicationGroupSharesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-replication-group.
+type showReplicationGroupSharesOptions struct {
+	show   bool                    `ddl:"static" sql:"SHOW"`
+	shares bool                    `ddl:"static" sql:"SHARES"`
+	in     AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupSharesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.in) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupSharesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewExternalObjectIdentifierFromFullyQualifiedName(row.Name).objectIdentifier.(AccountObjectIdentifier)
+	}
+	return resultList, nil
+}
@@ -0,0 +1,154 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var (
+	replicationGroupSet = g.NewQueryStruct("ReplicationGroupSet").
+				PredefinedQueryStructField("ObjectTypes", "[]PluralObjectType", g.ParameterOptions().SQL("OBJECT_TYPES")).
+				PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_DATABASES")).
+				PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_SHARES")).
+				PredefinedQueryStructField("AllowedIntegrationTypes", "[]IntegrationType", g.ParameterOptions().SQL("ALLOWED_INTEGRATION_TYPES")).
+				OptionalTextAssignment("REPLICATION_SCHEDULE", g.ParameterOptions().SingleQuotes()).
+				WithValidation(g.AtLeastOneValueSet, "ObjectTypes", "AllowedDatabases", "AllowedShares", "AllowedIntegrationTypes", "ReplicationSchedule")
+
+	replicationGroupUnset = g.NewQueryStruct("ReplicationGroupUnset").
+				OptionalSQL("REPLICATION_SCHEDULE").
+				WithValidation(g.AtLeastOneValueSet, "ReplicationSchedule")
+
+	replicationGroupAdd = g.NewQueryStruct("ReplicationGroupAdd").
+				PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("TO ALLOWED_DATABASES").Reverse()).
+				PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("TO ALLOWED_SHARES").Reverse()).
+				PredefinedQueryStructField("AllowedAccounts", "[]AccountIdentifier", g.ParameterOptions().SQL("TO ALLOWED_ACCOUNTS").Reverse()).
+				OptionalSQL("IGNORE EDITION CHECK").
+				WithValidation(g.ExactlyOneValueSet, "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+
+	replicationGroupRemove = g.NewQueryStruct("ReplicationGroupRemove").
+				PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("FROM ALLOWED_DATABASES").Reverse()).
+				PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("FROM ALLOWED_SHARES").Reverse()).
+				PredefinedQueryStructField("AllowedAccounts", "[]AccountIdentifier", g.ParameterOptions().SQL("FROM ALLOWED_ACCOUNTS").Reverse()).
+				WithValidation(g.ExactlyOneValueSet, "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+
+	replicationGroupMove = g.NewQueryStruct("ReplicationGroupMove").
+				PredefinedQueryStructField("Databases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("DATABASES").NoEquals()).
+				PredefinedQueryStructField("Shares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("SHARES").NoEquals()).
+				Identifier("To", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("TO REPLICATION GROUP").Required()).
+				WithValidation(g.ExactlyOneValueSet, "Databases", "Shares").
+				WithValidation(g.ValidIdentifier, "To")
+
+	replicationGroupDbRow = g.DbStruct("replicationGroupDBRow").
+				Field("region_group", "string").
+				Field("snowflake_region", "string").
+				Field("created_on", "time.Time").
+				Field("account_name", "string").
+				Field("name", "string").
+				Field("type", "string").
+				Field("comment", "sql.NullString").
+				Field("is_primary", "bool").
+				Field("primary", "string").
+				Field("object_types", "string").
+				Field("allowed_integration_types", "string").
+				Field("allowed_accounts", "string").
+				Field("organization_name", "string").
+				Field("account_locator", "string").
+				Field("replication_schedule", "sql.NullString").
+				Field("secondary_state", "sql.NullString").
+				Field("next_scheduled_refresh", "sql.NullString").
+				Field("owner", "sql.NullString")
+
+	replicationGroup = g.PlainStruct("ReplicationGroup").
+				Field("RegionGroup", "string").
+				Field("SnowflakeRegion", "string").
+				Field("CreatedOn", "time.Time").
+				Field("AccountName", "string").
+				Field("Name", "string").
+				Field("Type", "string").
+				Field("Comment", "string").
+				Field("IsPrimary", "bool").
+				Field("Primary", "ExternalObjectIdentifier").
+				Field("ObjectTypes", "[]PluralObjectType").
+				Field("AllowedIntegrationTypes", "[]IntegrationType").
+				Field("AllowedAccounts", "[]AccountIdentifier").
+				Field("OrganizationName", "string").
+				Field("AccountLocator", "string").
+				Field("ReplicationSchedule", "string").
+				Field("SecondaryState", "ReplicationGroupSecondaryState").
+				Field("NextScheduledRefresh", "string").
+				Field("Owner", "string")
+
+	ReplicationGroupsDef = g.NewInterface(
+		"ReplicationGroups",
+		"ReplicationGroup",
+		g.KindOfT[AccountObjectIdentifier](),
+	).
+		CreateOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/create-replication-group",
+			g.NewQueryStruct("CreateReplicationGroup").
+				Create().
+				SQL("REPLICATION GROUP").
+				IfNotExists().
+				Name().
+				PredefinedQueryStructField("ObjectTypes", "[]PluralObjectType", g.ParameterOptions().SQL("OBJECT_TYPES").Required()).
+				PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_DATABASES")).
+				PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_SHARES")).
+				PredefinedQueryStructField("AllowedIntegrationTypes", "[]IntegrationType", g.ParameterOptions().SQL("ALLOWED_INTEGRATION_TYPES")).
+				PredefinedQueryStructField("AllowedAccounts", "[]AccountIdentifier", g.ParameterOptions().SQL("ALLOWED_ACCOUNTS").Required()).
+				OptionalSQL("IGNORE EDITION CHECK").
+				OptionalTextAssignment("REPLICATION_SCHEDULE", g.ParameterOptions().SingleQuotes()).
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ValidateValueSet, "ObjectTypes").
+				WithValidation(g.ValidateValueSet, "AllowedAccounts"),
+		).
+		CustomOperation(
+			"CreateReplica",
+			"https://docs.snowflake.com/en/sql-reference/sql/create-replication-group",
+			g.NewQueryStruct("CreateReplicaReplicationGroup").
+				Create().
+				SQL("REPLICATION GROUP").
+				IfNotExists().
+				Name().
+				Identifier("PrimaryReplicationGroup", g.KindOfT[ExternalObjectIdentifier](), g.IdentifierOptions().SQL("AS REPLICA OF").Required()).
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ValidIdentifier, "PrimaryReplicationGroup"),
+		).
+		AlterOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group",
+			g.NewQueryStruct("AlterReplicationGroup").
+				Alter().
+				SQL("REPLICATION GROUP").
+				IfExists().
+				Name().
+				Identifier("RenameTo", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+				OptionalQueryStructField("Set", replicationGroupSet, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", replicationGroupUnset, g.KeywordOptions().SQL("UNSET")).
+				OptionalQueryStructField("Add", replicationGroupAdd, g.KeywordOptions().SQL("ADD")).
+				OptionalQueryStructField("Remove", replicationGroupRemove, g.KeywordOptions().SQL("REMOVE")).
+				OptionalQueryStructField("Move", replicationGroupMove, g.KeywordOptions().SQL("MOVE")).
+				OptionalSQL("REFRESH").
+				OptionalSQL("SUSPEND").
+				OptionalSQL("RESUME").
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset", "Add", "Remove", "Move", "Refresh", "Suspend", "Resume").
+				WithValidation(g.ValidIdentifierIfSet, "RenameTo"),
+		).
+		DropOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/drop-replication-group",
+			g.NewQueryStruct("DropReplicationGroup").
+				Drop().
+				SQL("REPLICATION GROUP").
+				IfExists().
+				Name().
+				WithValidation(g.ValidIdentifier, "name"),
+		).
+		ShowOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/show-replication-groups",
+			replicationGroupDbRow,
+			replicationGroup,
+			g.NewQueryStruct("ShowReplicationGroups").
+				Show().
+				SQL("REPLICATION GROUPS").
+				OptionalIdentifier("InAccount", g.KindOfTPointer[AccountIdentifier](), g.IdentifierOptions().SQL("IN ACCOUNT")),
+		).
+		ShowByIdOperation()
+)
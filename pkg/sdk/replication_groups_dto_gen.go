@@ -0,0 +1,87 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateReplicationGroupOptions]        = new(CreateReplicationGroupRequest)
+	_ optionsProvider[CreateReplicaReplicationGroupOptions] = new(CreateReplicaReplicationGroupRequest)
+	_ optionsProvider[AlterReplicationGroupOptions]         = new(AlterReplicationGroupRequest)
+	_ optionsProvider[DropReplicationGroupOptions]          = new(DropReplicationGroupRequest)
+	_ optionsProvider[ShowReplicationGroupOptions]          = new(ShowReplicationGroupRequest)
+)
+
+type CreateReplicationGroupRequest struct {
+	IfNotExists             *bool
+	name                    AccountObjectIdentifier // required
+	ObjectTypes             []PluralObjectType      // required
+	AllowedDatabases        []AccountObjectIdentifier
+	AllowedShares           []AccountObjectIdentifier
+	AllowedIntegrationTypes []IntegrationType
+	AllowedAccounts         []AccountIdentifier // required
+	IgnoreEditionCheck      *bool
+	ReplicationSchedule     *string
+}
+
+func (r *CreateReplicationGroupRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+type CreateReplicaReplicationGroupRequest struct {
+	IfNotExists             *bool
+	name                    AccountObjectIdentifier  // required
+	PrimaryReplicationGroup ExternalObjectIdentifier // required
+}
+
+type AlterReplicationGroupRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+	RenameTo *AccountObjectIdentifier
+	Set      *ReplicationGroupSetRequest
+	Unset    *ReplicationGroupUnsetRequest
+	Add      *ReplicationGroupAddRequest
+	Remove   *ReplicationGroupRemoveRequest
+	Move     *ReplicationGroupMoveRequest
+	Refresh  *bool
+	Suspend  *bool
+	Resume   *bool
+}
+
+type ReplicationGroupSetRequest struct {
+	ObjectTypes             []PluralObjectType
+	AllowedDatabases        []AccountObjectIdentifier
+	AllowedShares           []AccountObjectIdentifier
+	AllowedIntegrationTypes []IntegrationType
+	ReplicationSchedule     *string
+}
+
+type ReplicationGroupUnsetRequest struct {
+	ReplicationSchedule *bool
+}
+
+type ReplicationGroupAddRequest struct {
+	AllowedDatabases   []AccountObjectIdentifier
+	AllowedShares      []AccountObjectIdentifier
+	AllowedAccounts    []AccountIdentifier
+	IgnoreEditionCheck *bool
+}
+
+type ReplicationGroupRemoveRequest struct {
+	AllowedDatabases []AccountObjectIdentifier
+	AllowedShares    []AccountObjectIdentifier
+	AllowedAccounts  []AccountIdentifier
+}
+
+type ReplicationGroupMoveRequest struct {
+	Databases []AccountObjectIdentifier
+	Shares    []AccountObjectIdentifier
+	To        AccountObjectIdentifier // required
+}
+
+type DropReplicationGroupRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+}
+
+type ShowReplicationGroupRequest struct {
+	InAccount *AccountIdentifier
+}
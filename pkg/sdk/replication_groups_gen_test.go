@@ -0,0 +1,332 @@
+package sdk
+
+import "testing"
+
+func TestReplicationGroups_Create(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateReplicationGroupOptions
+	defaultOpts := func() *CreateReplicationGroupOptions {
+		return &CreateReplicationGroupOptions{
+			name:            id,
+			ObjectTypes:     []PluralObjectType{PluralObjectTypeRoles},
+			AllowedAccounts: []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateReplicationGroupOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.ObjectTypes] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectTypes = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateReplicationGroupOptions", "ObjectTypes"))
+	})
+
+	t.Run("validation: [opts.AllowedAccounts] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AllowedAccounts = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateReplicationGroupOptions", "AllowedAccounts"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP %s OBJECT_TYPES = ROLES ALLOWED_ACCOUNTS = "MY_ORG.MY_ACCOUNT"`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.ObjectTypes = []PluralObjectType{PluralObjectTypeDatabases, PluralObjectTypeShares, PluralObjectTypeIntegrations}
+		opts.AllowedDatabases = []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")}
+		opts.AllowedShares = []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")}
+		opts.AllowedIntegrationTypes = []IntegrationType{IntegrationTypeAPIIntegrations}
+		opts.IgnoreEditionCheck = Bool(true)
+		opts.ReplicationSchedule = String("10 MINUTE")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS %s OBJECT_TYPES = DATABASES, SHARES, INTEGRATIONS ALLOWED_DATABASES = "db1" ALLOWED_SHARES = "share1" ALLOWED_INTEGRATION_TYPES = API INTEGRATIONS ALLOWED_ACCOUNTS = "MY_ORG.MY_ACCOUNT" IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`, id.FullyQualifiedName())
+	})
+}
+
+func TestReplicationGroups_CreateReplica(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+	primaryId := NewExternalObjectIdentifier(NewAccountIdentifier("myorg", "myaccount"), id)
+
+	// Minimal valid CreateReplicaReplicationGroupOptions
+	defaultOpts := func() *CreateReplicaReplicationGroupOptions {
+		return &CreateReplicaReplicationGroupOptions{
+			name:                    id,
+			PrimaryReplicationGroup: primaryId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateReplicaReplicationGroupOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.PrimaryReplicationGroup]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.PrimaryReplicationGroup = NewExternalObjectIdentifier(NewAccountIdentifier("myorg", "myaccount"), NewAccountObjectIdentifier(""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP %s AS REPLICA OF %s`, id.FullyQualifiedName(), primaryId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS %s AS REPLICA OF %s`, id.FullyQualifiedName(), primaryId.FullyQualifiedName())
+	})
+}
+
+func TestReplicationGroups_Alter(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterReplicationGroupOptions
+	defaultOpts := func() *AlterReplicationGroupOptions {
+		return &AlterReplicationGroupOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterReplicationGroupOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.Refresh = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.Unset opts.Add opts.Remove opts.Move opts.Refresh opts.Suspend opts.Resume] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterReplicationGroupOptions", "RenameTo", "Set", "Unset", "Add", "Remove", "Move", "Refresh", "Suspend", "Resume"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.Unset opts.Add opts.Remove opts.Move opts.Refresh opts.Suspend opts.Resume] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Suspend = Bool(true)
+		opts.Resume = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterReplicationGroupOptions", "RenameTo", "Set", "Unset", "Add", "Remove", "Move", "Refresh", "Suspend", "Resume"))
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RenameTo = Pointer(NewAccountObjectIdentifier(""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.ObjectTypes opts.Set.AllowedDatabases opts.Set.AllowedShares opts.Set.AllowedIntegrationTypes opts.Set.ReplicationSchedule] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ReplicationGroupSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterReplicationGroupOptions.Set", "ObjectTypes", "AllowedDatabases", "AllowedShares", "AllowedIntegrationTypes", "ReplicationSchedule"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Add.AllowedDatabases opts.Add.AllowedShares opts.Add.AllowedAccounts] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &ReplicationGroupAdd{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterReplicationGroupOptions.Add", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Remove.AllowedDatabases opts.Remove.AllowedShares opts.Remove.AllowedAccounts] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Remove = &ReplicationGroupRemove{
+			AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+			AllowedShares:    []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterReplicationGroupOptions.Remove", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Move.Databases opts.Move.Shares] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Move = &ReplicationGroupMove{
+			To: RandomAccountObjectIdentifier(),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterReplicationGroupOptions.Move", "Databases", "Shares"))
+	})
+
+	t.Run("validation: valid identifier for [opts.Move.To]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Move = &ReplicationGroupMove{
+			Databases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := defaultOpts()
+		newId := RandomAccountObjectIdentifier()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP IF EXISTS %s RENAME TO %s`, id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ReplicationGroupSet{
+			ObjectTypes:             []PluralObjectType{PluralObjectTypeDatabases, PluralObjectTypeIntegrations},
+			AllowedDatabases:        []AccountObjectIdentifier{NewAccountObjectIdentifier("db1"), NewAccountObjectIdentifier("db2")},
+			AllowedIntegrationTypes: []IntegrationType{IntegrationTypeSecurityIntegrations},
+			ReplicationSchedule:     String("USING CRON 0 0 10-20 * TUE,THU UTC"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s SET OBJECT_TYPES = DATABASES, INTEGRATIONS ALLOWED_DATABASES = "db1", "db2" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS REPLICATION_SCHEDULE = 'USING CRON 0 0 10-20 * TUE,THU UTC'`, id.FullyQualifiedName())
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.ReplicationSchedule] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ReplicationGroupUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterReplicationGroupOptions.Unset", "ReplicationSchedule"))
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ReplicationGroupUnset{
+			ReplicationSchedule: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s UNSET REPLICATION_SCHEDULE`, id.FullyQualifiedName())
+	})
+
+	t.Run("add allowed databases", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &ReplicationGroupAdd{
+			AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1"), NewAccountObjectIdentifier("db2")},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s ADD "db1", "db2" TO ALLOWED_DATABASES`, id.FullyQualifiedName())
+	})
+
+	t.Run("add allowed accounts", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &ReplicationGroupAdd{
+			AllowedAccounts:    []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+			IgnoreEditionCheck: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s ADD "MY_ORG.MY_ACCOUNT" TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`, id.FullyQualifiedName())
+	})
+
+	t.Run("remove allowed shares", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Remove = &ReplicationGroupRemove{
+			AllowedShares: []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s REMOVE "share1" FROM ALLOWED_SHARES`, id.FullyQualifiedName())
+	})
+
+	t.Run("move databases", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Move = &ReplicationGroupMove{
+			Databases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+			To:        NewAccountObjectIdentifier("rg2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s MOVE DATABASES "db1" TO REPLICATION GROUP "rg2"`, id.FullyQualifiedName())
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s REFRESH`, id.FullyQualifiedName())
+	})
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Suspend = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s SUSPEND`, id.FullyQualifiedName())
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Resume = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP %s RESUME`, id.FullyQualifiedName())
+	})
+}
+
+func TestReplicationGroups_Drop(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid DropReplicationGroupOptions
+	defaultOpts := func() *DropReplicationGroupOptions {
+		return &DropReplicationGroupOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropReplicationGroupOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP REPLICATION GROUP %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP REPLICATION GROUP IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestReplicationGroups_Show(t *testing.T) {
+	// Minimal valid ShowReplicationGroupOptions
+	defaultOpts := func() *ShowReplicationGroupOptions {
+		return &ShowReplicationGroupOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowReplicationGroupOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.InAccount = Pointer(NewAccountIdentifierFromAccountLocator("abcd123"))
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS IN ACCOUNT "abcd123"`)
+	})
+}
+
+func TestReplicationGroups_ShowDatabases(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+	opts := &showReplicationGroupDatabasesOptions{
+		in: id,
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW DATABASES IN REPLICATION GROUP %s`, id.FullyQualifiedName())
+}
+
+func TestReplicationGroups_ShowShares(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+	opts := &showReplicationGroupSharesOptions{
+		in: id,
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW SHARES IN REPLICATION GROUP %s`, id.FullyQualifiedName())
+}
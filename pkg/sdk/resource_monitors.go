@@ -42,6 +42,7 @@ type ResourceMonitor struct {
 	SuspendImmediateAt *int
 	NotifyTriggers     []int
 	Level              ResourceMonitorLevel
+	Owner              string
 	Comment            string
 	NotifyUsers        []string
 }
@@ -121,6 +122,9 @@ func (row *resourceMonitorRow) convert() (*ResourceMonitor, error) {
 		return nil, err
 	}
 	resourceMonitor.NotifyTriggers = notifyTriggers
+	if row.Owner.Valid {
+		resourceMonitor.Owner = row.Owner.String
+	}
 	if row.Comment.Valid {
 		resourceMonitor.Comment = row.Comment.String
 	}
@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how the Client retries statements failing with transient errors.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of a statement; 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it is doubled after every attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// RetryableErrors are the error classes (e.g. ErrConcurrencyConflict) worth retrying.
+	RetryableErrors []error
+	// RetryNonIdempotent allows retrying statements that may have been applied before failing (e.g. a plain CREATE).
+	// By default, only statements that can be safely run twice are retried.
+	RetryNonIdempotent bool
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:     3,
+		InitialBackoff:  time.Second,
+		MaxBackoff:      30 * time.Second,
+		RetryableErrors: []error{ErrInternalError, ErrServiceUnavailable, ErrConcurrencyConflict},
+	}
+}
+
+func (p *RetryPolicy) shouldRetry(sql string, err error) bool {
+	if !p.RetryNonIdempotent && !isIdempotentStatement(sql) {
+		return false
+	}
+	for _, retryable := range p.RetryableErrors {
+		if errors.Is(err, retryable) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+var idempotentStatementPrefixes = []string{"SHOW ", "DESCRIBE ", "DESC ", "SELECT ", "USE ", "GRANT ", "REVOKE ", "CREATE OR REPLACE "}
+
+// isIdempotentStatement checks if running the statement twice has the same effect as running it once.
+// Statements not recognized as such (e.g. CREATE without IF NOT EXISTS, or renames) are considered unsafe to retry.
+func isIdempotentStatement(sql string) bool {
+	statement := strings.ToUpper(strings.Join(strings.Fields(sql), " "))
+	for _, prefix := range idempotentStatementPrefixes {
+		if strings.HasPrefix(statement, prefix) {
+			return true
+		}
+	}
+	switch {
+	case strings.HasPrefix(statement, "CREATE "):
+		return strings.Contains(statement, " IF NOT EXISTS ")
+	case strings.HasPrefix(statement, "DROP "):
+		return strings.Contains(statement, " IF EXISTS ")
+	case strings.HasPrefix(statement, "ALTER "):
+		return (strings.Contains(statement, " SET ") || strings.Contains(statement, " UNSET ")) && !strings.Contains(statement, " RENAME ")
+	}
+	return false
+}
+
+// retryPolicies keeps the policies set on clients by their connection, so that clients created with NewClientFromDB
+// (e.g. in the resources, which only receive the *sql.DB) follow the same policy.
+var retryPolicies = struct {
+	sync.RWMutex
+	m map[*sql.DB]*RetryPolicy
+}{m: make(map[*sql.DB]*RetryPolicy)}
+
+// SetRetryPolicy sets the retry policy of the client and of all clients created from its connection.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+	if c.db != nil {
+		retryPolicies.Lock()
+		defer retryPolicies.Unlock()
+		retryPolicies.m[c.db.DB] = policy
+	}
+}
+
+func retryPolicyFor(db *sql.DB) *RetryPolicy {
+	retryPolicies.RLock()
+	defer retryPolicies.RUnlock()
+	if policy, ok := retryPolicies.m[db]; ok {
+		return policy
+	}
+	return DefaultRetryPolicy()
+}
+
+// withRetries runs the statement until it succeeds, fails with an error that should not be retried
+// or the attempts of the retry policy are exhausted. Errors are returned decoded by decodeDriverError.
+func (c *Client) withRetries(ctx context.Context, sql string, run func() error) error {
+	policy := c.retryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+	for attempt := 1; ; attempt++ {
+		err := decodeDriverError(run())
+		if err == nil || attempt >= policy.MaxAttempts || !policy.shouldRetry(sql, err) {
+			return err
+		}
+		backoff := policy.backoff(attempt)
+		log.Printf("[DEBUG] attempt %d of %d failed with a transient error, retrying in %s: %v\n", attempt, policy.MaxAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// resetDestination clears the rows scanned into dest by a previous, failed attempt.
+func resetDestination(dest interface{}) {
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
+}
@@ -0,0 +1,263 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConnector is a driver returning the scripted errors, in order, for the consecutive statements.
+// Statements are successful once the script is exhausted; queries return rows with a single column
+// (named column, or name by default).
+type fakeConnector struct {
+	mu         sync.Mutex
+	errs       []error
+	column     string
+	names      []string
+	statements []string
+}
+
+func (c *fakeConnector) next(query string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statements = append(c.statements, query)
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := c.connector.next(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if err := c.connector.next(query); err != nil {
+		return nil, err
+	}
+	column := c.connector.column
+	if column == "" {
+		column = "name"
+	}
+	return &fakeRows{column: column, names: c.connector.names}, nil
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+type fakeRows struct {
+	column string
+	names  []string
+	i      int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{r.column}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.names) {
+		return io.EOF
+	}
+	dest[0] = r.names[r.i]
+	r.i++
+	return nil
+}
+
+func TestClient_retries(t *testing.T) {
+	ctx := context.Background()
+	internalError := &gosnowflake.SnowflakeError{Number: 603, SQLState: "XX000", Message: "SQL execution internal error"}
+	lockConflict := &gosnowflake.SnowflakeError{Number: 625, SQLState: "57014", Message: "Statement has locked table"}
+	notExists := &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "Database 'DB' does not exist or not authorized."}
+
+	newClient := func(t *testing.T, policy *RetryPolicy, connector *fakeConnector) *Client {
+		t.Helper()
+		db := sql.OpenDB(connector)
+		t.Cleanup(func() { _ = db.Close() })
+		client := NewClientFromDB(db)
+		if policy != nil {
+			client.SetRetryPolicy(policy)
+		}
+		return client
+	}
+	testPolicy := func() *RetryPolicy {
+		policy := DefaultRetryPolicy()
+		policy.InitialBackoff = time.Millisecond
+		policy.MaxBackoff = 2 * time.Millisecond
+		return policy
+	}
+
+	t.Run("exec: retries idempotent statement", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{lockConflict, internalError}}
+		client := newClient(t, testPolicy(), connector)
+
+		_, err := client.exec(ctx, `GRANT USAGE ON DATABASE "DB" TO ROLE "ROLE"`)
+
+		require.NoError(t, err)
+		assert.Len(t, connector.statements, 3)
+	})
+
+	t.Run("exec: does not retry non-idempotent statement", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{internalError}}
+		client := newClient(t, testPolicy(), connector)
+
+		_, err := client.exec(ctx, `CREATE DATABASE "DB"`)
+
+		require.ErrorIs(t, err, ErrInternalError)
+		assert.Len(t, connector.statements, 1)
+	})
+
+	t.Run("exec: retries non-idempotent statement when allowed", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{internalError}}
+		policy := testPolicy()
+		policy.RetryNonIdempotent = true
+		client := newClient(t, policy, connector)
+
+		_, err := client.exec(ctx, `CREATE DATABASE "DB"`)
+
+		require.NoError(t, err)
+		assert.Len(t, connector.statements, 2)
+	})
+
+	t.Run("exec: does not retry errors that are not retryable", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{notExists}}
+		client := newClient(t, testPolicy(), connector)
+
+		_, err := client.exec(ctx, `DROP DATABASE IF EXISTS "DB"`)
+
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		assert.Len(t, connector.statements, 1)
+	})
+
+	t.Run("exec: gives up after max attempts", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{lockConflict, lockConflict, lockConflict, lockConflict}}
+		client := newClient(t, testPolicy(), connector)
+
+		_, err := client.exec(ctx, `ALTER WAREHOUSE "WH" SET WAREHOUSE_SIZE = 'SMALL'`)
+
+		require.ErrorIs(t, err, ErrConcurrencyConflict)
+		var snowflakeErr *gosnowflake.SnowflakeError
+		require.ErrorAs(t, err, &snowflakeErr)
+		assert.Len(t, connector.statements, 3)
+	})
+
+	t.Run("exec: stops retrying when context is done", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{lockConflict, lockConflict}}
+		policy := testPolicy()
+		policy.InitialBackoff = time.Hour
+		policy.MaxBackoff = time.Hour
+		client := newClient(t, policy, connector)
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err := client.exec(ctx, `REVOKE USAGE ON DATABASE "DB" FROM ROLE "ROLE"`)
+
+		require.ErrorIs(t, err, ErrConcurrencyConflict)
+		assert.Len(t, connector.statements, 1)
+	})
+
+	t.Run("query: retries and returns rows of the successful attempt", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{internalError}, names: []string{"A", "B"}}
+		client := newClient(t, testPolicy(), connector)
+		var dest []struct {
+			Name string `db:"name"`
+		}
+
+		err := client.query(ctx, &dest, "SHOW DATABASES")
+
+		require.NoError(t, err)
+		require.Len(t, dest, 2)
+		assert.Equal(t, "A", dest[0].Name)
+		assert.Len(t, connector.statements, 2)
+	})
+
+	t.Run("queryOne: retries", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{internalError}, names: []string{"A"}}
+		client := newClient(t, testPolicy(), connector)
+		var dest struct {
+			Name string `db:"name"`
+		}
+
+		err := client.queryOne(ctx, &dest, "SELECT CURRENT_ROLE() AS name")
+
+		require.NoError(t, err)
+		assert.Equal(t, "A", dest.Name)
+		assert.Len(t, connector.statements, 2)
+	})
+
+	t.Run("clients created from the connection inherit the policy", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{lockConflict, lockConflict}}
+		policy := testPolicy()
+		policy.MaxAttempts = 1
+		client := newClient(t, policy, connector)
+
+		_, err := NewClientFromDB(client.GetConn().DB).exec(ctx, `GRANT USAGE ON DATABASE "DB" TO ROLE "ROLE"`)
+
+		require.ErrorIs(t, err, ErrConcurrencyConflict)
+		assert.Len(t, connector.statements, 1)
+	})
+}
+
+func TestIsIdempotentStatement(t *testing.T) {
+	testCases := map[string]bool{
+		"SHOW WAREHOUSES":                                    true,
+		"describe table \"DB\".\"SCHEMA\".\"T\"":             true,
+		`GRANT USAGE ON DATABASE "DB" TO ROLE "R"`:           true,
+		`CREATE OR REPLACE TABLE "T" ("C" NUMBER)`:           true,
+		`CREATE DATABASE IF NOT EXISTS "DB"`:                 true,
+		`DROP ROLE IF EXISTS "R"`:                            true,
+		`ALTER WAREHOUSE "WH" SET WAREHOUSE_SIZE = 'SMALL'`:  true,
+		`ALTER USER "U" UNSET COMMENT`:                       true,
+		`CREATE DATABASE "DB"`:                               false,
+		`DROP ROLE "R"`:                                      false,
+		`ALTER WAREHOUSE "WH" RENAME TO "WH2"`:               false,
+		`ALTER TABLE "T" ADD COLUMN "C" NUMBER`:              false,
+		`ALTER TABLE "T" RENAME COLUMN "A" TO "B" SET X = 1`: false,
+		`INSERT INTO "T" VALUES (1)`:                         false,
+		`CALL "PROC"()`:                                      false,
+	}
+	for statement, expected := range testCases {
+		t.Run(statement, func(t *testing.T) {
+			assert.Equal(t, expected, isIdempotentStatement(statement))
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type SecretType string
+
+var (
+	SecretTypePassword      SecretType = "PASSWORD"
+	SecretTypeOAuth2        SecretType = "OAUTH2"
+	SecretTypeGenericString SecretType = "GENERIC_STRING"
+)
+
+var secretOAuthScope = g.NewQueryStruct("SecretOAuthScope").
+	Text("Scope", g.KeywordOptions().SingleQuotes().Required())
+
+var secretSet = g.NewQueryStruct("SecretSet").
+	ListQueryStructField("OAuthScopes", secretOAuthScope, g.ParameterOptions().Parentheses().SQL("OAUTH_SCOPES")).
+	OptionalTextAssignment("OAUTH_REFRESH_TOKEN", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_REFRESH_TOKEN_EXPIRY_TIME", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("USERNAME", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("PASSWORD", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SECRET_STRING", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "OAuthScopes", "OauthRefreshToken", "OauthRefreshTokenExpiryTime", "Username", "Password", "SecretString", "Comment")
+
+var secretUnset = g.NewQueryStruct("SecretUnset").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Comment")
+
+var SecretsDef = g.NewInterface(
+	"Secrets",
+	"Secret",
+	g.KindOfT[SchemaObjectIdentifier](),
+).CustomOperation(
+	"CreateWithOAuthClientCredentialsFlow",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+	g.NewQueryStruct("CreateWithOAuthClientCredentialsFlowSecret").
+		Create().
+		OrReplace().
+		SQL("SECRET").
+		IfNotExists().
+		Name().
+		SQLWithCustomFieldName("secretType", "TYPE = OAUTH2").
+		Identifier("ApiAuthentication", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("API_AUTHENTICATION").Equals().Required()).
+		ListQueryStructField("OAuthScopes", secretOAuthScope, g.ParameterOptions().Parentheses().SQL("OAUTH_SCOPES")).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ValidIdentifier, "ApiAuthentication").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).CustomOperation(
+	"CreateWithOAuthAuthorizationCodeFlow",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+	g.NewQueryStruct("CreateWithOAuthAuthorizationCodeFlowSecret").
+		Create().
+		OrReplace().
+		SQL("SECRET").
+		IfNotExists().
+		Name().
+		SQLWithCustomFieldName("secretType", "TYPE = OAUTH2").
+		TextAssignment("OAUTH_REFRESH_TOKEN", g.ParameterOptions().SingleQuotes().Required()).
+		TextAssignment("OAUTH_REFRESH_TOKEN_EXPIRY_TIME", g.ParameterOptions().SingleQuotes().Required()).
+		Identifier("ApiAuthentication", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("API_AUTHENTICATION").Equals().Required()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ValidIdentifier, "ApiAuthentication").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).CustomOperation(
+	"CreateWithBasicAuthentication",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+	g.NewQueryStruct("CreateWithBasicAuthenticationSecret").
+		Create().
+		OrReplace().
+		SQL("SECRET").
+		IfNotExists().
+		Name().
+		SQLWithCustomFieldName("secretType", "TYPE = PASSWORD").
+		TextAssignment("USERNAME", g.ParameterOptions().SingleQuotes().Required()).
+		TextAssignment("PASSWORD", g.ParameterOptions().SingleQuotes().Required()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).CustomOperation(
+	"CreateWithGenericString",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+	g.NewQueryStruct("CreateWithGenericStringSecret").
+		Create().
+		OrReplace().
+		SQL("SECRET").
+		IfNotExists().
+		Name().
+		SQLWithCustomFieldName("secretType", "TYPE = GENERIC_STRING").
+		TextAssignment("SECRET_STRING", g.ParameterOptions().SingleQuotes().Required()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).AlterOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-secret",
+	g.NewQueryStruct("AlterSecret").
+		Alter().
+		SQL("SECRET").
+		IfExists().
+		Name().
+		OptionalQueryStructField(
+			"Set",
+			secretSet,
+			g.KeywordOptions().SQL("SET"),
+		).
+		OptionalQueryStructField(
+			"Unset",
+			secretUnset,
+			g.KeywordOptions().SQL("UNSET"),
+		).
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/drop-secret",
+	g.NewQueryStruct("DropSecret").
+		Drop().
+		SQL("SECRET").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-secrets",
+	g.DbStruct("secretDBRow").
+		Field("created_on", "time.Time").
+		Field("name", "string").
+		Field("schema_name", "string").
+		Field("database_name", "string").
+		Field("owner", "string").
+		Field("comment", "sql.NullString").
+		Field("secret_type", "string").
+		Field("oauth_scopes", "sql.NullString").
+		Field("owner_role_type", "string"),
+	g.PlainStruct("Secret").
+		Field("CreatedOn", "time.Time").
+		Field("Name", "string").
+		Field("SchemaName", "string").
+		Field("DatabaseName", "string").
+		Field("Owner", "string").
+		Field("Comment", "string").
+		Field("SecretType", "string").
+		Field("OAuthScopes", "[]string").
+		Field("OwnerRoleType", "string"),
+	g.NewQueryStruct("ShowSecrets").
+		Show().
+		SQL("SECRETS").
+		OptionalLike().
+		OptionalIn(),
+).ShowByIdOperation().DescribeOperation(
+	g.DescriptionMappingKindSingleValue,
+	"https://docs.snowflake.com/en/sql-reference/sql/desc-secret",
+	g.DbStruct("secretDetailsDBRow").
+		Field("created_on", "time.Time").
+		Field("name", "string").
+		Field("schema_name", "string").
+		Field("database_name", "string").
+		Field("owner", "string").
+		Field("comment", "sql.NullString").
+		Field("secret_type", "string").
+		Field("username", "sql.NullString").
+		Field("oauth_access_token_expiry_time", "sql.NullString").
+		Field("oauth_refresh_token_expiry_time", "sql.NullString").
+		Field("oauth_scopes", "sql.NullString").
+		Field("integration_name", "sql.NullString"),
+	g.PlainStruct("SecretDetails").
+		Field("CreatedOn", "time.Time").
+		Field("Name", "string").
+		Field("SchemaName", "string").
+		Field("DatabaseName", "string").
+		Field("Owner", "string").
+		Field("Comment", "string").
+		Field("SecretType", "string").
+		Field("Username", "string").
+		Field("OAuthAccessTokenExpiryTime", "string").
+		Field("OAuthRefreshTokenExpiryTime", "string").
+		Field("OAuthScopes", "[]string").
+		Field("IntegrationName", "string"),
+	g.NewQueryStruct("DescribeSecret").
+		Describe().
+		SQL("SECRET").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+)
@@ -0,0 +1,334 @@
+package sdk
+
+import "testing"
+
+func TestSecrets_CreateWithOAuthClientCredentialsFlow(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+	integrationId := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *CreateWithOAuthClientCredentialsFlowSecretOptions {
+		return &CreateWithOAuthClientCredentialsFlowSecretOptions{
+			name:              id,
+			ApiAuthentication: integrationId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithOAuthClientCredentialsFlowSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ApiAuthentication]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ApiAuthentication = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithOAuthClientCredentialsFlowSecretOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s`, id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.OAuthScopes = []SecretOAuthScope{{Scope: "photo"}, {Scope: "offline_access"}}
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_SCOPES = ('photo', 'offline_access') COMMENT = 'comment'`, id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+	})
+}
+
+func TestSecrets_CreateWithOAuthAuthorizationCodeFlow(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+	integrationId := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *CreateWithOAuthAuthorizationCodeFlowSecretOptions {
+		return &CreateWithOAuthAuthorizationCodeFlowSecretOptions{
+			name:                        id,
+			OauthRefreshToken:           "token",
+			OauthRefreshTokenExpiryTime: "2024-01-01 12:00:00",
+			ApiAuthentication:           integrationId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithOAuthAuthorizationCodeFlowSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ApiAuthentication]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ApiAuthentication = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithOAuthAuthorizationCodeFlowSecretOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE SECRET IF NOT EXISTS %s TYPE = OAUTH2 OAUTH_REFRESH_TOKEN = 'token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2024-01-01 12:00:00' API_AUTHENTICATION = %s COMMENT = 'comment'`, id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+	})
+}
+
+func TestSecrets_CreateWithBasicAuthentication(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateWithBasicAuthenticationSecretOptions {
+		return &CreateWithBasicAuthenticationSecretOptions{
+			name:     id,
+			Username: "user",
+			Password: "password",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithBasicAuthenticationSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithBasicAuthenticationSecretOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SECRET %s TYPE = PASSWORD USERNAME = 'user' PASSWORD = 'password' COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestSecrets_CreateWithGenericString(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateWithGenericStringSecretOptions {
+		return &CreateWithGenericStringSecretOptions{
+			name:         id,
+			SecretString: "secret",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithGenericStringSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithGenericStringSecretOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE SECRET IF NOT EXISTS %s TYPE = GENERIC_STRING SECRET_STRING = 'secret' COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestSecrets_Alter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *AlterSecretOptions {
+		return &AlterSecretOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &SecretUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSecretOptions", "Set", "Unset"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.OAuthScopes opts.Set.OauthRefreshToken opts.Set.OauthRefreshTokenExpiryTime opts.Set.Username opts.Set.Password opts.Set.SecretString opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSecretOptions.Set", "OAuthScopes", "OauthRefreshToken", "OauthRefreshTokenExpiryTime", "Username", "Password", "SecretString", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &SecretUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSecretOptions.Unset", "Comment"))
+	})
+
+	t.Run("set oauth scopes", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &SecretSet{
+			OAuthScopes: []SecretOAuthScope{{Scope: "photo"}},
+			Comment:     String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECRET IF EXISTS %s SET OAUTH_SCOPES = ('photo') COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("set oauth refresh token", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{
+			OauthRefreshToken:           String("token"),
+			OauthRefreshTokenExpiryTime: String("2024-01-01 12:00:00"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECRET %s SET OAUTH_REFRESH_TOKEN = 'token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2024-01-01 12:00:00'`, id.FullyQualifiedName())
+	})
+
+	t.Run("set username and password", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{
+			Username: String("user"),
+			Password: String("password"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECRET %s SET USERNAME = 'user' PASSWORD = 'password'`, id.FullyQualifiedName())
+	})
+
+	t.Run("set secret string", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{
+			SecretString: String("secret"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECRET %s SET SECRET_STRING = 'secret'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &SecretUnset{
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECRET %s UNSET COMMENT`, id.FullyQualifiedName())
+	})
+}
+
+func TestSecrets_Drop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *DropSecretOptions {
+		return &DropSecretOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP SECRET %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP SECRET IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestSecrets_Show(t *testing.T) {
+	defaultOpts := func() *ShowSecretOptions {
+		return &ShowSecretOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SECRETS`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Schema: NewDatabaseObjectIdentifier("db", "schema")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SECRETS LIKE 'pattern' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestSecrets_Describe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	defaultOpts := func() *DescribeSecretOptions {
+		return &DescribeSecretOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE SECRET %s`, id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,234 @@
+package sdk
+
+import "context"
+
+var _ Secrets = (*secrets)(nil)
+
+type secrets struct {
+	client *Client
+}
+
+func (v *secrets) CreateWithOAuthClientCredentialsFlow(ctx context.Context, request *CreateWithOAuthClientCredentialsFlowSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithOAuthAuthorizationCodeFlow(ctx context.Context, request *CreateWithOAuthAuthorizationCodeFlowSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithBasicAuthentication(ctx context.Context, request *CreateWithBasicAuthenticationSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithGenericString(ctx context.Context, request *CreateWithGenericStringSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Alter(ctx context.Context, request *AlterSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Drop(ctx context.Context, request *DropSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Show(ctx context.Context, request *ShowSecretRequest) ([]Secret, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[secretDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[secretDBRow, Secret](dbRows)
+	return resultList, nil
+}
+
+func (v *secrets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Secret, error) {
+	request := NewShowSecretRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	secrets, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if secret.Name == id.Name() {
+			return &secret, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *secrets) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error) {
+	opts := &DescribeSecretOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[secretDetailsDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateWithOAuthClientCredentialsFlowSecretRequest) toOpts() *CreateWithOAuthClientCredentialsFlowSecretOptions {
+	opts := &CreateWithOAuthClientCredentialsFlowSecretOptions{
+		OrReplace:         r.OrReplace,
+		IfNotExists:       r.IfNotExists,
+		name:              r.name,
+		ApiAuthentication: r.ApiAuthentication,
+		Comment:           r.Comment,
+	}
+	if r.OAuthScopes != nil {
+		s := make([]SecretOAuthScope, len(r.OAuthScopes))
+		for i, v := range r.OAuthScopes {
+			s[i] = SecretOAuthScope{
+				Scope: v.Scope,
+			}
+		}
+		opts.OAuthScopes = s
+	}
+	return opts
+}
+
+func (r *CreateWithOAuthAuthorizationCodeFlowSecretRequest) toOpts() *CreateWithOAuthAuthorizationCodeFlowSecretOptions {
+	opts := &CreateWithOAuthAuthorizationCodeFlowSecretOptions{
+		OrReplace:                   r.OrReplace,
+		IfNotExists:                 r.IfNotExists,
+		name:                        r.name,
+		OauthRefreshToken:           r.OauthRefreshToken,
+		OauthRefreshTokenExpiryTime: r.OauthRefreshTokenExpiryTime,
+		ApiAuthentication:           r.ApiAuthentication,
+		Comment:                     r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithBasicAuthenticationSecretRequest) toOpts() *CreateWithBasicAuthenticationSecretOptions {
+	opts := &CreateWithBasicAuthenticationSecretOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Username:    r.Username,
+		Password:    r.Password,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithGenericStringSecretRequest) toOpts() *CreateWithGenericStringSecretOptions {
+	opts := &CreateWithGenericStringSecretOptions{
+		OrReplace:    r.OrReplace,
+		IfNotExists:  r.IfNotExists,
+		name:         r.name,
+		SecretString: r.SecretString,
+		Comment:      r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterSecretRequest) toOpts() *AlterSecretOptions {
+	opts := &AlterSecretOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &SecretSet{
+			OauthRefreshToken:           r.Set.OauthRefreshToken,
+			OauthRefreshTokenExpiryTime: r.Set.OauthRefreshTokenExpiryTime,
+			Username:                    r.Set.Username,
+			Password:                    r.Set.Password,
+			SecretString:                r.Set.SecretString,
+			Comment:                     r.Set.Comment,
+		}
+		if r.Set.OAuthScopes != nil {
+			s := make([]SecretOAuthScope, len(r.Set.OAuthScopes))
+			for i, v := range r.Set.OAuthScopes {
+				s[i] = SecretOAuthScope{
+					Scope: v.Scope,
+				}
+			}
+			opts.Set.OAuthScopes = s
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &SecretUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropSecretRequest) toOpts() *DropSecretOptions {
+	opts := &DropSecretOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowSecretRequest) toOpts() *ShowSecretOptions {
+	opts := &ShowSecretOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r secretDBRow) convert() *Secret {
+	secret := &Secret{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		SchemaName:    r.SchemaName,
+		DatabaseName:  r.DatabaseName,
+		Owner:         r.Owner,
+		SecretType:    r.SecretType,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Comment.Valid {
+		secret.Comment = r.Comment.String
+	}
+	if r.OauthScopes.Valid {
+		secret.OAuthScopes = ParseCommaSeparatedStringArray(r.OauthScopes.String, true)
+	}
+	return secret
+}
+
+func (r *DescribeSecretRequest) toOpts() *DescribeSecretOptions {
+	opts := &DescribeSecretOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r secretDetailsDBRow) convert() *SecretDetails {
+	details := &SecretDetails{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		SchemaName:   r.SchemaName,
+		DatabaseName: r.DatabaseName,
+		Owner:        r.Owner,
+		SecretType:   r.SecretType,
+	}
+	if r.Comment.Valid {
+		details.Comment = r.Comment.String
+	}
+	if r.Username.Valid {
+		details.Username = r.Username.String
+	}
+	if r.OauthAccessTokenExpiryTime.Valid {
+		details.OAuthAccessTokenExpiryTime = r.OauthAccessTokenExpiryTime.String
+	}
+	if r.OauthRefreshTokenExpiryTime.Valid {
+		details.OAuthRefreshTokenExpiryTime = r.OauthRefreshTokenExpiryTime.String
+	}
+	if r.OauthScopes.Valid {
+		details.OAuthScopes = ParseCommaSeparatedStringArray(r.OauthScopes.String, true)
+	}
+	if r.IntegrationName.Valid {
+		details.IntegrationName = r.IntegrationName.String
+	}
+	return details
+}
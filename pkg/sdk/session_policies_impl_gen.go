@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ SessionPolicies = (*sessionPolicies)(nil)
 
@@ -43,7 +39,12 @@ func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifie
 		return nil, err
 	}
 
-	return collections.FindOne(sessionPolicies, func(r SessionPolicy) bool { return r.Name == id.Name() })
+	for _, sessionPolicy := range sessionPolicies {
+		if sessionPolicy.ID().FullyQualifiedName() == id.FullyQualifiedName() {
+			return &sessionPolicy, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
 }
 
 func (v *sessionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDescription, error) {
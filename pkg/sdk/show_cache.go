@@ -0,0 +1,277 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// showRowsLimit is the maximum number of rows returned by SHOW statements without pagination.
+const showRowsLimit = 10000
+
+// showCache keeps the results of SHOW statements, so that resources listing the same objects (e.g. the future grants
+// in a schema, or the tables in it) during a refresh query Snowflake only once. Any write invalidates the whole cache.
+type showCache struct {
+	mu         sync.Mutex
+	entries    map[showCacheKey]*showCacheEntry
+	generation int
+	suspended  int
+}
+
+type showCacheKey struct {
+	rowsType reflect.Type
+	sql      string
+}
+
+type showCacheEntry struct {
+	ready  chan struct{}
+	result reflect.Value
+	err    error
+}
+
+func newShowCache() *showCache {
+	return &showCache{entries: make(map[showCacheKey]*showCacheEntry)}
+}
+
+func isShowStatement(sql string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "SHOW ")
+}
+
+// query serves the rows of the statement from the cache, or loads them into dest with load. Concurrent callers
+// of the same statement wait for the first one instead of running it again. Errors are not cached.
+//
+// Statements filtering objects by name (e.g. SHOW TABLES LIKE 'T' IN SCHEMA "DB"."S", as run by ShowByID) are answered
+// from the cached rows of the whole container (SHOW TABLES IN SCHEMA "DB"."S"), so that the objects of a container are
+// listed once per refresh, however many of them are looked up.
+func (c *showCache) query(ctx context.Context, sql string, dest interface{}, load func(dest interface{}, sql string) error) error {
+	destination := reflect.ValueOf(dest)
+	if destination.Kind() != reflect.Ptr || destination.Elem().Kind() != reflect.Slice {
+		return load(dest, sql)
+	}
+	rowType := destination.Elem().Type().Elem()
+
+	containerSQL, like, ok := containerShowStatement(sql)
+	if !ok || nameFieldIndex(rowType) == nil {
+		return c.cached(ctx, sql, destination, load)
+	}
+	all := reflect.New(destination.Elem().Type())
+	if err := c.cached(ctx, containerSQL, all, load); err != nil {
+		return err
+	}
+	filtered := filterByName(all.Elem(), like)
+	if filtered.Len() == 0 && all.Elem().Len() >= showRowsLimit {
+		// the object may be missing from the container rows because they were truncated
+		return load(dest, sql)
+	}
+	destination.Elem().Set(filtered)
+	return nil
+}
+
+func (c *showCache) cached(ctx context.Context, sql string, destination reflect.Value, load func(dest interface{}, sql string) error) error {
+	// rows of the same statement may be scanned into different structs
+	key := showCacheKey{rowsType: destination.Elem().Type(), sql: sql}
+	c.mu.Lock()
+	if c.suspended > 0 {
+		c.mu.Unlock()
+		return load(destination.Interface(), sql)
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &showCacheEntry{ready: make(chan struct{})}
+		c.entries[key] = entry
+		generation := c.generation
+		c.mu.Unlock()
+
+		entry.err = load(destination.Interface(), sql)
+		if entry.err == nil {
+			entry.result = cloneSlice(destination.Elem())
+		}
+		c.mu.Lock()
+		// results loaded while something was written may be already stale
+		if (entry.err != nil || c.generation != generation) && c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+		close(entry.ready)
+		return entry.err
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if entry.err != nil {
+		return load(destination.Interface(), sql)
+	}
+	destination.Elem().Set(cloneSlice(entry.result))
+	return nil
+}
+
+func (c *showCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.entries = make(map[showCacheKey]*showCacheEntry)
+}
+
+func (c *showCache) suspend() func() {
+	c.mu.Lock()
+	c.suspended++
+	c.mu.Unlock()
+	c.invalidate()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			c.suspended--
+			c.mu.Unlock()
+			c.invalidate()
+		})
+	}
+}
+
+var showLikeStatement = regexp.MustCompile(`^(?i)(SHOW [A-Z ]+?) LIKE '([^']*)'( IN .*)?$`)
+
+// containerShowStatement returns the statement listing all the objects of the container of a SHOW ... LIKE statement,
+// together with the LIKE pattern as a regular expression. Statements with other filters (e.g. STARTS WITH or LIMIT),
+// or whose LIKE does not filter by name (i.e. SHOW PARAMETERS), are not supported.
+func containerShowStatement(sql string) (string, *regexp.Regexp, bool) {
+	matches := showLikeStatement.FindStringSubmatch(strings.TrimSpace(sql))
+	if matches == nil {
+		return "", nil, false
+	}
+	command, pattern, in := matches[1], matches[2], matches[3]
+	upperIn := strings.ToUpper(in)
+	if strings.Contains(strings.ToUpper(command), "PARAMETERS") || strings.Contains(upperIn, " STARTS WITH ") || strings.Contains(upperIn, " LIMIT ") {
+		return "", nil, false
+	}
+	like, err := likePatternToRegexp(pattern)
+	if err != nil {
+		return "", nil, false
+	}
+	return command + in, like, true
+}
+
+// likePatternToRegexp translates a LIKE pattern, which is case-insensitive in SHOW statements, into a regular expression.
+func likePatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("(?is)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// nameFieldIndex returns the index of the field of the row struct mapped to the name column.
+func nameFieldIndex(rowType reflect.Type) []int {
+	if rowType.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		if field.Tag.Get("db") != "name" {
+			continue
+		}
+		switch field.Type {
+		case reflect.TypeOf(""), reflect.TypeOf(sql.NullString{}):
+			return field.Index
+		}
+	}
+	return nil
+}
+
+func filterByName(rows reflect.Value, like *regexp.Regexp) reflect.Value {
+	index := nameFieldIndex(rows.Type().Elem())
+	filtered := reflect.MakeSlice(rows.Type(), 0, 0)
+	for i := 0; i < rows.Len(); i++ {
+		var name string
+		switch v := rows.Index(i).FieldByIndex(index).Interface().(type) {
+		case string:
+			name = v
+		case sql.NullString:
+			name = v.String
+		}
+		if like.MatchString(name) {
+			filtered = reflect.Append(filtered, rows.Index(i))
+		}
+	}
+	return filtered
+}
+
+func cloneSlice(v reflect.Value) reflect.Value {
+	clone := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(clone, v)
+	return clone
+}
+
+// showCaches keeps the caches enabled on clients by their connection, so that clients created with NewClientFromDB
+// (e.g. in the resources, which only receive the *sql.DB) share the same cache.
+var showCaches = struct {
+	sync.RWMutex
+	m map[*sql.DB]*showCache
+}{m: make(map[*sql.DB]*showCache)}
+
+// EnableShowCache makes the client and all clients created from its connection serve the results of SHOW statements
+// from a cache. The cache lives as long as the connection (i.e. one Terraform command), so writes not made through
+// the client have to be announced with SuspendShowCache or InvalidateShowCache.
+func (c *Client) EnableShowCache() {
+	cache := newShowCache()
+	c.showCache = cache
+	if c.db != nil {
+		showCaches.Lock()
+		defer showCaches.Unlock()
+		showCaches.m[c.db.DB] = cache
+	}
+}
+
+func showCacheFor(db *sql.DB) *showCache {
+	showCaches.RLock()
+	defer showCaches.RUnlock()
+	return showCaches.m[db]
+}
+
+func (c *Client) ShowCacheEnabled() bool {
+	return c.showCache != nil
+}
+
+// InvalidateShowCache drops all the cached results.
+func (c *Client) InvalidateShowCache() {
+	if c.showCache != nil {
+		c.showCache.invalidate()
+	}
+}
+
+// SuspendShowCache stops serving results from the cache until the returned function is called, which also invalidates it.
+// It is meant to surround operations writing to Snowflake, so that they read their own writes.
+func (c *Client) SuspendShowCache() func() {
+	if c.showCache == nil {
+		return func() {}
+	}
+	return c.showCache.suspend()
+}
+
+// QueryShow runs a SHOW statement built outside of the SDK (e.g. by the pkg/snowflake builders) and scans its rows into dest,
+// which is expected to be a pointer to a slice of structs. The rows are served from the cache if it is enabled.
+func (c *Client) QueryShow(ctx context.Context, dest interface{}, sql string) error {
+	return c.query(ctx, dest, sql)
+}
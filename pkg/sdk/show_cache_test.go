@@ -0,0 +1,184 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_showCache(t *testing.T) {
+	ctx := context.Background()
+	type nameRow struct {
+		Name string `db:"name"`
+	}
+
+	newClient := func(t *testing.T, connector *fakeConnector) *Client {
+		t.Helper()
+		db := sql.OpenDB(connector)
+		t.Cleanup(func() { _ = db.Close() })
+		client := NewClientFromDB(db)
+		client.SetRetryPolicy(&RetryPolicy{MaxAttempts: 1})
+		client.EnableShowCache()
+		return client
+	}
+
+	t.Run("serves repeated statements from the cache", func(t *testing.T) {
+		connector := &fakeConnector{names: []string{"A", "B"}}
+		client := newClient(t, connector)
+
+		for i := 0; i < 2; i++ {
+			var rows []nameRow
+			require.NoError(t, client.query(ctx, &rows, `SHOW FUTURE GRANTS IN SCHEMA "DB"."S"`))
+			assert.Equal(t, []nameRow{{Name: "A"}, {Name: "B"}}, rows)
+		}
+		assert.Equal(t, []string{`SHOW FUTURE GRANTS IN SCHEMA "DB"."S"`}, connector.statements)
+	})
+
+	t.Run("answers lookups by name from the container", func(t *testing.T) {
+		connector := &fakeConnector{names: []string{"TABLE_1", "TABLE_2", "TABLEX1"}}
+		client := newClient(t, connector)
+
+		table, err := client.Tables.ShowByID(ctx, NewSchemaObjectIdentifier("DB", "S", "TABLE_1"))
+		require.NoError(t, err)
+		assert.Equal(t, "TABLE_1", table.Name)
+
+		table, err = client.Tables.ShowByID(ctx, NewSchemaObjectIdentifier("DB", "S", "TABLE_2"))
+		require.NoError(t, err)
+		assert.Equal(t, "TABLE_2", table.Name)
+
+		_, err = client.Tables.ShowByID(ctx, NewSchemaObjectIdentifier("DB", "S", "MISSING"))
+		require.Error(t, err)
+
+		// rows scanned into another struct are cached separately
+		for i := 0; i < 2; i++ {
+			var rows []nameRow
+			require.NoError(t, client.query(ctx, &rows, `SHOW TABLES LIKE 'table_1' IN SCHEMA "DB"."S"`))
+			assert.Equal(t, []nameRow{{Name: "TABLE_1"}, {Name: "TABLEX1"}}, rows)
+		}
+
+		assert.Equal(t, []string{`SHOW TABLES IN SCHEMA "DB"."S"`, `SHOW TABLES IN SCHEMA "DB"."S"`}, connector.statements)
+	})
+
+	t.Run("looks up by name directly when the container rows may be truncated", func(t *testing.T) {
+		names := make([]string, showRowsLimit)
+		for i := range names {
+			names[i] = fmt.Sprintf("T%d", i)
+		}
+		connector := &fakeConnector{names: names}
+		client := newClient(t, connector)
+
+		var rows []nameRow
+		require.NoError(t, client.query(ctx, &rows, `SHOW TABLES LIKE 'OTHER' IN SCHEMA "DB"."S"`))
+
+		assert.Equal(t, []string{`SHOW TABLES IN SCHEMA "DB"."S"`, `SHOW TABLES LIKE 'OTHER' IN SCHEMA "DB"."S"`}, connector.statements)
+	})
+
+	t.Run("writes invalidate the cache", func(t *testing.T) {
+		connector := &fakeConnector{names: []string{"A"}}
+		client := newClient(t, connector)
+		var rows []nameRow
+
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+		_, err := client.exec(ctx, `CREATE ROLE "B"`)
+		require.NoError(t, err)
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+
+		assert.Equal(t, []string{"SHOW ROLES", `CREATE ROLE "B"`, "SHOW ROLES"}, connector.statements)
+	})
+
+	t.Run("suspended cache is bypassed and invalidated when resumed", func(t *testing.T) {
+		connector := &fakeConnector{names: []string{"A"}}
+		client := newClient(t, connector)
+		var rows []nameRow
+
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+		resume := NewClientFromDB(client.GetConn().DB).SuspendShowCache()
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+		resume()
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+
+		assert.Len(t, connector.statements, 3)
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{&gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", Message: "Insufficient privileges"}}, names: []string{"A"}}
+		client := newClient(t, connector)
+		var rows []nameRow
+
+		require.ErrorIs(t, client.query(ctx, &rows, "SHOW ROLES"), ErrInsufficientPrivileges)
+		require.NoError(t, client.query(ctx, &rows, "SHOW ROLES"))
+
+		assert.Len(t, connector.statements, 2)
+	})
+
+	t.Run("does not cache other statements", func(t *testing.T) {
+		connector := &fakeConnector{names: []string{"A"}}
+		client := newClient(t, connector)
+		var rows []nameRow
+
+		require.NoError(t, client.query(ctx, &rows, "SELECT CURRENT_ROLE() AS name"))
+		require.NoError(t, client.query(ctx, &rows, "SELECT CURRENT_ROLE() AS name"))
+
+		assert.Len(t, connector.statements, 2)
+	})
+}
+
+func TestContainerShowStatement(t *testing.T) {
+	testCases := []struct {
+		statement string
+		container string
+		matching  []string
+		other     []string
+	}{
+		{
+			statement: `SHOW TABLES LIKE 'T_1' IN SCHEMA "DB"."S"`,
+			container: `SHOW TABLES IN SCHEMA "DB"."S"`,
+			matching:  []string{"T_1", "t_1", "TX1"},
+			other:     []string{"T_12", "T1"},
+		},
+		{
+			statement: `SHOW WAREHOUSES LIKE 'WH\_%'`,
+			container: `SHOW WAREHOUSES`,
+			matching:  []string{"WH_", "WH_1"},
+			other:     []string{"WHX1"},
+		},
+		{
+			statement: `SHOW USER FUNCTIONS LIKE 'F' IN ACCOUNT`,
+			container: `SHOW USER FUNCTIONS IN ACCOUNT`,
+			matching:  []string{"F"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.statement, func(t *testing.T) {
+			container, like, ok := containerShowStatement(tc.statement)
+
+			require.True(t, ok)
+			assert.Equal(t, tc.container, container)
+			for _, name := range tc.matching {
+				assert.True(t, like.MatchString(name), name)
+			}
+			for _, name := range tc.other {
+				assert.False(t, like.MatchString(name), name)
+			}
+		})
+	}
+
+	for _, statement := range []string{
+		`SHOW PARAMETERS LIKE 'TIMEZONE' IN ACCOUNT`,
+		`SHOW TABLES LIKE 'T' IN SCHEMA "DB"."S" STARTS WITH 'T'`,
+		`SHOW TABLES LIKE 'T' IN SCHEMA "DB"."S" LIMIT 1`,
+		`SHOW TABLES IN SCHEMA "DB"."S"`,
+		`SHOW GRANTS ON TABLE "DB"."S"."T"`,
+	} {
+		t.Run(statement, func(t *testing.T) {
+			_, _, ok := containerShowStatement(statement)
+			assert.False(t, ok)
+		})
+	}
+}
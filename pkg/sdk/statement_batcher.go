@@ -0,0 +1,314 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+type StatementBatchMode string
+
+const (
+	// StatementBatchModeMultiStatement sends the batch as one multi-statement request (MULTI_STATEMENT_COUNT).
+	// Snowflake stops at the first failing statement, so a failed batch is re-run statement by statement
+	// to attribute the errors; batched statements are expected to be idempotent (e.g. GRANT and REVOKE).
+	StatementBatchModeMultiStatement StatementBatchMode = "MULTI_STATEMENT"
+	// StatementBatchModeExecuteImmediate sends the batch as an anonymous EXECUTE IMMEDIATE block running every
+	// statement in its own exception handler, so a failing statement does not affect the others.
+	StatementBatchModeExecuteImmediate StatementBatchMode = "EXECUTE_IMMEDIATE"
+)
+
+var AllStatementBatchModes = []StatementBatchMode{
+	StatementBatchModeMultiStatement,
+	StatementBatchModeExecuteImmediate,
+}
+
+// StatementBatchOptions configures how statements submitted concurrently (e.g. by many grant resources) are grouped.
+type StatementBatchOptions struct {
+	Mode StatementBatchMode
+	// MaxStatements is the size after which a batch is sent right away.
+	MaxStatements int
+	// Window is how long the first statement of a batch waits for other statements to join it.
+	Window time.Duration
+}
+
+func DefaultStatementBatchOptions() *StatementBatchOptions {
+	return &StatementBatchOptions{
+		Mode:          StatementBatchModeExecuteImmediate,
+		MaxStatements: 100,
+		Window:        200 * time.Millisecond,
+	}
+}
+
+// BatchStatementError attributes an error of a batch to the statement that caused it.
+type BatchStatementError struct {
+	Statement string
+	Err       error
+}
+
+func (e *BatchStatementError) Error() string {
+	return fmt.Sprintf("statement %q failed: %v", e.Statement, e.Err)
+}
+
+func (e *BatchStatementError) Unwrap() error {
+	return e.Err
+}
+
+type batchedStatement struct {
+	sql  string
+	done chan error
+}
+
+// statementBatcher groups the statements submitted within a window into batches executed by the client it was created for.
+type statementBatcher struct {
+	client  *Client
+	options StatementBatchOptions
+
+	mu      sync.Mutex
+	pending []*batchedStatement
+	timer   *time.Timer
+}
+
+func newStatementBatcher(client *Client, options *StatementBatchOptions) *statementBatcher {
+	defaults := DefaultStatementBatchOptions()
+	b := &statementBatcher{client: client, options: *options}
+	if b.options.Mode == "" {
+		b.options.Mode = defaults.Mode
+	}
+	if b.options.MaxStatements <= 0 {
+		b.options.MaxStatements = defaults.MaxStatements
+	}
+	return b
+}
+
+// statementBatchers keeps the batchers set on clients by their connection, so that clients created with NewClientFromDB
+// (e.g. in the resources, which only receive the *sql.DB) share the same batches.
+var statementBatchers = struct {
+	sync.RWMutex
+	m map[*sql.DB]*statementBatcher
+}{m: make(map[*sql.DB]*statementBatcher)}
+
+// SetStatementBatching enables batching of the grant statements run by the client and by all clients created from
+// its connection. Passing nil disables it.
+func (c *Client) SetStatementBatching(options *StatementBatchOptions) {
+	var batcher *statementBatcher
+	if options != nil {
+		batcher = newStatementBatcher(c, options)
+	}
+	c.batcher = batcher
+	if c.db != nil {
+		statementBatchers.Lock()
+		defer statementBatchers.Unlock()
+		if batcher == nil {
+			delete(statementBatchers.m, c.db.DB)
+		} else {
+			statementBatchers.m[c.db.DB] = batcher
+		}
+	}
+}
+
+func statementBatcherFor(db *sql.DB) *statementBatcher {
+	statementBatchers.RLock()
+	defer statementBatchers.RUnlock()
+	return statementBatchers.m[db]
+}
+
+// ExecBatch runs the statements and returns the errors of the failed ones joined. When batching is enabled
+// with SetStatementBatching, the statements are sent together with the ones submitted concurrently by other callers.
+func (c *Client) ExecBatch(ctx context.Context, statements ...string) error {
+	if c.batcher == nil || c.dryRun {
+		for _, statement := range statements {
+			if _, err := c.exec(ctx, statement); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return c.batcher.submit(ctx, statements...)
+}
+
+func (b *statementBatcher) submit(ctx context.Context, statements ...string) error {
+	submitted := make([]*batchedStatement, len(statements))
+	for i, statement := range statements {
+		submitted[i] = &batchedStatement{sql: statement, done: make(chan error, 1)}
+	}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, submitted...)
+	var ready []*batchedStatement
+	switch {
+	case len(b.pending) >= b.options.MaxStatements:
+		ready = b.takePendingLocked()
+	case b.timer == nil:
+		b.timer = time.AfterFunc(b.options.Window, b.flush)
+	}
+	b.mu.Unlock()
+	if ready != nil {
+		go b.execute(ready)
+	}
+
+	var errs []error
+	for _, statement := range submitted {
+		select {
+		case err := <-statement.done:
+			if err != nil {
+				errs = append(errs, err)
+			}
+		case <-ctx.Done():
+			// the statement may still be executed with its batch
+			return ctx.Err()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (b *statementBatcher) takePendingLocked() []*batchedStatement {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	pending := b.pending
+	b.pending = nil
+	return pending
+}
+
+func (b *statementBatcher) flush() {
+	b.mu.Lock()
+	ready := b.takePendingLocked()
+	b.mu.Unlock()
+	if len(ready) > 0 {
+		b.execute(ready)
+	}
+}
+
+func (b *statementBatcher) execute(statements []*batchedStatement) {
+	for len(statements) > 0 {
+		size := min(len(statements), b.options.MaxStatements)
+		batch := statements[:size]
+		statements = statements[size:]
+
+		sqls := make([]string, len(batch))
+		for i, statement := range batch {
+			sqls[i] = strings.TrimSuffix(strings.TrimSpace(statement.sql), ";")
+		}
+		log.Printf("[DEBUG] executing batch of %d statement(s) in %s mode\n", len(batch), b.options.Mode)
+
+		var errs []error
+		if b.options.Mode == StatementBatchModeMultiStatement {
+			errs = b.executeMultiStatement(sqls)
+		} else {
+			errs = b.executeImmediate(sqls)
+		}
+		for i, statement := range batch {
+			if errs[i] != nil {
+				statement.done <- &BatchStatementError{Statement: statement.sql, Err: errs[i]}
+			} else {
+				statement.done <- nil
+			}
+		}
+	}
+}
+
+// executeOneByOne runs the statements separately, returning the error of each of them.
+func (b *statementBatcher) executeOneByOne(sqls []string) []error {
+	ctx := context.Background()
+	errs := make([]error, len(sqls))
+	for i, sql := range sqls {
+		_, errs[i] = b.client.exec(ctx, sql)
+	}
+	return errs
+}
+
+func (b *statementBatcher) executeMultiStatement(sqls []string) []error {
+	if len(sqls) == 1 {
+		return b.executeOneByOne(sqls)
+	}
+	ctx, err := gosnowflake.WithMultiStatement(context.Background(), len(sqls))
+	if err != nil {
+		return b.executeOneByOne(sqls)
+	}
+	if _, err := b.client.exec(ctx, strings.Join(sqls, ";\n")); err != nil {
+		log.Printf("[DEBUG] batch failed, running its statements one by one to attribute errors: %v\n", err)
+		return b.executeOneByOne(sqls)
+	}
+	return make([]error, len(sqls))
+}
+
+// batchStatementFailure is an entry of the array returned by the block built with executeImmediateBlock.
+type batchStatementFailure struct {
+	Index   int    `json:"index"`
+	Code    int    `json:"code"`
+	State   string `json:"state"`
+	Message string `json:"message"`
+}
+
+// executeImmediateBlock builds an anonymous block running every statement in its own exception handler
+// and returning the failures as an array.
+func executeImmediateBlock(sqls []string) string {
+	var sb strings.Builder
+	sb.WriteString("EXECUTE IMMEDIATE $$\nDECLARE\n  failures ARRAY DEFAULT ARRAY_CONSTRUCT();\nBEGIN\n")
+	for i, sql := range sqls {
+		sb.WriteString("  BEGIN\n    ")
+		sb.WriteString(sql)
+		sb.WriteString(";\n  EXCEPTION\n    WHEN OTHER THEN\n")
+		sb.WriteString(fmt.Sprintf("      failures := ARRAY_APPEND(failures, OBJECT_CONSTRUCT('index', %d, 'code', SQLCODE, 'state', SQLSTATE, 'message', SQLERRM));\n", i))
+		sb.WriteString("  END;\n")
+	}
+	sb.WriteString("  RETURN failures;\nEND;\n$$")
+	return sb.String()
+}
+
+func (b *statementBatcher) executeImmediate(sqls []string) []error {
+	for _, sql := range sqls {
+		// statements using dollar-quoted strings cannot be embedded in the block
+		if strings.Contains(sql, "$$") {
+			return b.executeOneByOne(sqls)
+		}
+	}
+
+	block := executeImmediateBlock(sqls)
+	var result []struct {
+		Failures sql.NullString `db:"anonymous block"`
+	}
+	if err := b.client.query(context.Background(), &result, block); err != nil {
+		log.Printf("[DEBUG] batch failed, running its statements one by one to attribute errors: %v\n", err)
+		return b.executeOneByOne(sqls)
+	}
+	errs := make([]error, len(sqls))
+	if len(result) == 0 || !result[0].Failures.Valid {
+		return errs
+	}
+	failures, err := parseBatchStatementFailures(result[0].Failures.String)
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	for _, failure := range failures {
+		if failure.Index >= 0 && failure.Index < len(errs) {
+			errs[failure.Index] = decodeDriverError(&gosnowflake.SnowflakeError{
+				Number:   failure.Code,
+				SQLState: failure.State,
+				Message:  failure.Message,
+			})
+		}
+	}
+	return errs
+}
+
+func parseBatchStatementFailures(s string) ([]batchStatementFailure, error) {
+	var failures []batchStatementFailure
+	if err := json.Unmarshal([]byte(s), &failures); err != nil {
+		return nil, fmt.Errorf("could not parse the failures of the batch: %w", err)
+	}
+	return failures, nil
+}
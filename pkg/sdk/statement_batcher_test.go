@@ -0,0 +1,160 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ExecBatch(t *testing.T) {
+	ctx := context.Background()
+	grants := []string{
+		`GRANT USAGE ON DATABASE "DB" TO ROLE "A"`,
+		`GRANT USAGE ON DATABASE "MISSING" TO ROLE "B"`,
+		`GRANT USAGE ON DATABASE "DB" TO ROLE "C"`,
+	}
+	notExists := &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "Database 'MISSING' does not exist or not authorized."}
+
+	newClient := func(t *testing.T, options *StatementBatchOptions, connector *fakeConnector) *Client {
+		t.Helper()
+		db := sql.OpenDB(connector)
+		t.Cleanup(func() { _ = db.Close() })
+		client := NewClientFromDB(db)
+		client.SetRetryPolicy(&RetryPolicy{MaxAttempts: 1})
+		if options != nil {
+			client.SetStatementBatching(options)
+			t.Cleanup(func() { client.SetStatementBatching(nil) })
+		}
+		return client
+	}
+	requireStatementError := func(t *testing.T, err error, statement string, expected error) {
+		t.Helper()
+		var batchErr *BatchStatementError
+		require.ErrorAs(t, err, &batchErr)
+		assert.Equal(t, statement, batchErr.Statement)
+		require.ErrorIs(t, err, expected)
+	}
+
+	t.Run("without batching statements are run one by one", func(t *testing.T) {
+		connector := &fakeConnector{}
+		client := newClient(t, nil, connector)
+
+		err := client.ExecBatch(ctx, grants...)
+
+		require.NoError(t, err)
+		assert.Equal(t, grants, connector.statements)
+	})
+
+	t.Run("execute immediate: attributes failures to statements", func(t *testing.T) {
+		connector := &fakeConnector{
+			column: "anonymous block",
+			names:  []string{`[{"index": 1, "code": 2003, "state": "02000", "message": "Database 'MISSING' does not exist or not authorized."}]`},
+		}
+		client := newClient(t, &StatementBatchOptions{Mode: StatementBatchModeExecuteImmediate, Window: time.Millisecond}, connector)
+
+		err := client.ExecBatch(ctx, grants...)
+
+		requireStatementError(t, err, grants[1], ErrObjectNotExistOrAuthorized)
+		assert.NotContains(t, err.Error(), `ROLE "A"`)
+		require.Len(t, connector.statements, 1)
+		assert.Equal(t, executeImmediateBlock(grants), connector.statements[0])
+	})
+
+	t.Run("execute immediate: statements with dollar-quoted strings are run one by one", func(t *testing.T) {
+		connector := &fakeConnector{}
+		client := newClient(t, &StatementBatchOptions{Mode: StatementBatchModeExecuteImmediate, Window: time.Millisecond}, connector)
+		statements := []string{grants[0], `CREATE FUNCTION "F"() RETURNS INT AS $$ 1 $$`}
+
+		err := client.ExecBatch(ctx, statements...)
+
+		require.NoError(t, err)
+		assert.Equal(t, statements, connector.statements)
+	})
+
+	t.Run("multi statement: sends the batch at once", func(t *testing.T) {
+		connector := &fakeConnector{}
+		client := newClient(t, &StatementBatchOptions{Mode: StatementBatchModeMultiStatement, Window: time.Millisecond}, connector)
+
+		err := client.ExecBatch(ctx, grants...)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{strings.Join(grants, ";\n")}, connector.statements)
+	})
+
+	t.Run("multi statement: reruns a failed batch to attribute failures", func(t *testing.T) {
+		connector := &fakeConnector{errs: []error{notExists, nil, notExists, nil}}
+		client := newClient(t, &StatementBatchOptions{Mode: StatementBatchModeMultiStatement, Window: time.Millisecond}, connector)
+
+		err := client.ExecBatch(ctx, grants...)
+
+		requireStatementError(t, err, grants[1], ErrObjectNotExistOrAuthorized)
+		assert.Equal(t, append([]string{strings.Join(grants, ";\n")}, grants...), connector.statements)
+	})
+
+	t.Run("groups statements submitted concurrently", func(t *testing.T) {
+		connector := &fakeConnector{}
+		client := newClient(t, &StatementBatchOptions{Mode: StatementBatchModeMultiStatement, Window: 100 * time.Millisecond}, connector)
+
+		var wg sync.WaitGroup
+		errs := make([]error, len(grants))
+		for i, grant := range grants {
+			wg.Add(1)
+			go func(i int, grant string) {
+				defer wg.Done()
+				// every resource uses its own client created from the connection
+				errs[i] = NewClientFromDB(client.GetConn().DB).ExecBatch(ctx, grant)
+			}(i, grant)
+		}
+		wg.Wait()
+
+		require.NoError(t, errors.Join(errs...))
+		require.Len(t, connector.statements, 1)
+		for _, grant := range grants {
+			assert.Contains(t, connector.statements[0], grant)
+		}
+	})
+
+	t.Run("sends a full batch without waiting for the window", func(t *testing.T) {
+		connector := &fakeConnector{}
+		client := newClient(t, &StatementBatchOptions{Mode: StatementBatchModeMultiStatement, MaxStatements: 2, Window: time.Hour}, connector)
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		err := client.ExecBatch(ctx, grants[:2]...)
+
+		require.NoError(t, err)
+		assert.Len(t, connector.statements, 1)
+	})
+}
+
+func TestExecuteImmediateBlock(t *testing.T) {
+	block := executeImmediateBlock([]string{`GRANT USAGE ON DATABASE "DB" TO ROLE "A"`, `REVOKE USAGE ON DATABASE "DB" FROM ROLE "B"`})
+
+	assert.Equal(t, `EXECUTE IMMEDIATE $$
+DECLARE
+  failures ARRAY DEFAULT ARRAY_CONSTRUCT();
+BEGIN
+  BEGIN
+    GRANT USAGE ON DATABASE "DB" TO ROLE "A";
+  EXCEPTION
+    WHEN OTHER THEN
+      failures := ARRAY_APPEND(failures, OBJECT_CONSTRUCT('index', 0, 'code', SQLCODE, 'state', SQLSTATE, 'message', SQLERRM));
+  END;
+  BEGIN
+    REVOKE USAGE ON DATABASE "DB" FROM ROLE "B";
+  EXCEPTION
+    WHEN OTHER THEN
+      failures := ARRAY_APPEND(failures, OBJECT_CONSTRUCT('index', 1, 'code', SQLCODE, 'state', SQLSTATE, 'message', SQLERRM));
+  END;
+  RETURN failures;
+END;
+$$`, block)
+}
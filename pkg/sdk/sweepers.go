@@ -2,229 +2,599 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 
 	"golang.org/x/exp/slices"
 )
 
+// defaultProtectedNames are never swept, regardless of the object type.
+var defaultProtectedNames = []string{
+	// system roles
+	"ACCOUNTADMIN", "SECURITYADMIN", "SYSADMIN", "ORGADMIN", "USERADMIN", "PUBLIC",
+	// system database, warehouse and user
+	"SNOWFLAKE",
+	// shared objects used by the acceptance tests
+	"terraform_test_database", "terraform_test_warehouse",
+}
+
+// defaultProtectedOwners are the roles whose objects are not swept when SweepOptions.ProtectedOwners is nil.
+// ACCOUNTADMIN is not among them, because the tests create their objects as ACCOUNTADMIN.
+var defaultProtectedOwners = []string{"SYSADMIN"}
+
+// SweepOptions configure SweepWithOptions.
+type SweepOptions struct {
+	// Prefix limits the sweep to objects with names starting with it. All objects are swept when empty.
+	Prefix string
+	// DryRun only lists the objects that would be dropped; nothing is dropped nor detached.
+	DryRun bool
+	// ProtectedNames are never swept, in addition to the system objects, the current user and the current role.
+	ProtectedNames []string
+	// ProtectedOwners are the roles whose objects are never swept. Defaults to SYSADMIN when nil; an empty, non-nil
+	// slice protects no owner. The objects owned by ACCOUNTADMIN are protected only when it is listed here.
+	ProtectedOwners []string
+}
+
+// SweptObject is an object dropped by a sweeper, or one that would be dropped in dry run mode.
+type SweptObject struct {
+	ObjectType ObjectType
+	Name       string
+}
+
+type sweeper struct {
+	client          *Client
+	ctx             context.Context
+	prefix          string
+	dryRun          bool
+	protectedNames  []string
+	protectedOwners []string
+	currentAccount  string
+	swept           []SweptObject
+}
+
 func Sweep(client *Client, prefix string) error {
+	_, err := SweepWithOptions(client, &SweepOptions{Prefix: prefix})
+	return err
+}
+
+func SweepAll(client *Client) error {
+	return Sweep(client, "")
+}
+
+// SweepWithOptions drops the account-level objects left behind by tests and returns the swept objects.
+// The sweepers run in dependency order: policies are detached from the account and the users before the policies
+// themselves are dropped, and compute pools, databases and warehouses are dropped before the roles owning them.
+func SweepWithOptions(client *Client, opts *SweepOptions) ([]SweptObject, error) {
+	opts = createIfNil(opts)
+	s, err := newSweeper(client, opts)
+	if err != nil {
+		return nil, err
+	}
 	sweepers := []func() error{
-		getAccountPolicyAttachementsSweeper(client),
-		getResourceMonitorSweeper(client, prefix),
-		getFailoverGroupSweeper(client, prefix),
-		getShareSweeper(client, prefix),
-		getDatabaseSweeper(client, prefix),
-		getWarehouseSweeper(client, prefix),
-		getRoleSweeper(client, prefix),
-		// todo: users, integrations, replication groups, network policies
-		// getUserSweeper(client, prefix),
+		getAccountPolicyAttachementsSweeper(s),
+		getUserSweeper(s),
+		getIntegrationSweeper(s),
+		getNetworkPolicySweeper(s),
+		getSessionPolicySweeper(s),
+		getPasswordPolicySweeper(s),
+		getComputePoolSweeper(s),
+		getResourceMonitorSweeper(s),
+		getFailoverGroupSweeper(s),
+		getReplicationGroupSweeper(s),
+		getShareSweeper(s),
+		getDatabaseSweeper(s),
+		getWarehouseSweeper(s),
+		getRoleSweeper(s),
 	}
 	for _, sweeper := range sweepers {
 		if err := sweeper(); err != nil {
+			return s.swept, err
+		}
+	}
+	return s.swept, nil
+}
+
+func newSweeper(client *Client, opts *SweepOptions) (*sweeper, error) {
+	ctx := context.Background()
+	currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, err := client.ContextFunctions.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentRole, err := client.ContextFunctions.CurrentRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protectedNames := append(slices.Clone(defaultProtectedNames), currentUser, currentRole)
+	protectedOwners := opts.ProtectedOwners
+	if protectedOwners == nil {
+		protectedOwners = defaultProtectedOwners
+	}
+	return &sweeper{
+		client:          client,
+		ctx:             ctx,
+		prefix:          opts.Prefix,
+		dryRun:          opts.DryRun,
+		protectedNames:  append(protectedNames, opts.ProtectedNames...),
+		protectedOwners: protectedOwners,
+		currentAccount:  currentAccount,
+	}, nil
+}
+
+func (s *sweeper) logStart(objectType PluralObjectType) {
+	if s.prefix == "" {
+		log.Printf("[DEBUG] Sweeping all %s", strings.ToLower(objectType.String()))
+	} else {
+		log.Printf("[DEBUG] Sweeping all %s with prefix %s", strings.ToLower(objectType.String()), s.prefix)
+	}
+}
+
+// shouldSweep checks the name against the prefix and the protected names, and the owner against the protected owners.
+func (s *sweeper) shouldSweep(name string, owner string) bool {
+	return (s.prefix == "" || strings.HasPrefix(name, s.prefix)) &&
+		!slices.Contains(s.protectedNames, name) &&
+		!slices.ContainsFunc(s.protectedOwners, func(protectedOwner string) bool { return strings.EqualFold(protectedOwner, owner) })
+}
+
+// ownerOf returns the role with the OWNERSHIP privilege on the object, for the objects without the owner column
+// in the output of their SHOW command, like integrations and network policies.
+func (s *sweeper) ownerOf(objectType ObjectType, id ObjectIdentifier) (string, error) {
+	grants, err := s.client.Grants.Show(s.ctx, &ShowGrantOptions{
+		On: &ShowGrantsOn{
+			Object: &Object{
+				ObjectType: objectType,
+				Name:       id,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, grant := range grants {
+		if grant.Privilege == "OWNERSHIP" {
+			return grant.GranteeName.Name(), nil
+		}
+	}
+	return "", nil
+}
+
+func (s *sweeper) drop(objectType ObjectType, name string, drop func() error) error {
+	s.swept = append(s.swept, SweptObject{ObjectType: objectType, Name: name})
+	if s.dryRun {
+		log.Printf("[DEBUG] Would drop %s %s", strings.ToLower(objectType.String()), name)
+		return nil
+	}
+	log.Printf("[DEBUG] Dropping %s %s", strings.ToLower(objectType.String()), name)
+	return drop()
+}
+
+func (s *sweeper) skip(objectType ObjectType, name string) {
+	log.Printf("[DEBUG] Skipping %s %s", strings.ToLower(objectType.String()), name)
+}
+
+// getAccountPolicyAttachementsSweeper detaches the policies from the account and the session policies about to be swept
+// from the users, as a policy cannot be dropped while it is still attached.
+func getAccountPolicyAttachementsSweeper(s *sweeper) func() error {
+	return func() error {
+		if s.dryRun {
+			log.Printf("[DEBUG] Would unset password and session policies set on the account level")
+			log.Printf("[DEBUG] Would unset session policies set on the user level")
+			return nil
+		}
+		log.Printf("[DEBUG] Unsetting password and session policies set on the account level")
+		opts := &AlterAccountOptions{
+			Unset: &AccountUnset{
+				PasswordPolicy: Bool(true),
+			},
+		}
+		_ = s.client.Accounts.Alter(s.ctx, opts)
+		opts = &AlterAccountOptions{
+			Unset: &AccountUnset{
+				SessionPolicy: Bool(true),
+			},
+		}
+		_ = s.client.Accounts.Alter(s.ctx, opts)
+		return s.unsetUserSessionPolicies()
+	}
+}
+
+// policyReferenceSweepDBRow is based on https://docs.snowflake.com/en/sql-reference/functions/policy_references.
+type policyReferenceSweepDBRow struct {
+	RefEntityName   string `db:"REF_ENTITY_NAME"`
+	RefEntityDomain string `db:"REF_ENTITY_DOMAIN"`
+}
+
+// unsetUserSessionPolicies detaches the session policies matching the sweep from all the users they are set on.
+func (s *sweeper) unsetUserSessionPolicies() error {
+	log.Printf("[DEBUG] Unsetting session policies set on the user level")
+	sessionPolicies, err := s.client.SessionPolicies.Show(s.ctx, NewShowSessionPolicyRequest())
+	if err != nil {
+		return err
+	}
+	for _, sessionPolicy := range sessionPolicies {
+		if !s.shouldSweep(sessionPolicy.Name, sessionPolicy.Owner) {
+			continue
+		}
+		policyReferencesId := NewSchemaObjectIdentifier(sessionPolicy.DatabaseName, "INFORMATION_SCHEMA", "POLICY_REFERENCES")
+		var references []policyReferenceSweepDBRow
+		sql := fmt.Sprintf(`SELECT REF_ENTITY_NAME, REF_ENTITY_DOMAIN FROM TABLE(%s(POLICY_NAME => '%s'))`, policyReferencesId.FullyQualifiedName(), sessionPolicy.ID().FullyQualifiedName())
+		if err := s.client.query(s.ctx, &references, sql); err != nil {
 			return err
 		}
+		for _, reference := range references {
+			if reference.RefEntityDomain != string(ObjectTypeUser) {
+				continue
+			}
+			log.Printf("[DEBUG] Unsetting session policy %s from user %s", sessionPolicy.ID().FullyQualifiedName(), reference.RefEntityName)
+			_ = s.client.Users.Alter(s.ctx, NewAccountObjectIdentifier(reference.RefEntityName), &AlterUserOptions{
+				Unset: &UserUnset{
+					SessionPolicy: Bool(true),
+				},
+			})
+		}
 	}
 	return nil
 }
 
-func SweepAll(client *Client) error {
-	return Sweep(client, "")
+// getUserSweeper runs before the policy sweepers, as dropping a user detaches its password and session policies.
+func getUserSweeper(s *sweeper) func() error {
+	return func() error {
+		s.logStart(PluralObjectTypeUsers)
+		users, err := s.client.Users.Show(s.ctx, nil)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			user := user
+			if s.shouldSweep(user.Name, user.Owner) {
+				if err := s.drop(ObjectTypeUser, user.Name, func() error {
+					return s.client.Users.Drop(s.ctx, user.ID())
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypeUser, user.Name)
+			}
+		}
+		return nil
+	}
+}
+
+// showIntegrationsSweepOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-integrations.
+// Integrations are not covered by the SDK yet, so only the statements needed by the sweeper are defined here.
+type showIntegrationsSweepOptions struct {
+	show         bool `ddl:"static" sql:"SHOW"`
+	integrations bool `ddl:"static" sql:"INTEGRATIONS"`
 }
 
-func getResourceMonitorSweeper(client *Client, prefix string) func() error {
+func (opts *showIntegrationsSweepOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	return nil
+}
+
+type integrationSweepDBRow struct {
+	Name     string `db:"name"`
+	Category string `db:"category"`
+}
+
+// dropIntegrationSweepOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-integration.
+type dropIntegrationSweepOptions struct {
+	drop        bool                    `ddl:"static" sql:"DROP"`
+	integration bool                    `ddl:"static" sql:"INTEGRATION"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *dropIntegrationSweepOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+// getIntegrationSweeper sweeps integrations of all categories (security, storage, API and notification).
+func getIntegrationSweeper(s *sweeper) func() error {
 	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all resource monitors")
-		} else {
-			log.Printf("[DEBUG] Sweeping all resource monitors with prefix %s", prefix)
+		s.logStart(PluralObjectTypeIntegrations)
+		integrations, err := validateAndQuery[integrationSweepDBRow](s.client, s.ctx, &showIntegrationsSweepOptions{})
+		if err != nil {
+			return err
+		}
+		for _, integration := range integrations {
+			integration := integration
+			owner, err := s.ownerOf(ObjectTypeIntegration, NewAccountObjectIdentifier(integration.Name))
+			if err != nil {
+				return err
+			}
+			if s.shouldSweep(integration.Name, owner) {
+				if err := s.drop(ObjectTypeIntegration, integration.Name, func() error {
+					return validateAndExec(s.client, s.ctx, &dropIntegrationSweepOptions{name: NewAccountObjectIdentifier(integration.Name)})
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypeIntegration, integration.Name)
+			}
 		}
-		ctx := context.Background()
-		rms, err := client.ResourceMonitors.Show(ctx, nil)
+		return nil
+	}
+}
+
+// getNetworkPolicySweeper skips the network policy set on the account level, so that the sweep never unlocks the account.
+func getNetworkPolicySweeper(s *sweeper) func() error {
+	return func() error {
+		s.logStart(PluralObjectTypeNetworkPolicies)
+		accountNetworkPolicy, err := s.client.Parameters.ShowAccountParameter(s.ctx, AccountParameterNetworkPolicy)
 		if err != nil {
 			return err
 		}
-		for _, rm := range rms {
-			if prefix == "" || strings.HasPrefix(rm.Name, prefix) {
-				log.Printf("[DEBUG] Dropping resource monitor %s", rm.Name)
-				if err := client.ResourceMonitors.Drop(ctx, rm.ID()); err != nil {
+		networkPolicies, err := s.client.NetworkPolicies.Show(s.ctx, NewShowNetworkPolicyRequest())
+		if err != nil {
+			return err
+		}
+		for _, networkPolicy := range networkPolicies {
+			networkPolicy := networkPolicy
+			owner, err := s.ownerOf(ObjectTypeNetworkPolicy, NewAccountObjectIdentifier(networkPolicy.Name))
+			if err != nil {
+				return err
+			}
+			if s.shouldSweep(networkPolicy.Name, owner) && networkPolicy.Name != accountNetworkPolicy.Value {
+				if err := s.drop(ObjectTypeNetworkPolicy, networkPolicy.Name, func() error {
+					return s.client.NetworkPolicies.Drop(s.ctx, NewDropNetworkPolicyRequest(NewAccountObjectIdentifier(networkPolicy.Name)))
+				}); err != nil {
 					return err
 				}
 			} else {
-				log.Printf("[DEBUG] Skipping resource monitor %s", rm.Name)
+				s.skip(ObjectTypeNetworkPolicy, networkPolicy.Name)
 			}
 		}
 		return nil
 	}
 }
 
-func getFailoverGroupSweeper(client *Client, prefix string) func() error {
+func getSessionPolicySweeper(s *sweeper) func() error {
 	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all failover groups")
-		} else {
-			log.Printf("[DEBUG] Sweeping all failover groups with prefix %s", prefix)
+		s.logStart(PluralObjectTypeSessionPolicies)
+		sessionPolicies, err := s.client.SessionPolicies.Show(s.ctx, NewShowSessionPolicyRequest())
+		if err != nil {
+			return err
+		}
+		for _, sessionPolicy := range sessionPolicies {
+			sessionPolicy := sessionPolicy
+			if s.shouldSweep(sessionPolicy.Name, sessionPolicy.Owner) {
+				if err := s.drop(ObjectTypeSessionPolicy, sessionPolicy.ID().FullyQualifiedName(), func() error {
+					return s.client.SessionPolicies.Drop(s.ctx, NewDropSessionPolicyRequest(sessionPolicy.ID()))
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypeSessionPolicy, sessionPolicy.ID().FullyQualifiedName())
+			}
 		}
-		ctx := context.Background()
-		currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+		return nil
+	}
+}
+
+func getPasswordPolicySweeper(s *sweeper) func() error {
+	return func() error {
+		s.logStart(PluralObjectTypePasswordPolicies)
+		passwordPolicies, err := s.client.PasswordPolicies.Show(s.ctx, nil)
 		if err != nil {
 			return err
 		}
-		opts := &ShowFailoverGroupOptions{
-			InAccount: NewAccountIdentifierFromAccountLocator(currentAccount),
+		for _, passwordPolicy := range passwordPolicies {
+			passwordPolicy := passwordPolicy
+			if s.shouldSweep(passwordPolicy.Name, passwordPolicy.Owner) {
+				if err := s.drop(ObjectTypePasswordPolicy, passwordPolicy.ID().FullyQualifiedName(), func() error {
+					return s.client.PasswordPolicies.Drop(s.ctx, passwordPolicy.ID(), nil)
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypePasswordPolicy, passwordPolicy.ID().FullyQualifiedName())
+			}
 		}
-		fgs, err := client.FailoverGroups.Show(ctx, opts)
+		return nil
+	}
+}
+
+// getComputePoolSweeper stops all the services running in a compute pool before dropping it.
+func getComputePoolSweeper(s *sweeper) func() error {
+	return func() error {
+		s.logStart(PluralObjectTypeComputePools)
+		computePools, err := s.client.ComputePools.Show(s.ctx, NewShowComputePoolRequest())
 		if err != nil {
 			return err
 		}
-		for _, fg := range fgs {
-			if (prefix == "" || strings.HasPrefix(fg.Name, prefix)) && fg.AccountLocator == currentAccount {
-				log.Printf("[DEBUG] Dropping failover group %s", fg.Name)
-				if err := client.FailoverGroups.Drop(ctx, fg.ID(), nil); err != nil {
+		for _, computePool := range computePools {
+			id := NewAccountObjectIdentifier(computePool.Name)
+			if s.shouldSweep(computePool.Name, computePool.Owner) {
+				if err := s.drop(ObjectTypeComputePool, computePool.Name, func() error {
+					if err := s.client.ComputePools.Alter(s.ctx, NewAlterComputePoolRequest(id).WithStopAll(Bool(true))); err != nil {
+						return err
+					}
+					return s.client.ComputePools.Drop(s.ctx, NewDropComputePoolRequest(id))
+				}); err != nil {
 					return err
 				}
 			} else {
-				log.Printf("[DEBUG] Skipping failover group %s", fg.Name)
+				s.skip(ObjectTypeComputePool, computePool.Name)
 			}
 		}
 		return nil
 	}
 }
 
-func getUserSweeper(client *Client, prefix string) func() error {
+func getResourceMonitorSweeper(s *sweeper) func() error {
 	return func() error {
-		/*ctx := context.Background()
-		users, client.Users.Show(ctx,nil) // LIKE '%TEST_%'
-		for _, user := range users {
-			err := client.Users.Drop(ctx, user.ID(), nil)
-			if err != nil {
-				return err
+		s.logStart(PluralObjectTypeResourceMonitors)
+		rms, err := s.client.ResourceMonitors.Show(s.ctx, nil)
+		if err != nil {
+			return err
+		}
+		for _, rm := range rms {
+			rm := rm
+			if s.shouldSweep(rm.Name, rm.Owner) {
+				if err := s.drop(ObjectTypeResourceMonitor, rm.Name, func() error {
+					return s.client.ResourceMonitors.Drop(s.ctx, rm.ID())
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypeResourceMonitor, rm.Name)
 			}
-		}*/
+		}
 		return nil
 	}
 }
 
-func getRoleSweeper(client *Client, prefix string) func() error {
+func getFailoverGroupSweeper(s *sweeper) func() error {
 	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all roles")
-		} else {
-			log.Printf("[DEBUG] Sweeping all roles with prefix %s", prefix)
+		s.logStart(PluralObjectTypeFailoverGroups)
+		opts := &ShowFailoverGroupOptions{
+			InAccount: NewAccountIdentifierFromAccountLocator(s.currentAccount),
 		}
-		ctx := context.Background()
-		roles, err := client.Roles.Show(ctx, NewShowRoleRequest())
+		fgs, err := s.client.FailoverGroups.Show(s.ctx, opts)
 		if err != nil {
 			return err
 		}
-		for _, role := range roles {
-			if (prefix == "" || strings.HasPrefix(role.Name, prefix)) && !slices.Contains([]string{"ACCOUNTADMIN", "SECURITYADMIN", "SYSADMIN", "ORGADMIN", "USERADMIN", "PUBLIC"}, role.Name) {
-				log.Printf("[DEBUG] Dropping role %s", role.Name)
-				if err := client.Roles.Drop(ctx, NewDropRoleRequest(role.ID())); err != nil {
+		for _, fg := range fgs {
+			fg := fg
+			if s.shouldSweep(fg.Name, fg.Owner) && fg.AccountLocator == s.currentAccount {
+				if err := s.drop(ObjectTypeFailoverGroup, fg.Name, func() error {
+					return s.client.FailoverGroups.Drop(s.ctx, fg.ID(), nil)
+				}); err != nil {
 					return err
 				}
 			} else {
-				log.Printf("[DEBUG] Skipping role %s", role.Name)
+				s.skip(ObjectTypeFailoverGroup, fg.Name)
 			}
 		}
 		return nil
 	}
 }
 
-func getShareSweeper(client *Client, prefix string) func() error {
+func getReplicationGroupSweeper(s *sweeper) func() error {
 	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all shares")
-		} else {
-			log.Printf("[DEBUG] Sweeping all shares with prefix %s", prefix)
+		s.logStart(PluralObjectTypeReplicationGroups)
+		inAccount := NewAccountIdentifierFromAccountLocator(s.currentAccount)
+		rgs, err := s.client.ReplicationGroups.Show(s.ctx, NewShowReplicationGroupRequest().WithInAccount(&inAccount))
+		if err != nil {
+			return err
 		}
-		ctx := context.Background()
-		shares, err := client.Shares.Show(ctx, nil)
+		for _, rg := range rgs {
+			rg := rg
+			if s.shouldSweep(rg.Name, rg.Owner) && rg.AccountLocator == s.currentAccount {
+				if err := s.drop(ObjectTypeReplicationGroup, rg.Name, func() error {
+					return s.client.ReplicationGroups.Drop(s.ctx, NewDropReplicationGroupRequest(rg.ID()))
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypeReplicationGroup, rg.Name)
+			}
+		}
+		return nil
+	}
+}
+
+func getShareSweeper(s *sweeper) func() error {
+	return func() error {
+		s.logStart(PluralObjectTypeShares)
+		shares, err := s.client.Shares.Show(s.ctx, nil)
 		if err != nil {
 			return err
 		}
 		for _, share := range shares {
-			if (share.Kind == ShareKindOutbound) && (prefix == "" || strings.HasPrefix(share.Name.Name(), prefix)) {
-				log.Printf("[DEBUG] Dropping share %s", share.ID().Name())
-				if err := client.Shares.Drop(ctx, share.ID()); err != nil {
+			share := share
+			if share.Kind == ShareKindOutbound && s.shouldSweep(share.Name.Name(), share.Owner) {
+				if err := s.drop(ObjectTypeShare, share.ID().Name(), func() error {
+					return s.client.Shares.Drop(s.ctx, share.ID())
+				}); err != nil {
 					return err
 				}
 			} else {
-				log.Printf("[DEBUG] Skipping share %s", share.Name.Name())
+				s.skip(ObjectTypeShare, share.Name.Name())
 			}
 		}
 		return nil
 	}
 }
 
-func getDatabaseSweeper(client *Client, prefix string) func() error {
+func getDatabaseSweeper(s *sweeper) func() error {
 	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all databases")
-		} else {
-			log.Printf("[DEBUG] Sweeping all databases with prefix %s", prefix)
-		}
-		ctx := context.Background()
-		dbs, err := client.Databases.Show(ctx, nil)
+		s.logStart(PluralObjectTypeDatabases)
+		dbs, err := s.client.Databases.Show(s.ctx, nil)
 		if err != nil {
 			return err
 		}
 		for _, db := range dbs {
-			if (prefix == "" || strings.HasPrefix(db.Name, prefix)) && db.Name != "SNOWFLAKE" && db.Name != "terraform_test_database" {
-				log.Printf("[DEBUG] Dropping database %s", db.Name)
-				if err := client.Databases.Drop(ctx, db.ID(), nil); err != nil {
+			db := db
+			if s.shouldSweep(db.Name, db.Owner) {
+				if err := s.drop(ObjectTypeDatabase, db.Name, func() error {
+					return s.client.Databases.Drop(s.ctx, db.ID(), nil)
+				}); err != nil {
 					return err
 				}
 			} else {
-				log.Printf("[DEBUG] Skipping database %s", db.Name)
+				s.skip(ObjectTypeDatabase, db.Name)
 			}
 		}
 		return nil
 	}
 }
 
-func getWarehouseSweeper(client *Client, prefix string) func() error {
+func getWarehouseSweeper(s *sweeper) func() error {
 	return func() error {
-		if prefix == "" {
-			log.Printf("[DEBUG] Sweeping all warehouses")
-		} else {
-			log.Printf("[DEBUG] Sweeping all warehouses with prefix %s", prefix)
-		}
-		ctx := context.Background()
-		whs, err := client.Warehouses.Show(ctx, nil)
+		s.logStart(PluralObjectTypeWarehouses)
+		whs, err := s.client.Warehouses.Show(s.ctx, nil)
 		if err != nil {
 			return err
 		}
 		for _, wh := range whs {
-			if (prefix == "" || strings.HasPrefix(wh.Name, prefix)) && wh.Name != "SNOWFLAKE" && wh.Name != "terraform_test_warehouse" {
-				log.Printf("[DEBUG] Dropping warehouse %s", wh.Name)
-				if err := client.Warehouses.Drop(ctx, wh.ID(), nil); err != nil {
+			wh := wh
+			if s.shouldSweep(wh.Name, wh.Owner) {
+				if err := s.drop(ObjectTypeWarehouse, wh.Name, func() error {
+					return s.client.Warehouses.Drop(s.ctx, wh.ID(), nil)
+				}); err != nil {
 					return err
 				}
 			} else {
-				log.Printf("[DEBUG] Skipping warehouse %s", wh.Name)
+				s.skip(ObjectTypeWarehouse, wh.Name)
 			}
 		}
 		return nil
 	}
 }
 
-func getAccountPolicyAttachementsSweeper(client *Client) func() error {
+func getRoleSweeper(s *sweeper) func() error {
 	return func() error {
-		log.Printf("[DEBUG] Unsetting password and session policies set on the account level")
-		ctx := context.Background()
-		opts := &AlterAccountOptions{
-			Unset: &AccountUnset{
-				PasswordPolicy: Bool(true),
-			},
+		s.logStart(PluralObjectTypeRoles)
+		roles, err := s.client.Roles.Show(s.ctx, NewShowRoleRequest())
+		if err != nil {
+			return err
 		}
-		_ = client.Accounts.Alter(ctx, opts)
-		opts = &AlterAccountOptions{
-			Unset: &AccountUnset{
-				SessionPolicy: Bool(true),
-			},
+		for _, role := range roles {
+			role := role
+			if s.shouldSweep(role.Name, role.Owner) {
+				if err := s.drop(ObjectTypeRole, role.Name, func() error {
+					return s.client.Roles.Drop(s.ctx, NewDropRoleRequest(role.ID()))
+				}); err != nil {
+					return err
+				}
+			} else {
+				s.skip(ObjectTypeRole, role.Name)
+			}
 		}
-		_ = client.Accounts.Alter(ctx, opts)
 		return nil
 	}
 }
@@ -1,12 +1,36 @@
 package sdk
 
 import (
+	"context"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 )
 
+// sweepOptionsFromEnv reads the dry run mode from SNOWFLAKE_SWEEP_DRY_RUN, the comma separated
+// protected names from SNOWFLAKE_SWEEP_PROTECTED_NAMES and protected owners from SNOWFLAKE_SWEEP_PROTECTED_OWNERS.
+func sweepOptionsFromEnv(prefix string) *SweepOptions {
+	opts := &SweepOptions{
+		Prefix: prefix,
+		DryRun: os.Getenv("SNOWFLAKE_SWEEP_DRY_RUN") == "1",
+	}
+	if protectedNames := os.Getenv("SNOWFLAKE_SWEEP_PROTECTED_NAMES"); protectedNames != "" {
+		for _, name := range strings.Split(protectedNames, ",") {
+			opts.ProtectedNames = append(opts.ProtectedNames, strings.TrimSpace(name))
+		}
+	}
+	if protectedOwners := os.Getenv("SNOWFLAKE_SWEEP_PROTECTED_OWNERS"); protectedOwners != "" {
+		for _, owner := range strings.Split(protectedOwners, ",") {
+			opts.ProtectedOwners = append(opts.ProtectedOwners, strings.TrimSpace(owner))
+		}
+	}
+	return opts
+}
+
 func TestSweepAll(t *testing.T) {
 	enableSweep := os.Getenv("SNOWFLAKE_ENABLE_SWEEP")
 	if enableSweep != "1" {
@@ -15,14 +39,20 @@ func TestSweepAll(t *testing.T) {
 
 	t.Run("all sweepers in secondary account", func(t *testing.T) {
 		client := testSecondaryClient(t)
-		err := SweepAll(client)
+		swept, err := SweepWithOptions(client, sweepOptionsFromEnv(""))
 		require.NoError(t, err)
+		for _, object := range swept {
+			t.Logf("swept %s %s", object.ObjectType, object.Name)
+		}
 	})
 
 	t.Run("all sweepers in primary account", func(t *testing.T) {
 		client := testClient(t)
-		err := SweepAll(client)
+		swept, err := SweepWithOptions(client, sweepOptionsFromEnv(""))
 		require.NoError(t, err)
+		for _, object := range swept {
+			t.Logf("swept %s %s", object.ObjectType, object.Name)
+		}
 	})
 }
 
@@ -36,4 +66,48 @@ func TestSweep(t *testing.T) {
 		err := Sweep(client, "TEST_")
 		require.NoError(t, err)
 	})
+
+	t.Run("sweepers in dry run mode", func(t *testing.T) {
+		client := testClient(t)
+		ctx := context.Background()
+		id := RandomAccountObjectIdentifier()
+		err := client.Roles.Create(ctx, NewCreateRoleRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Drop(ctx, NewDropRoleRequest(id).WithIfExists(true))
+			require.NoError(t, err)
+		})
+
+		swept, err := SweepWithOptions(client, &SweepOptions{Prefix: id.Name(), DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, []SweptObject{{ObjectType: ObjectTypeRole, Name: id.Name()}}, swept)
+
+		_, err = client.Roles.ShowByID(ctx, NewShowByIdRoleRequest(id))
+		require.NoError(t, err)
+	})
+}
+
+func TestSweeper_shouldSweep(t *testing.T) {
+	s := &sweeper{
+		prefix:          "TEST_",
+		protectedNames:  append(slices.Clone(defaultProtectedNames), "TEST_PRODUCTION"),
+		protectedOwners: defaultProtectedOwners,
+	}
+
+	assert.True(t, s.shouldSweep("TEST_LEFTOVER", "TEST_ROLE"))
+	assert.False(t, s.shouldSweep("PRODUCTION", "TEST_ROLE"))
+	assert.False(t, s.shouldSweep("TEST_PRODUCTION", "TEST_ROLE"))
+	assert.False(t, s.shouldSweep("TEST_LEFTOVER", "sysadmin"))
+	assert.True(t, s.shouldSweep("TEST_LEFTOVER", "ACCOUNTADMIN"))
+
+	s.prefix = ""
+	assert.True(t, s.shouldSweep("PRODUCTION", "TEST_ROLE"))
+	assert.True(t, s.shouldSweep("PRODUCTION", ""))
+	assert.False(t, s.shouldSweep("SYSADMIN", "TEST_ROLE"))
+	assert.False(t, s.shouldSweep("SNOWFLAKE", "TEST_ROLE"))
+
+	s.protectedOwners = []string{"TEST_OWNER", "ACCOUNTADMIN"}
+	assert.True(t, s.shouldSweep("PRODUCTION", "SYSADMIN"))
+	assert.False(t, s.shouldSweep("PRODUCTION", "TEST_OWNER"))
+	assert.False(t, s.shouldSweep("PRODUCTION", "ACCOUNTADMIN"))
 }
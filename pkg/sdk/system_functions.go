@@ -2,11 +2,14 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
 type SystemFunctions interface {
 	GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error)
+	GenerateSCIMAccessToken(ctx context.Context, integrationID AccountObjectIdentifier) (string, error)
+	GetPrivateLinkConfig(ctx context.Context) (*PrivateLinkConfig, error)
 }
 
 var _ SystemFunctions = (*systemFunctions)(nil)
@@ -26,3 +29,59 @@ func (c *systemFunctions) GetTag(ctx context.Context, tagID ObjectIdentifier, ob
 	}
 	return s.Tag, nil
 }
+
+// GenerateSCIMAccessToken is based on https://docs.snowflake.com/en/sql-reference/functions/system_generate_scim_access_token.
+func (c *systemFunctions) GenerateSCIMAccessToken(ctx context.Context, integrationID AccountObjectIdentifier) (string, error) {
+	if !ValidObjectIdentifier(integrationID) {
+		return "", ErrInvalidObjectIdentifier
+	}
+	s := &struct {
+		Token string `db:"TOKEN"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN('%s') AS "TOKEN"`, integrationID.Name())
+	err := c.client.queryOne(ctx, s, sql)
+	if err != nil {
+		return "", err
+	}
+	return s.Token, nil
+}
+
+type PrivateLinkConfig struct {
+	AccountName               string `json:"privatelink-account-name"`
+	AwsVpceID                 string `json:"privatelink-vpce-id,omitempty"`
+	AzurePrivateLinkServiceID string `json:"privatelink-pls-id,omitempty"`
+	AccountURL                string `json:"privatelink-account-url"`
+	OCSPURL                   string `json:"privatelink-ocsp-url,omitempty"`
+	InternalStage             string `json:"privatelink-internal-stage,omitempty"`
+	SnowsightURL              string `json:"snowsight-privatelink-url,omitempty"`
+	RegionlessSnowsightURL    string `json:"regionless-snowsight-privatelink-url,omitempty"`
+	RegionlessAccountURL      string `json:"regionless-privatelink-account-url,omitempty"`
+	ConnectionURLs            string `json:"privatelink-connection-urls,omitempty"`
+	// Snowflake returns the OCSP URL under this key for AWS accounts.
+	TypoOCSPURL string `json:"privatelink_ocsp-url,omitempty"`
+}
+
+func parsePrivateLinkConfig(raw string) (*PrivateLinkConfig, error) {
+	config := &PrivateLinkConfig{}
+	if err := json.Unmarshal([]byte(raw), config); err != nil {
+		return nil, fmt.Errorf("unable to parse private link config: %w", err)
+	}
+	if config.TypoOCSPURL != "" {
+		config.OCSPURL = config.TypoOCSPURL
+		config.TypoOCSPURL = ""
+	}
+	return config, nil
+}
+
+// GetPrivateLinkConfig is based on https://docs.snowflake.com/en/sql-reference/functions/system_get_privatelink_config.
+func (c *systemFunctions) GetPrivateLinkConfig(ctx context.Context) (*PrivateLinkConfig, error) {
+	s := &struct {
+		Config string `db:"CONFIG"`
+	}{}
+	sql := `SELECT SYSTEM$GET_PRIVATELINK_CONFIG() AS "CONFIG"`
+	err := c.client.queryOne(ctx, s, sql)
+	if err != nil {
+		return nil, err
+	}
+	return parsePrivateLinkConfig(s.Config)
+}
@@ -0,0 +1,35 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrivateLinkConfig(t *testing.T) {
+	t.Run("aws", func(t *testing.T) {
+		config, err := parsePrivateLinkConfig(`{"privatelink-account-name":"ab12345.eu-central-1.privatelink","privatelink-vpce-id":"com.amazonaws.vpce.eu-central-1.vpce-svc-1","privatelink-account-url":"ab12345.eu-central-1.privatelink.snowflakecomputing.com","regionless-privatelink-account-url":"org-acc.privatelink.snowflakecomputing.com","privatelink_ocsp-url":"ocsp.ab12345.eu-central-1.privatelink.snowflakecomputing.com"}`)
+		require.NoError(t, err)
+		assert.Equal(t, &PrivateLinkConfig{
+			AccountName:          "ab12345.eu-central-1.privatelink",
+			AwsVpceID:            "com.amazonaws.vpce.eu-central-1.vpce-svc-1",
+			AccountURL:           "ab12345.eu-central-1.privatelink.snowflakecomputing.com",
+			OCSPURL:              "ocsp.ab12345.eu-central-1.privatelink.snowflakecomputing.com",
+			RegionlessAccountURL: "org-acc.privatelink.snowflakecomputing.com",
+		}, config)
+	})
+
+	t.Run("azure", func(t *testing.T) {
+		config, err := parsePrivateLinkConfig(`{"privatelink-account-name":"ab12345.west-europe.privatelink","privatelink-pls-id":"sf-pvlinksvc-westeurope.1.azure.privatelinkservice","privatelink-account-url":"ab12345.west-europe.privatelink.snowflakecomputing.com","privatelink-ocsp-url":"ocsp.ab12345.west-europe.privatelink.snowflakecomputing.com"}`)
+		require.NoError(t, err)
+		assert.Equal(t, "sf-pvlinksvc-westeurope.1.azure.privatelinkservice", config.AzurePrivateLinkServiceID)
+		assert.Equal(t, "ocsp.ab12345.west-europe.privatelink.snowflakecomputing.com", config.OCSPURL)
+		assert.Empty(t, config.AwsVpceID)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parsePrivateLinkConfig(`not json`)
+		require.ErrorContains(t, err, "unable to parse private link config")
+	})
+}
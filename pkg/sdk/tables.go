@@ -165,7 +165,7 @@ type ColumnMaskingPolicy struct {
 
 // OutOfLineConstraint is based on https://docs.snowflake.com/en/sql-reference/sql/create-table-constraint#out-of-line-unique-primary-foreign-key.
 type OutOfLineConstraint struct {
-	Name       string               `ddl:"parameter,no_equals" sql:"CONSTRAINT"`
+	Name       *string              `ddl:"parameter,no_equals" sql:"CONSTRAINT"`
 	Type       ColumnConstraintType `ddl:"keyword"`
 	Columns    []string             `ddl:"keyword,parentheses"`
 	ForeignKey *OutOfLineForeignKey `ddl:"keyword"`
@@ -261,13 +261,15 @@ type TableColumnAddAction struct {
 	Type             DataType                        `ddl:"keyword"`
 	DefaultValue     *ColumnDefaultValue             `ddl:"keyword"`
 	InlineConstraint *TableColumnAddInlineConstraint `ddl:"keyword"`
+	Collate          *string                         `ddl:"parameter,no_equals,single_quotes" sql:"COLLATE"`
 	MaskingPolicy    *ColumnMaskingPolicy            `ddl:"keyword"`
 	Tags             []TagAssociation                `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment          *string                         `ddl:"parameter,no_equals,single_quotes" sql:"COMMENT"`
 }
 
 type TableColumnAddInlineConstraint struct {
 	NotNull    *bool                `ddl:"keyword" sql:"NOT NULL"`
-	Name       string               `ddl:"parameter,no_equals" sql:"CONSTRAINT"`
+	Name       *string              `ddl:"parameter,no_equals" sql:"CONSTRAINT"`
 	Type       ColumnConstraintType `ddl:"keyword"`
 	ForeignKey *ColumnAddForeignKey `ddl:"keyword"`
 }
@@ -367,9 +367,11 @@ type TableColumnAddActionRequest struct {
 	Type             DataType // required
 	DefaultValue     *ColumnDefaultValueRequest
 	InlineConstraint *TableColumnAddInlineConstraintRequest
+	Collate          *string
 	MaskingPolicy    *ColumnMaskingPolicyRequest
 	With             *bool
 	Tags             []TagAssociation
+	Comment          *string
 }
 
 type TableColumnAddInlineConstraintRequest struct {
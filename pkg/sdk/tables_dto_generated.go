@@ -1169,6 +1169,11 @@ func (s *TableColumnAddActionRequest) WithInlineConstraint(inlineConstraint *Tab
 	return s
 }
 
+func (s *TableColumnAddActionRequest) WithCollate(collate *string) *TableColumnAddActionRequest {
+	s.Collate = collate
+	return s
+}
+
 func (s *TableColumnAddActionRequest) WithMaskingPolicy(maskingPolicy *ColumnMaskingPolicyRequest) *TableColumnAddActionRequest {
 	s.MaskingPolicy = maskingPolicy
 	return s
@@ -1184,6 +1189,11 @@ func (s *TableColumnAddActionRequest) WithTags(tags []TagAssociation) *TableColu
 	return s
 }
 
+func (s *TableColumnAddActionRequest) WithComment(comment *string) *TableColumnAddActionRequest {
+	s.Comment = comment
+	return s
+}
+
 func NewTableColumnAddInlineConstraintRequest() *TableColumnAddInlineConstraintRequest {
 	return &TableColumnAddInlineConstraintRequest{}
 }
@@ -295,7 +295,7 @@ func (r *TableConstraintActionRequest) toOpts() *TableConstraintAction {
 			}
 		}
 		outOfLineConstraint := OutOfLineConstraint{
-			Name:               r.Add.Name,
+			Name:               constraintName(r.Add.Name),
 			Type:               r.Add.Type,
 			Columns:            r.Add.Columns,
 			ForeignKey:         foreignKey,
@@ -361,12 +361,18 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 	if r.Add != nil {
 		var defaultValue *ColumnDefaultValue
 		if r.Add.DefaultValue != nil {
-			defaultValue = &ColumnDefaultValue{
-				r.Add.DefaultValue.expression,
-				&ColumnIdentity{
+			var columnIdentity *ColumnIdentity
+			if r.Add.DefaultValue.identity != nil {
+				columnIdentity = &ColumnIdentity{
 					Start:     r.Add.DefaultValue.identity.Start,
 					Increment: r.Add.DefaultValue.identity.Increment,
-				},
+					Order:     r.Add.DefaultValue.identity.Order,
+					Noorder:   r.Add.DefaultValue.identity.Noorder,
+				}
+			}
+			defaultValue = &ColumnDefaultValue{
+				r.Add.DefaultValue.expression,
+				columnIdentity,
 			}
 		}
 		var inlineConstraint *TableColumnAddInlineConstraint
@@ -380,11 +386,19 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 			}
 			inlineConstraint = &TableColumnAddInlineConstraint{
 				NotNull:    r.Add.InlineConstraint.NotNull,
-				Name:       r.Add.InlineConstraint.Name,
+				Name:       constraintName(r.Add.InlineConstraint.Name),
 				Type:       r.Add.InlineConstraint.Type,
 				ForeignKey: foreignKey,
 			}
 		}
+		var maskingPolicy *ColumnMaskingPolicy
+		if r.Add.MaskingPolicy != nil {
+			maskingPolicy = &ColumnMaskingPolicy{
+				With:  r.Add.MaskingPolicy.with,
+				Name:  r.Add.MaskingPolicy.name,
+				Using: r.Add.MaskingPolicy.using,
+			}
+		}
 		return &TableColumnAction{
 			Add: &TableColumnAddAction{
 				IfNotExists:      r.Add.IfNotExists,
@@ -392,6 +406,10 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 				Type:             r.Add.Type,
 				DefaultValue:     defaultValue,
 				InlineConstraint: inlineConstraint,
+				Collate:          r.Add.Collate,
+				MaskingPolicy:    maskingPolicy,
+				Tags:             r.Add.Tags,
+				Comment:          r.Add.Comment,
 			},
 		}
 	}
@@ -502,7 +520,7 @@ func (s *CreateTableRequest) toOpts() *createTableOptions {
 			}
 		}
 		outOfLineConstraint := OutOfLineConstraint{
-			Name:               outOfLineConstraintRequest.Name,
+			Name:               constraintName(outOfLineConstraintRequest.Name),
 			Type:               outOfLineConstraintRequest.Type,
 			Columns:            outOfLineConstraintRequest.Columns,
 			ForeignKey:         foreignKey,
@@ -815,3 +833,11 @@ func (v *DescribeTableStageRequest) toOpts() *describeTableStageOptions {
 		name: v.id,
 	}
 }
+
+// constraintName returns nil for unnamed constraints, so that the CONSTRAINT keyword is not rendered for them.
+func constraintName(name string) *string {
+	if name == "" {
+		return nil
+	}
+	return &name
+}
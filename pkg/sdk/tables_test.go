@@ -406,7 +406,7 @@ func TestTableCreate(t *testing.T) {
 		}
 		require.NoError(t, err)
 		outOfLineConstraint1 := OutOfLineConstraint{
-			Name:    "OUT_OF_LINE_CONSTRAINT",
+			Name:    String("OUT_OF_LINE_CONSTRAINT"),
 			Type:    ColumnConstraintTypeForeignKey,
 			Columns: []string{"COLUMN_1", "COLUMN_2"},
 			ForeignKey: &OutOfLineForeignKey{
@@ -475,7 +475,7 @@ func TestTableCreate(t *testing.T) {
 			Comment:                    &tableComment,
 		}
 		assertOptsValidAndSQLEquals(t, opts,
-			`CREATE TABLE %s (%s %s CONSTRAINT INLINE_CONSTRAINT PRIMARY KEY NOT NULL COLLATE 'de' IDENTITY START 10 INCREMENT 1 ORDER MASKING POLICY %s USING (FOO, BAR) TAG ("db"."schema"."column_tag1" = 'v1', "db"."schema"."column_tag2" = 'v2') COMMENT '%s', CONSTRAINT OUT_OF_LINE_CONSTRAINT FOREIGN KEY (COLUMN_1, COLUMN_2) REFERENCES %s (COLUMN_3, COLUMN_4) MATCH FULL ON UPDATE SET NULL ON DELETE RESTRICT, CONSTRAINT UNIQUE (COLUMN_1) ENFORCED DEFERRABLE INITIALLY DEFERRED ENABLE RELY) CLUSTER BY (COLUMN_1, COLUMN_2) ENABLE_SCHEMA_EVOLUTION = true STAGE_FILE_FORMAT = (TYPE = CSV COMPRESSION = AUTO) STAGE_COPY_OPTIONS = (ON_ERROR = SKIP_FILE) DATA_RETENTION_TIME_IN_DAYS = 10 MAX_DATA_EXTENSION_TIME_IN_DAYS = 100 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en' COPY GRANTS ROW ACCESS POLICY %s ON (COLUMN_1, COLUMN_2) TAG ("db"."schema"."table_tag1" = 'v1', "db"."schema"."table_tag2" = 'v2') COMMENT = '%s'`,
+			`CREATE TABLE %s (%s %s CONSTRAINT INLINE_CONSTRAINT PRIMARY KEY NOT NULL COLLATE 'de' IDENTITY START 10 INCREMENT 1 ORDER MASKING POLICY %s USING (FOO, BAR) TAG ("db"."schema"."column_tag1" = 'v1', "db"."schema"."column_tag2" = 'v2') COMMENT '%s', CONSTRAINT OUT_OF_LINE_CONSTRAINT FOREIGN KEY (COLUMN_1, COLUMN_2) REFERENCES %s (COLUMN_3, COLUMN_4) MATCH FULL ON UPDATE SET NULL ON DELETE RESTRICT, UNIQUE (COLUMN_1) ENFORCED DEFERRABLE INITIALLY DEFERRED ENABLE RELY) CLUSTER BY (COLUMN_1, COLUMN_2) ENABLE_SCHEMA_EVOLUTION = true STAGE_FILE_FORMAT = (TYPE = CSV COMPRESSION = AUTO) STAGE_COPY_OPTIONS = (ON_ERROR = SKIP_FILE) DATA_RETENTION_TIME_IN_DAYS = 10 MAX_DATA_EXTENSION_TIME_IN_DAYS = 100 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en' COPY GRANTS ROW ACCESS POLICY %s ON (COLUMN_1, COLUMN_2) TAG ("db"."schema"."table_tag1" = 'v1', "db"."schema"."table_tag2" = 'v2') COMMENT = '%s'`,
 			id.FullyQualifiedName(),
 			columnName,
 			columnType,
@@ -999,6 +999,38 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ADD COLUMN IF NOT EXISTS NEXT_COLUMN BOOLEAN IDENTITY START 10 INCREMENT 1", id.FullyQualifiedName())
 	})
 
+	t.Run("add new column with all options", func(t *testing.T) {
+		maskingPolicyName := RandomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				Add: &TableColumnAddAction{
+					Name: "NEXT_COLUMN",
+					Type: DataTypeVARCHAR,
+					DefaultValue: &ColumnDefaultValue{
+						Expression: String("'default'"),
+					},
+					InlineConstraint: &TableColumnAddInlineConstraint{
+						NotNull: Bool(true),
+					},
+					Collate: String("en-ci"),
+					MaskingPolicy: &ColumnMaskingPolicy{
+						Name:  maskingPolicyName,
+						Using: []string{"FOO"},
+					},
+					Tags: []TagAssociation{
+						{
+							Name:  NewSchemaObjectIdentifier("db", "schema", "column_tag1"),
+							Value: "v1",
+						},
+					},
+					Comment: String("comment"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ADD COLUMN NEXT_COLUMN VARCHAR DEFAULT 'default' NOT NULL COLLATE 'en-ci' MASKING POLICY %s USING (FOO) TAG ("db"."schema"."column_tag1" = 'v1') COMMENT 'comment'`, id.FullyQualifiedName(), maskingPolicyName.FullyQualifiedName())
+	})
+
 	t.Run("rename column", func(t *testing.T) {
 		oldColumn := "OLD_NAME"
 		newColumnName := "NEW_NAME"
@@ -1191,7 +1223,7 @@ func TestTableAlter(t *testing.T) {
 
 	t.Run("alter constraint: add", func(t *testing.T) {
 		outOfLineConstraint := OutOfLineConstraint{
-			Name:    "OUT_OF_LINE_CONSTRAINT",
+			Name:    String("OUT_OF_LINE_CONSTRAINT"),
 			Type:    ColumnConstraintTypeForeignKey,
 			Columns: []string{"COLUMN_1", "COLUMN_2"},
 			ForeignKey: &OutOfLineForeignKey{
@@ -1256,6 +1288,18 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s DROP CONSTRAINT OUT_OF_LINE_CONSTRAINT (COLUMN_3, COLUMN_4) CASCADE", id.FullyQualifiedName())
 	})
 
+	t.Run("alter constraint: drop primary key", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ConstraintAction: &TableConstraintAction{
+				Drop: &TableConstraintDropAction{
+					PrimaryKey: Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s DROP PRIMARY KEY", id.FullyQualifiedName())
+	})
+
 	t.Run("external table: add", func(t *testing.T) {
 		opts := &alterTableOptions{
 			name: id,
@@ -239,7 +239,8 @@ func (opts *alterTableOptions) validate() error {
 			); !ok {
 				errs = append(errs, errExactlyOneOf("TableConstraintDropAction", "ConstraintName", "PrimaryKey", "Unique", "ForeignKey", "Columns"))
 			}
-			if len(dropAction.Columns) == 0 {
+			// a table has only one primary key, so it can be dropped without listing its columns
+			if len(dropAction.Columns) == 0 && !valueSet(dropAction.PrimaryKey) {
 				errs = append(errs, errNotSet("TableConstraintDropAction", "Columns"))
 			}
 		}
@@ -24,7 +24,8 @@ var taskDbRow = g.DbStruct("taskDBRow").
 	Field("last_suspended_on", "string").
 	Field("owner_role_type", "string").
 	Field("config", "string").
-	Field("budget", "string")
+	Field("budget", "string").
+	Field("task_relations", "string")
 
 var task = g.PlainStruct("Task").
 	Field("CreatedOn", "string").
@@ -46,7 +47,8 @@ var task = g.PlainStruct("Task").
 	Field("LastSuspendedOn", "string").
 	Field("OwnerRoleType", "string").
 	Field("Config", "string").
-	Field("Budget", "string")
+	Field("Budget", "string").
+	Field("TaskRelations", "TaskRelations")
 
 var TasksDef = g.NewInterface(
 	"Tasks",
@@ -78,13 +80,15 @@ var TasksDef = g.NewInterface(
 			OptionalTextAssignment("ERROR_INTEGRATION", g.ParameterOptions().NoQuotes()).
 			OptionalSQL("COPY GRANTS").
 			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalIdentifier("Finalize", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("FINALIZE")).
 			ListAssignment("AFTER", "SchemaObjectIdentifier", g.ParameterOptions().NoEquals()).
 			OptionalTags().
 			OptionalTextAssignment("WHEN", g.ParameterOptions().NoQuotes().NoEquals()).
 			SQL("AS").
 			Text("sql", g.KeywordOptions().NoQuotes().Required()).
 			WithValidation(g.ValidIdentifier, "name").
-			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ConflictingFields, "Schedule", "After"),
 	).
 	CustomOperation(
 		"Clone",
@@ -111,6 +115,8 @@ var TasksDef = g.NewInterface(
 			OptionalSQL("SUSPEND").
 			ListAssignment("REMOVE AFTER", "SchemaObjectIdentifier", g.ParameterOptions().NoEquals()).
 			ListAssignment("ADD AFTER", "SchemaObjectIdentifier", g.ParameterOptions().NoEquals()).
+			OptionalIdentifier("SetFinalize", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("SET FINALIZE")).
+			OptionalSQL("UNSET FINALIZE").
 			OptionalQueryStructField(
 				"Set",
 				g.NewQueryStruct("TaskSet").
@@ -148,7 +154,7 @@ var TasksDef = g.NewInterface(
 			OptionalTextAssignment("MODIFY AS", g.ParameterOptions().NoQuotes().NoEquals()).
 			OptionalTextAssignment("MODIFY WHEN", g.ParameterOptions().NoQuotes().NoEquals()).
 			WithValidation(g.ValidIdentifier, "name").
-			WithValidation(g.ExactlyOneValueSet, "Resume", "Suspend", "RemoveAfter", "AddAfter", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"),
+			WithValidation(g.ExactlyOneValueSet, "Resume", "Suspend", "RemoveAfter", "AddAfter", "SetFinalize", "UnsetFinalize", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"),
 	).
 	DropOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/drop-task",
@@ -74,6 +74,11 @@ func (s *CreateTaskRequest) WithComment(Comment *string) *CreateTaskRequest {
 	return s
 }
 
+func (s *CreateTaskRequest) WithFinalize(Finalize *SchemaObjectIdentifier) *CreateTaskRequest {
+	s.Finalize = Finalize
+	return s
+}
+
 func (s *CreateTaskRequest) WithAfter(After []SchemaObjectIdentifier) *CreateTaskRequest {
 	s.After = After
 	return s
@@ -156,6 +161,16 @@ func (s *AlterTaskRequest) WithAddAfter(AddAfter []SchemaObjectIdentifier) *Alte
 	return s
 }
 
+func (s *AlterTaskRequest) WithSetFinalize(SetFinalize *SchemaObjectIdentifier) *AlterTaskRequest {
+	s.SetFinalize = SetFinalize
+	return s
+}
+
+func (s *AlterTaskRequest) WithUnsetFinalize(UnsetFinalize *bool) *AlterTaskRequest {
+	s.UnsetFinalize = UnsetFinalize
+	return s
+}
+
 func (s *AlterTaskRequest) WithSet(Set *TaskSetRequest) *AlterTaskRequest {
 	s.Set = Set
 	return s
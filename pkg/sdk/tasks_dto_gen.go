@@ -26,6 +26,7 @@ type CreateTaskRequest struct {
 	ErrorIntegration            *string
 	CopyGrants                  *bool
 	Comment                     *string
+	Finalize                    *SchemaObjectIdentifier
 	After                       []SchemaObjectIdentifier
 	Tag                         []TagAssociation
 	When                        *string
@@ -53,18 +54,20 @@ func (r *CloneTaskRequest) GetName() SchemaObjectIdentifier {
 }
 
 type AlterTaskRequest struct {
-	IfExists    *bool
-	name        SchemaObjectIdentifier // required
-	Resume      *bool
-	Suspend     *bool
-	RemoveAfter []SchemaObjectIdentifier
-	AddAfter    []SchemaObjectIdentifier
-	Set         *TaskSetRequest
-	Unset       *TaskUnsetRequest
-	SetTags     []TagAssociation
-	UnsetTags   []ObjectIdentifier
-	ModifyAs    *string
-	ModifyWhen  *string
+	IfExists      *bool
+	name          SchemaObjectIdentifier // required
+	Resume        *bool
+	Suspend       *bool
+	RemoveAfter   []SchemaObjectIdentifier
+	AddAfter      []SchemaObjectIdentifier
+	SetFinalize   *SchemaObjectIdentifier
+	UnsetFinalize *bool
+	Set           *TaskSetRequest
+	Unset         *TaskUnsetRequest
+	SetTags       []TagAssociation
+	UnsetTags     []ObjectIdentifier
+	ModifyAs      *string
+	ModifyWhen    *string
 }
 
 type TaskSetRequest struct {
@@ -33,6 +33,7 @@ type CreateTaskOptions struct {
 	ErrorIntegration            *string                  `ddl:"parameter,no_quotes" sql:"ERROR_INTEGRATION"`
 	CopyGrants                  *bool                    `ddl:"keyword" sql:"COPY GRANTS"`
 	Comment                     *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Finalize                    *SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"FINALIZE"`
 	After                       []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"AFTER"`
 	Tag                         []TagAssociation         `ddl:"keyword,parentheses" sql:"TAG"`
 	When                        *string                  `ddl:"parameter,no_quotes,no_equals" sql:"WHEN"`
@@ -58,20 +59,22 @@ type CloneTaskOptions struct {
 
 // AlterTaskOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-task.
 type AlterTaskOptions struct {
-	alter       bool                     `ddl:"static" sql:"ALTER"`
-	task        bool                     `ddl:"static" sql:"TASK"`
-	IfExists    *bool                    `ddl:"keyword" sql:"IF EXISTS"`
-	name        SchemaObjectIdentifier   `ddl:"identifier"`
-	Resume      *bool                    `ddl:"keyword" sql:"RESUME"`
-	Suspend     *bool                    `ddl:"keyword" sql:"SUSPEND"`
-	RemoveAfter []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"REMOVE AFTER"`
-	AddAfter    []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"ADD AFTER"`
-	Set         *TaskSet                 `ddl:"list,no_parentheses" sql:"SET"`
-	Unset       *TaskUnset               `ddl:"list,no_parentheses" sql:"UNSET"`
-	SetTags     []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
-	UnsetTags   []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
-	ModifyAs    *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY AS"`
-	ModifyWhen  *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY WHEN"`
+	alter         bool                     `ddl:"static" sql:"ALTER"`
+	task          bool                     `ddl:"static" sql:"TASK"`
+	IfExists      *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name          SchemaObjectIdentifier   `ddl:"identifier"`
+	Resume        *bool                    `ddl:"keyword" sql:"RESUME"`
+	Suspend       *bool                    `ddl:"keyword" sql:"SUSPEND"`
+	RemoveAfter   []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"REMOVE AFTER"`
+	AddAfter      []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"ADD AFTER"`
+	SetFinalize   *SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"SET FINALIZE"`
+	UnsetFinalize *bool                    `ddl:"keyword" sql:"UNSET FINALIZE"`
+	Set           *TaskSet                 `ddl:"list,no_parentheses" sql:"SET"`
+	Unset         *TaskUnset               `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags       []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags     []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
+	ModifyAs      *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY AS"`
+	ModifyWhen    *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY WHEN"`
 }
 
 type TaskSet struct {
@@ -140,6 +143,7 @@ type taskDBRow struct {
 	OwnerRoleType             sql.NullString `db:"owner_role_type"`
 	Config                    sql.NullString `db:"config"`
 	Budget                    sql.NullString `db:"budget"`
+	TaskRelations             sql.NullString `db:"task_relations"`
 }
 
 type Task struct {
@@ -163,6 +167,14 @@ type Task struct {
 	OwnerRoleType             string
 	Config                    string
 	Budget                    string
+	TaskRelations             TaskRelations
+}
+
+// TaskRelations is parsed from the task_relations column holding the task graph relations of the task as JSON.
+type TaskRelations struct {
+	Predecessors      []SchemaObjectIdentifier
+	FinalizerTask     *SchemaObjectIdentifier
+	FinalizedRootTask *SchemaObjectIdentifier
 }
 
 // DescribeTaskOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-task.
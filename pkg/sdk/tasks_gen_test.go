@@ -34,6 +34,13 @@ func TestTasks_Create(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateTaskOptions", "OrReplace", "IfNotExists"))
 	})
 
+	t.Run("validation: conflicting fields for [opts.Schedule opts.After]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Schedule = String("10 MINUTE")
+		opts.After = []SchemaObjectIdentifier{RandomSchemaObjectIdentifier()}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateTaskOptions", "Schedule", "After"))
+	})
+
 	t.Run("validation: exactly one field from [opts.Warehouse.Warehouse opts.Warehouse.UserTaskManagedInitialWarehouseSize] should be present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Warehouse = &CreateTaskWarehouse{}
@@ -62,12 +69,12 @@ func TestTasks_Create(t *testing.T) {
 	t.Run("all options", func(t *testing.T) {
 		warehouseId := RandomAccountObjectIdentifier()
 		otherTaskId := RandomSchemaObjectIdentifier()
+		rootTaskId := RandomSchemaObjectIdentifier()
 		tagId := RandomSchemaObjectIdentifier()
 
 		req := NewCreateTaskRequest(id, sql).
 			WithOrReplace(Bool(true)).
 			WithWarehouse(NewCreateTaskWarehouseRequest().WithWarehouse(&warehouseId)).
-			WithSchedule(String("10 MINUTE")).
 			WithConfig(String(`$${"output_dir": "/temp/test_directory/", "learning_rate": 0.1}$$`)).
 			WithAllowOverlappingExecution(Bool(true)).
 			WithSessionParameters(&SessionParameters{
@@ -79,6 +86,7 @@ func TestTasks_Create(t *testing.T) {
 			WithErrorIntegration(String("some_error_integration")).
 			WithCopyGrants(Bool(true)).
 			WithComment(String("some comment")).
+			WithFinalize(&rootTaskId).
 			WithAfter([]SchemaObjectIdentifier{otherTaskId}).
 			WithTag([]TagAssociation{{
 				Name:  tagId,
@@ -86,7 +94,7 @@ func TestTasks_Create(t *testing.T) {
 			}}).
 			WithWhen(String(`SYSTEM$STREAM_HAS_DATA('MYSTREAM')`))
 
-		assertOptsValidAndSQLEquals(t, req.toOpts(), "CREATE OR REPLACE TASK %s WAREHOUSE = %s SCHEDULE = '10 MINUTE' CONFIG = $${\"output_dir\": \"/temp/test_directory/\", \"learning_rate\": 0.1}$$ ALLOW_OVERLAPPING_EXECUTION = true JSON_INDENT = 10, LOCK_TIMEOUT = 5 USER_TASK_TIMEOUT_MS = 5 SUSPEND_TASK_AFTER_NUM_FAILURES = 6 ERROR_INTEGRATION = some_error_integration COPY GRANTS COMMENT = 'some comment' AFTER %s TAG (%s = 'v1') WHEN SYSTEM$STREAM_HAS_DATA('MYSTREAM') AS SELECT CURRENT_TIMESTAMP", id.FullyQualifiedName(), warehouseId.FullyQualifiedName(), otherTaskId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, req.toOpts(), "CREATE OR REPLACE TASK %s WAREHOUSE = %s CONFIG = $${\"output_dir\": \"/temp/test_directory/\", \"learning_rate\": 0.1}$$ ALLOW_OVERLAPPING_EXECUTION = true JSON_INDENT = 10, LOCK_TIMEOUT = 5 USER_TASK_TIMEOUT_MS = 5 SUSPEND_TASK_AFTER_NUM_FAILURES = 6 ERROR_INTEGRATION = some_error_integration COPY GRANTS COMMENT = 'some comment' FINALIZE = %s AFTER %s TAG (%s = 'v1') WHEN SYSTEM$STREAM_HAS_DATA('MYSTREAM') AS SELECT CURRENT_TIMESTAMP", id.FullyQualifiedName(), warehouseId.FullyQualifiedName(), rootTaskId.FullyQualifiedName(), otherTaskId.FullyQualifiedName(), tagId.FullyQualifiedName())
 	})
 }
 
@@ -154,16 +162,16 @@ func TestTasks_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.SetFinalize opts.UnsetFinalize opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterTaskOptions", "Resume", "Suspend", "RemoveAfter", "AddAfter", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterTaskOptions", "Resume", "Suspend", "RemoveAfter", "AddAfter", "SetFinalize", "UnsetFinalize", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
 	})
 
-	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present - more present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.SetFinalize opts.UnsetFinalize opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present - more present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Resume = Bool(true)
 		opts.Suspend = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterTaskOptions", "Resume", "Suspend", "RemoveAfter", "AddAfter", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterTaskOptions", "Resume", "Suspend", "RemoveAfter", "AddAfter", "SetFinalize", "UnsetFinalize", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
 	})
 
 	t.Run("validation: at least one of the fields [opts.Set.Warehouse opts.Set.UserTaskManagedInitialWarehouseSize opts.Set.Schedule opts.Set.Config opts.Set.AllowOverlappingExecution opts.Set.UserTaskTimeoutMs opts.Set.SuspendTaskAfterNumFailures opts.Set.ErrorIntegration opts.Set.Comment opts.Set.SessionParameters] should be set", func(t *testing.T) {
@@ -227,6 +235,18 @@ func TestTasks_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s ADD AFTER %s", id.FullyQualifiedName(), otherTaskId.FullyQualifiedName())
 	})
 
+	t.Run("alter set finalize", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetFinalize = &otherTaskId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s SET FINALIZE = %s", id.FullyQualifiedName(), otherTaskId.FullyQualifiedName())
+	})
+
+	t.Run("alter unset finalize", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetFinalize = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s UNSET FINALIZE", id.FullyQualifiedName())
+	})
+
 	t.Run("alter set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &TaskSet{
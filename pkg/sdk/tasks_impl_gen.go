@@ -117,6 +117,7 @@ func (r *CreateTaskRequest) toOpts() *CreateTaskOptions {
 		ErrorIntegration:            r.ErrorIntegration,
 		CopyGrants:                  r.CopyGrants,
 		Comment:                     r.Comment,
+		Finalize:                    r.Finalize,
 		After:                       r.After,
 		Tag:                         r.Tag,
 		When:                        r.When,
@@ -143,12 +144,14 @@ func (r *CloneTaskRequest) toOpts() *CloneTaskOptions {
 
 func (r *AlterTaskRequest) toOpts() *AlterTaskOptions {
 	opts := &AlterTaskOptions{
-		IfExists:    r.IfExists,
-		name:        r.name,
-		Resume:      r.Resume,
-		Suspend:     r.Suspend,
-		RemoveAfter: r.RemoveAfter,
-		AddAfter:    r.AddAfter,
+		IfExists:      r.IfExists,
+		name:          r.name,
+		Resume:        r.Resume,
+		Suspend:       r.Suspend,
+		RemoveAfter:   r.RemoveAfter,
+		AddAfter:      r.AddAfter,
+		SetFinalize:   r.SetFinalize,
+		UnsetFinalize: r.UnsetFinalize,
 
 		SetTags:    r.SetTags,
 		UnsetTags:  r.UnsetTags,
@@ -271,9 +274,42 @@ func (r taskDBRow) convert() *Task {
 	if r.Budget.Valid {
 		task.Budget = r.Budget.String
 	}
+	if r.TaskRelations.Valid {
+		if taskRelations, err := getTaskRelations(r.TaskRelations.String); err == nil {
+			task.TaskRelations = *taskRelations
+		}
+	}
 	return &task
 }
 
+func getTaskRelations(taskRelations string) (*TaskRelations, error) {
+	// The relations are returned as a JSON object with fully qualified names,
+	// e.g. `{"Predecessors":["\"db\".\"schema\".\"task\""],"FinalizerTask":"db.schema.finalizer"}`.
+	relations := struct {
+		Predecessors      []string `json:"Predecessors"`
+		FinalizerTask     string   `json:"FinalizerTask"`
+		FinalizedRootTask string   `json:"FinalizedRootTask"`
+	}{}
+	if err := json.Unmarshal([]byte(taskRelations), &relations); err != nil {
+		return nil, err
+	}
+	result := &TaskRelations{
+		Predecessors: make([]SchemaObjectIdentifier, len(relations.Predecessors)),
+	}
+	for i, predecessor := range relations.Predecessors {
+		result.Predecessors[i] = NewSchemaObjectIdentifierFromFullyQualifiedName(predecessor)
+	}
+	if relations.FinalizerTask != "" {
+		finalizerTask := NewSchemaObjectIdentifierFromFullyQualifiedName(relations.FinalizerTask)
+		result.FinalizerTask = &finalizerTask
+	}
+	if relations.FinalizedRootTask != "" {
+		finalizedRootTask := NewSchemaObjectIdentifierFromFullyQualifiedName(relations.FinalizedRootTask)
+		result.FinalizedRootTask = &finalizedRootTask
+	}
+	return result, nil
+}
+
 func getPredecessors(predecessors string) ([]string, error) {
 	// Since 2022_03, Snowflake returns this as a JSON array (even empty)
 	// The list is formatted, e.g.:
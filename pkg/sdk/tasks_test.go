@@ -112,3 +112,28 @@ func Test_getPredecessors(t *testing.T) {
 		require.ErrorContains(t, err, "invalid character ']'")
 	})
 }
+
+func Test_getTaskRelations(t *testing.T) {
+	t.Run("predecessors and finalizer", func(t *testing.T) {
+		got, err := getTaskRelations("{\"Predecessors\":[\"\\\"a\\\".\\\"b\\\".\\\"c\\\"\"],\"FinalizerTask\":\"\\\"a\\\".\\\"b\\\".\\\"f\\\"\"}")
+		require.NoError(t, err)
+		require.Equal(t, []SchemaObjectIdentifier{NewSchemaObjectIdentifier("a", "b", "c")}, got.Predecessors)
+		require.NotNil(t, got.FinalizerTask)
+		require.Equal(t, NewSchemaObjectIdentifier("a", "b", "f"), *got.FinalizerTask)
+		require.Nil(t, got.FinalizedRootTask)
+	})
+
+	t.Run("finalized root task", func(t *testing.T) {
+		got, err := getTaskRelations("{\"Predecessors\":[],\"FinalizedRootTask\":\"\\\"a\\\".\\\"b\\\".\\\"r\\\"\"}")
+		require.NoError(t, err)
+		require.Empty(t, got.Predecessors)
+		require.Nil(t, got.FinalizerTask)
+		require.NotNil(t, got.FinalizedRootTask)
+		require.Equal(t, NewSchemaObjectIdentifier("a", "b", "r"), *got.FinalizedRootTask)
+	})
+
+	t.Run("incorrect json", func(t *testing.T) {
+		_, err := getTaskRelations("{]")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,164 @@
+package testint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ApplicationPackages(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	stage, cleanupStage := createStage(t, client, testDb(t), testSchema(t), random.AlphanumericN(8))
+	t.Cleanup(cleanupStage)
+
+	putOnStage(t, client, stage, "manifest.yml")
+	putOnStage(t, client, stage, "setup.sql")
+
+	cleanupApplicationPackageHandle := func(id sdk.AccountObjectIdentifier) func() {
+		return func() {
+			err := client.ApplicationPackages.Drop(ctx, sdk.NewDropApplicationPackageRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createApplicationPackageHandle := func(t *testing.T) *sdk.ApplicationPackage {
+		t.Helper()
+
+		id := sdk.NewAccountObjectIdentifier(random.StringN(4))
+		request := sdk.NewCreateApplicationPackageRequest(id).
+			WithComment(sdk.String("comment")).
+			WithDistribution(sdk.Pointer(sdk.DistributionInternal))
+		err := client.ApplicationPackages.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupApplicationPackageHandle(id))
+
+		applicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return applicationPackage
+	}
+
+	assertApplicationPackage := func(t *testing.T, id sdk.AccountObjectIdentifier) {
+		t.Helper()
+
+		e, err := client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, e.CreatedOn)
+		assert.Equal(t, id.Name(), e.Name)
+		assert.Equal(t, false, e.IsDefault)
+		assert.Equal(t, false, e.IsCurrent)
+		assert.Equal(t, string(sdk.DistributionInternal), e.Distribution)
+		assert.Equal(t, "ACCOUNTADMIN", e.Owner)
+		assert.Equal(t, "comment", e.Comment)
+		assert.Equal(t, 1, e.RetentionTime)
+	}
+
+	t.Run("create application package", func(t *testing.T) {
+		id := sdk.NewAccountObjectIdentifier(random.StringN(4))
+		request := sdk.NewCreateApplicationPackageRequest(id).
+			WithDataRetentionTimeInDays(sdk.Int(1)).
+			WithMaxDataExtensionTimeInDays(sdk.Int(1)).
+			WithDefaultDdlCollation(sdk.String("en_US")).
+			WithComment(sdk.String("comment")).
+			WithDistribution(sdk.Pointer(sdk.DistributionInternal))
+		err := client.ApplicationPackages.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupApplicationPackageHandle(id))
+
+		assertApplicationPackage(t, id)
+	})
+
+	t.Run("alter application package: set and unset", func(t *testing.T) {
+		e := createApplicationPackageHandle(t)
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+
+		set := sdk.NewApplicationPackageSetRequest().
+			WithDataRetentionTimeInDays(sdk.Int(2)).
+			WithComment(sdk.String("new comment")).
+			WithDistribution(sdk.Pointer(sdk.DistributionExternal))
+		err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		o, err := client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 2, o.RetentionTime)
+		assert.Equal(t, "new comment", o.Comment)
+		assert.Equal(t, string(sdk.DistributionExternal), o.Distribution)
+
+		unset := sdk.NewApplicationPackageUnsetRequest().
+			WithComment(sdk.Bool(true)).
+			WithDistribution(sdk.Bool(true))
+		err = client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+
+		o, err = client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, o.Comment)
+		assert.Equal(t, string(sdk.DistributionInternal), o.Distribution)
+	})
+
+	t.Run("alter application package: versions, patches and release directive", func(t *testing.T) {
+		e := createApplicationPackageHandle(t)
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+		using := "@" + stage.ID().FullyQualifiedName()
+
+		addVersion := sdk.NewAddVersionRequest(using).
+			WithVersionIdentifier(sdk.String("V001")).
+			WithLabel(sdk.String("label"))
+		err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddVersion(addVersion))
+		require.NoError(t, err)
+
+		addPatch := sdk.NewAddPatchForVersionRequest("V001", using)
+		err = client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddPatchForVersion(addPatch))
+		require.NoError(t, err)
+
+		versions, err := client.ApplicationPackages.ShowVersions(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		patch, err := collections.FindOne(versions, func(v sdk.ApplicationPackageVersion) bool { return v.Patch == 1 })
+		require.NoError(t, err)
+		assert.Equal(t, "V001", patch.Version)
+
+		directive := sdk.NewSetDefaultReleaseDirectiveRequest("V001", 1)
+		err = client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(directive))
+		require.NoError(t, err)
+
+		// a version referenced by the default release directive cannot be dropped, so the directive has to be moved first
+		addVersion = sdk.NewAddVersionRequest(using).WithVersionIdentifier(sdk.String("V002"))
+		err = client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddVersion(addVersion))
+		require.NoError(t, err)
+		directive = sdk.NewSetDefaultReleaseDirectiveRequest("V002", 0)
+		err = client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(directive))
+		require.NoError(t, err)
+
+		err = client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithDropVersion(sdk.NewDropVersionRequest("V001")))
+		require.NoError(t, err)
+
+		versions, err = client.ApplicationPackages.ShowVersions(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "V002", versions[0].Version)
+	})
+
+	t.Run("show application package for SQL: with like", func(t *testing.T) {
+		e := createApplicationPackageHandle(t)
+
+		packages, err := client.ApplicationPackages.Show(ctx, sdk.NewShowApplicationPackageRequest().WithLike(&sdk.Like{Pattern: &e.Name}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(packages))
+		require.Equal(t, *e, packages[0])
+	})
+
+	t.Run("show by id: missing application package", func(t *testing.T) {
+		_, err := client.ApplicationPackages.ShowByID(ctx, sdk.NewAccountObjectIdentifier(fmt.Sprintf("missing_%s", random.StringN(4))))
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -79,4 +79,40 @@ func TestInt_ApplicationRoles(t *testing.T) {
 		assertApplicationRoles(t, appRoles, "app_role_1", "some comment")
 		assertApplicationRoles(t, appRoles, "app_role_2", "some comment2")
 	})
+
+	t.Run("Grant and revoke: to account role", func(t *testing.T) {
+		ctx := context.Background()
+		id := sdk.NewDatabaseObjectIdentifier(appName, "app_role_1")
+		role, cleanupRole := createRole(t, client)
+		t.Cleanup(cleanupRole)
+		roleId := sdk.NewAccountObjectIdentifier(role.Name)
+
+		err := client.ApplicationRoles.Grant(ctx, sdk.NewGrantApplicationRoleRequest(id, *sdk.NewKindOfRoleRequest().WithRoleName(&roleId)))
+		require.NoError(t, err)
+
+		grants, err := client.ApplicationRoles.ShowGrantsOf(ctx, id)
+		require.NoError(t, err)
+		_, err = collections.FindOne(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedTo == sdk.ObjectTypeRole && grant.GranteeName.Name() == role.Name
+		})
+		require.NoError(t, err)
+
+		err = client.ApplicationRoles.Revoke(ctx, sdk.NewRevokeApplicationRoleRequest(id, *sdk.NewKindOfRoleRequest().WithRoleName(&roleId)))
+		require.NoError(t, err)
+
+		grants, err = client.ApplicationRoles.ShowGrantsOf(ctx, id)
+		require.NoError(t, err)
+		_, err = collections.FindOne(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedTo == sdk.ObjectTypeRole && grant.GranteeName.Name() == role.Name
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Show grants to", func(t *testing.T) {
+		ctx := context.Background()
+		id := sdk.NewDatabaseObjectIdentifier(appName, "app_role_1")
+
+		_, err := client.ApplicationRoles.ShowGrantsTo(ctx, id)
+		require.NoError(t, err)
+	})
 }
@@ -0,0 +1,129 @@
+package testint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Applications(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	stage, cleanupStage := createStage(t, client, testDb(t), testSchema(t), random.AlphanumericN(8))
+	t.Cleanup(cleanupStage)
+
+	putOnStage(t, client, stage, "manifest.yml")
+	putOnStage(t, client, stage, "setup.sql")
+
+	using := "@" + stage.ID().FullyQualifiedName()
+	packageId := sdk.NewAccountObjectIdentifier(random.StringN(4))
+	err := client.ApplicationPackages.Create(ctx, sdk.NewCreateApplicationPackageRequest(packageId))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ApplicationPackages.Drop(ctx, sdk.NewDropApplicationPackageRequest(packageId))
+		require.NoError(t, err)
+	})
+	for _, version := range []string{"V001", "V002"} {
+		addVersion := sdk.NewAddVersionRequest(using).WithVersionIdentifier(sdk.String(version))
+		err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(packageId).WithAddVersion(addVersion))
+		require.NoError(t, err)
+	}
+
+	cleanupApplicationHandle := func(id sdk.AccountObjectIdentifier) func() {
+		return func() {
+			err := client.Applications.Drop(ctx, sdk.NewDropApplicationRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createApplicationHandle := func(t *testing.T, version string) *sdk.Application {
+		t.Helper()
+
+		id := sdk.NewAccountObjectIdentifier(random.StringN(4))
+		request := sdk.NewCreateApplicationRequest(id, packageId).
+			WithVersion(sdk.NewApplicationVersionRequest().WithVersionAndPatch(sdk.NewVersionAndPatchRequest(version).WithPatch(sdk.Int(0)))).
+			WithComment(sdk.String("comment"))
+		err := client.Applications.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupApplicationHandle(id))
+
+		application, err := client.Applications.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return application
+	}
+
+	t.Run("create application", func(t *testing.T) {
+		e := createApplicationHandle(t, "V001")
+
+		assert.NotEmpty(t, e.CreatedOn)
+		assert.Equal(t, packageId.Name(), e.Source)
+		assert.Equal(t, "APPLICATION PACKAGE", e.SourceType)
+		assert.Equal(t, "ACCOUNTADMIN", e.Owner)
+		assert.Equal(t, "comment", e.Comment)
+		assert.Equal(t, "V001", e.Version)
+		assert.Equal(t, 0, e.Patch)
+	})
+
+	t.Run("alter application: set and unset", func(t *testing.T) {
+		e := createApplicationHandle(t, "V001")
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+
+		set := sdk.NewApplicationSetRequest().
+			WithComment(sdk.String("new comment")).
+			WithDebugMode(sdk.Bool(true))
+		err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		o, err := client.Applications.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", o.Comment)
+
+		unset := sdk.NewApplicationUnsetRequest().
+			WithComment(sdk.Bool(true)).
+			WithDebugMode(sdk.Bool(true))
+		err = client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+
+		o, err = client.Applications.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, o.Comment)
+	})
+
+	t.Run("alter application: upgrade using version", func(t *testing.T) {
+		e := createApplicationHandle(t, "V001")
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+
+		version := sdk.NewApplicationVersionRequest().WithVersionAndPatch(sdk.NewVersionAndPatchRequest("V002"))
+		err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUpgradeVersion(version))
+		require.NoError(t, err)
+
+		o, err := client.Applications.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "V002", o.Version)
+	})
+
+	t.Run("describe application", func(t *testing.T) {
+		e := createApplicationHandle(t, "V001")
+
+		properties, err := client.Applications.Describe(ctx, sdk.NewAccountObjectIdentifier(e.Name))
+		require.NoError(t, err)
+		property, err := collections.FindOne(properties, func(p sdk.ApplicationProperty) bool { return p.Property == "version" })
+		require.NoError(t, err)
+		assert.Equal(t, "V001", property.Value)
+	})
+
+	t.Run("show application: with like", func(t *testing.T) {
+		e := createApplicationHandle(t, "V001")
+
+		applications, err := client.Applications.Show(ctx, sdk.NewShowApplicationRequest().WithLike(&sdk.Like{Pattern: &e.Name}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(applications))
+		require.Equal(t, *e, applications[0])
+	})
+}
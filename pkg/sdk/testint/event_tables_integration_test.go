@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -185,7 +184,7 @@ func TestInt_EventTables(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = client.EventTables.ShowByID(ctx, id)
-		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
 
 		_, err = client.EventTables.ShowByID(ctx, nid)
 		require.NoError(t, err)
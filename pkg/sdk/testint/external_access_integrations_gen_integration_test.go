@@ -0,0 +1,114 @@
+package testint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	schemaTest := testSchema(t)
+
+	networkRule, networkRuleCleanup := createNetworkRule(t, client, schemaTest)
+	t.Cleanup(networkRuleCleanup)
+
+	secret, secretCleanup := createGenericStringSecret(t, client, schemaTest)
+	t.Cleanup(secretCleanup)
+
+	createExternalAccessIntegration := func(t *testing.T) *sdk.ExternalAccessIntegration {
+		t.Helper()
+
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.ExternalAccessIntegrations.Create(ctx, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+
+		externalAccessIntegration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return externalAccessIntegration
+	}
+
+	findProperty := func(t *testing.T, properties []sdk.ExternalAccessIntegrationProperty, name string) string {
+		t.Helper()
+		property, err := collections.FindOne(properties, func(p sdk.ExternalAccessIntegrationProperty) bool { return p.Property == name })
+		require.NoError(t, err)
+		return property.PropertyValue
+	}
+
+	t.Run("create external access integration", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true).
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secret.ID()}).
+			WithComment(sdk.String("comment"))
+		err := client.ExternalAccessIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id))
+			require.NoError(t, err)
+		})
+
+		e, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), e.Name)
+		assert.Equal(t, "EXTERNAL_ACCESS", e.Type)
+		assert.Equal(t, true, e.Enabled)
+		assert.Equal(t, "comment", e.Comment)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, findProperty(t, properties, "ALLOWED_NETWORK_RULES"), networkRule.Name)
+		assert.Contains(t, findProperty(t, properties, "ALLOWED_AUTHENTICATION_SECRETS"), secret.Name)
+	})
+
+	t.Run("alter external access integration: set and unset", func(t *testing.T) {
+		e := createExternalAccessIntegration(t)
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+
+		set := sdk.NewExternalAccessIntegrationSetRequest().
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secret.ID()}).
+			WithEnabled(sdk.Bool(false)).
+			WithComment(sdk.String("new comment"))
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		o, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, false, o.Enabled)
+		assert.Equal(t, "new comment", o.Comment)
+
+		unset := sdk.NewExternalAccessIntegrationUnsetRequest().
+			WithAllowedAuthenticationSecrets(sdk.Bool(true)).
+			WithComment(sdk.Bool(true))
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+
+		o, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, o.Comment)
+	})
+
+	t.Run("show external access integration: with like", func(t *testing.T) {
+		e := createExternalAccessIntegration(t)
+
+		externalAccessIntegrations, err := client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest().WithLike(&sdk.Like{Pattern: &e.Name}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(externalAccessIntegrations))
+		require.Equal(t, *e, externalAccessIntegrations[0])
+	})
+
+	t.Run("show by id: missing external access integration", func(t *testing.T) {
+		_, err := client.ExternalAccessIntegrations.ShowByID(ctx, sdk.NewAccountObjectIdentifier("missing_"+random.StringN(4)))
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,127 @@
+package testint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// externalVolumeS3StorageLocation returns a storage location pointing at the bucket used by the external table tests.
+func externalVolumeS3StorageLocation(t *testing.T, name string) *sdk.ExternalVolumeStorageLocationRequest {
+	t.Helper()
+
+	bucketURL := os.Getenv("AWS_EXTERNAL_BUCKET_URL")
+	roleName := os.Getenv("AWS_EXTERNAL_ROLE_NAME")
+	if bucketURL == "" || roleName == "" {
+		t.Skip("Skipping external volume test, AWS_EXTERNAL_BUCKET_URL and AWS_EXTERNAL_ROLE_NAME have to be set")
+	}
+	return sdk.NewExternalVolumeStorageLocationRequest(name, sdk.ExternalVolumeStorageProviderS3, bucketURL).
+		WithStorageAwsRoleArn(sdk.String(roleName))
+}
+
+func TestInt_ExternalVolumes(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	cleanupExternalVolumeHandle := func(id sdk.AccountObjectIdentifier) func() {
+		return func() {
+			err := client.ExternalVolumes.Drop(ctx, sdk.NewDropExternalVolumeRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createExternalVolumeHandle := func(t *testing.T) *sdk.ExternalVolume {
+		t.Helper()
+
+		id := sdk.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalVolumeRequest(id, []sdk.ExternalVolumeStorageLocationRequest{
+			*externalVolumeS3StorageLocation(t, "s3-location"),
+		}).WithComment(sdk.String("comment"))
+		err := client.ExternalVolumes.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupExternalVolumeHandle(id))
+
+		externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return externalVolume
+	}
+
+	t.Run("create external volume", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalVolumeRequest(id, []sdk.ExternalVolumeStorageLocationRequest{
+			*externalVolumeS3StorageLocation(t, "s3-location").
+				WithEncryption(sdk.NewExternalVolumeStorageLocationEncryptionRequest("AWS_SSE_S3")),
+		}).
+			WithAllowWrites(sdk.Bool(true)).
+			WithComment(sdk.String("comment"))
+		err := client.ExternalVolumes.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupExternalVolumeHandle(id))
+
+		e, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), e.Name)
+		assert.Equal(t, true, e.AllowWrites)
+		assert.Equal(t, "comment", e.Comment)
+	})
+
+	t.Run("alter external volume: add and remove storage location", func(t *testing.T) {
+		e := createExternalVolumeHandle(t)
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+
+		err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).
+			WithAddStorageLocation(externalVolumeS3StorageLocation(t, "s3-location-2")))
+		require.NoError(t, err)
+
+		properties, err := client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		_, err = collections.FindOne(properties, func(p sdk.ExternalVolumeProperty) bool { return p.Property == "STORAGE_LOCATION_2" })
+		require.NoError(t, err)
+
+		err = client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).
+			WithRemoveStorageLocation(sdk.String("s3-location-2")))
+		require.NoError(t, err)
+
+		properties, err = client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		_, err = collections.FindOne(properties, func(p sdk.ExternalVolumeProperty) bool { return p.Property == "STORAGE_LOCATION_2" })
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("alter external volume: set", func(t *testing.T) {
+		e := createExternalVolumeHandle(t)
+		id := sdk.NewAccountObjectIdentifier(e.Name)
+
+		set := sdk.NewExternalVolumeSetRequest().
+			WithAllowWrites(sdk.Bool(false)).
+			WithComment(sdk.String("new comment"))
+		err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		o, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, false, o.AllowWrites)
+		assert.Equal(t, "new comment", o.Comment)
+	})
+
+	t.Run("show external volume: with like", func(t *testing.T) {
+		e := createExternalVolumeHandle(t)
+
+		externalVolumes, err := client.ExternalVolumes.Show(ctx, sdk.NewShowExternalVolumeRequest().WithLike(&sdk.Like{Pattern: &e.Name}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(externalVolumes))
+		require.Equal(t, *e, externalVolumes[0])
+	})
+
+	t.Run("show by id: missing external volume", func(t *testing.T) {
+		_, err := client.ExternalVolumes.ShowByID(ctx, sdk.NewAccountObjectIdentifier(fmt.Sprintf("missing_%s", random.StringN(4))))
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,304 @@
+package testint
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CreateFunctions(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	databaseTest, schemaTest := testDb(t), testSchema(t)
+
+	cleanupFunctionHandle := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id))
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				return
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("create function for Java", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, random.String(), []sdk.DataType{sdk.DataTypeVARCHAR})
+
+		definition := `
+		class TestFunc {
+			public static String echoVarchar(String x) {
+				return x;
+			}
+		}`
+		dt := sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataTypeVARCHAR)
+		returns := sdk.NewFunctionReturnsRequest().WithResultDataType(dt)
+		argument := sdk.NewFunctionArgumentRequest("x", sdk.DataTypeVARCHAR)
+		request := sdk.NewCreateForJavaFunctionRequest(id.WithoutArguments(), *returns, "TestFunc.echoVarchar").
+			WithOrReplace(sdk.Bool(true)).
+			WithArguments([]sdk.FunctionArgumentRequest{*argument}).
+			WithNullInputBehavior(sdk.NullInputBehaviorPointer(sdk.NullInputBehaviorCalledOnNullInput)).
+			WithFunctionDefinition(sdk.String(definition))
+		err := client.Functions.CreateForJava(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupFunctionHandle(id))
+
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "JAVA", function.Language)
+	})
+
+	t.Run("create function for Javascript", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, random.String(), []sdk.DataType{sdk.DataTypeFloat})
+
+		definition := `
+		if (D <= 0) {
+			return 1;
+		} else {
+			var result = 1;
+			for (var i = 2; i <= D; i++) {
+				result = result * i;
+			}
+			return result;
+		}`
+		dt := sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataTypeFloat)
+		returns := sdk.NewFunctionReturnsRequest().WithResultDataType(dt)
+		argument := sdk.NewFunctionArgumentRequest("d", sdk.DataTypeFloat)
+		request := sdk.NewCreateForJavascriptFunctionRequest(id.WithoutArguments(), *returns, definition).
+			WithOrReplace(sdk.Bool(true)).
+			WithArguments([]sdk.FunctionArgumentRequest{*argument}).
+			WithNullInputBehavior(sdk.NullInputBehaviorPointer(sdk.NullInputBehaviorCalledOnNullInput))
+		err := client.Functions.CreateForJavascript(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupFunctionHandle(id))
+
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "JAVASCRIPT", function.Language)
+	})
+
+	t.Run("create function for Python", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, random.String(), []sdk.DataType{sdk.DataTypeNumber})
+
+		definition := `
+def dump(i):
+	print("Hello World!")
+`
+		dt := sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataTypeVariant)
+		returns := sdk.NewFunctionReturnsRequest().WithResultDataType(dt)
+		argument := sdk.NewFunctionArgumentRequest("i", sdk.DataTypeNumber)
+		request := sdk.NewCreateForPythonFunctionRequest(id.WithoutArguments(), *returns, "3.8", "dump").
+			WithOrReplace(sdk.Bool(true)).
+			WithArguments([]sdk.FunctionArgumentRequest{*argument}).
+			WithFunctionDefinition(sdk.String(definition))
+		err := client.Functions.CreateForPython(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupFunctionHandle(id))
+
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "PYTHON", function.Language)
+	})
+
+	t.Run("create function for Scala", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, random.String(), []sdk.DataType{sdk.DataTypeVARCHAR})
+
+		definition := `
+		class Echo {
+			def echoVarchar(x : String): String = {
+				return x
+			}
+		}`
+		argument := sdk.NewFunctionArgumentRequest("x", sdk.DataTypeVARCHAR)
+		request := sdk.NewCreateForScalaFunctionRequest(id.WithoutArguments(), sdk.DataTypeVARCHAR, "Echo.echoVarchar").
+			WithOrReplace(sdk.Bool(true)).
+			WithArguments([]sdk.FunctionArgumentRequest{*argument}).
+			WithRuntimeVersion(sdk.String("2.12")).
+			WithFunctionDefinition(sdk.String(definition))
+		err := client.Functions.CreateForScala(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupFunctionHandle(id))
+
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "SCALA", function.Language)
+	})
+
+	t.Run("create function for SQL", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, random.String(), []sdk.DataType{sdk.DataTypeFloat})
+
+		dt := sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataTypeFloat)
+		returns := sdk.NewFunctionReturnsRequest().WithResultDataType(dt)
+		argument := sdk.NewFunctionArgumentRequest("x", sdk.DataTypeFloat)
+		request := sdk.NewCreateForSQLFunctionRequest(id.WithoutArguments(), *returns, "3.141592654::FLOAT").
+			WithOrReplace(sdk.Bool(true)).
+			WithArguments([]sdk.FunctionArgumentRequest{*argument}).
+			WithComment(sdk.String("comment"))
+		err := client.Functions.CreateForSQL(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupFunctionHandle(id))
+
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "SQL", function.Language)
+		assert.Equal(t, "comment", function.Description)
+	})
+}
+
+func TestInt_OtherFunctions(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	databaseTest, schemaTest := testDb(t), testSchema(t)
+	tagTest, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+
+	cleanupFunctionHandle := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id))
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				return
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	createFunctionForSQLHandle := func(t *testing.T, name string, arguments []sdk.DataType, cleanup bool) sdk.SchemaObjectIdentifier {
+		t.Helper()
+
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, name, arguments)
+		dt := sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataTypeFloat)
+		returns := sdk.NewFunctionReturnsRequest().WithResultDataType(dt)
+		requests := make([]sdk.FunctionArgumentRequest, len(arguments))
+		for i, argument := range arguments {
+			requests[i] = *sdk.NewFunctionArgumentRequest(fmt.Sprintf("x%d", i), argument)
+		}
+		request := sdk.NewCreateForSQLFunctionRequest(id.WithoutArguments(), *returns, "3.141592654::FLOAT").
+			WithOrReplace(sdk.Bool(true)).
+			WithArguments(requests)
+		err := client.Functions.CreateForSQL(ctx, request)
+		require.NoError(t, err)
+		if cleanup {
+			t.Cleanup(cleanupFunctionHandle(id))
+		}
+		return id
+	}
+
+	t.Run("show by id: overloaded functions", func(t *testing.T) {
+		name := random.String()
+		numberID := createFunctionForSQLHandle(t, name, []sdk.DataType{sdk.DataTypeNumber}, true)
+		varcharID := createFunctionForSQLHandle(t, name, []sdk.DataType{sdk.DataTypeVARCHAR, sdk.DataTypeNumber}, true)
+
+		function, err := client.Functions.ShowByID(ctx, numberID)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.DataType{sdk.DataTypeNumber}, function.ArgumentDataTypes())
+
+		function, err = client.Functions.ShowByID(ctx, varcharID)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.DataType{sdk.DataTypeVARCHAR, sdk.DataTypeNumber}, function.ArgumentDataTypes())
+
+		_, err = client.Functions.ShowByID(ctx, numberID.WithoutArguments())
+		require.Error(t, err)
+
+		_, err = client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, name, []sdk.DataType{sdk.DataTypeFloat}))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter function: rename", func(t *testing.T) {
+		id := createFunctionForSQLHandle(t, random.String(), []sdk.DataType{sdk.DataTypeNumber}, false)
+
+		nid := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, random.String(), id.Arguments())
+		newName := nid.WithoutArguments()
+		err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithRenameTo(&newName))
+		if err != nil {
+			t.Cleanup(cleanupFunctionHandle(id))
+		} else {
+			t.Cleanup(cleanupFunctionHandle(nid))
+		}
+		require.NoError(t, err)
+
+		_, err = client.Functions.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+
+		function, err := client.Functions.ShowByID(ctx, nid)
+		require.NoError(t, err)
+		assert.Equal(t, nid.Name(), function.Name)
+	})
+
+	t.Run("alter function: set and unset secure", func(t *testing.T) {
+		id := createFunctionForSQLHandle(t, random.String(), []sdk.DataType{sdk.DataTypeNumber}, true)
+
+		err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithSetSecure(sdk.Bool(true)))
+		require.NoError(t, err)
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, function.IsSecure)
+
+		err = client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithUnsetSecure(sdk.Bool(true)))
+		require.NoError(t, err)
+		function, err = client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, function.IsSecure)
+	})
+
+	t.Run("alter function: set and unset comment", func(t *testing.T) {
+		id := createFunctionForSQLHandle(t, random.String(), []sdk.DataType{sdk.DataTypeNumber}, true)
+
+		err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithSetComment(sdk.String("comment")))
+		require.NoError(t, err)
+		function, err := client.Functions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "comment", function.Description)
+
+		err = client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithUnsetComment(sdk.Bool(true)))
+		require.NoError(t, err)
+	})
+
+	t.Run("alter function: set and unset tags", func(t *testing.T) {
+		id := createFunctionForSQLHandle(t, random.String(), []sdk.DataType{sdk.DataTypeNumber}, true)
+
+		setTags := []sdk.TagAssociation{
+			{
+				Name:  tagTest.ID(),
+				Value: "abc",
+			},
+		}
+		err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithSetTags(setTags))
+		require.NoError(t, err)
+
+		unsetTags := []sdk.ObjectIdentifier{
+			tagTest.ID(),
+		}
+		err = client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id).WithUnsetTags(unsetTags))
+		require.NoError(t, err)
+	})
+
+	t.Run("describe function", func(t *testing.T) {
+		id := createFunctionForSQLHandle(t, random.String(), []sdk.DataType{sdk.DataTypeNumber}, true)
+
+		details, err := client.Functions.Describe(ctx, id)
+		require.NoError(t, err)
+		pairs := make(map[string]string)
+		for _, detail := range details {
+			pairs[detail.Property] = detail.Value
+		}
+		assert.Equal(t, "SQL", pairs["language"])
+		assert.Equal(t, "FLOAT", pairs["returns"])
+		assert.Equal(t, "3.141592654::FLOAT", pairs["body"])
+	})
+
+	t.Run("drop function", func(t *testing.T) {
+		id := createFunctionForSQLHandle(t, random.String(), []sdk.DataType{}, false)
+
+		err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.Functions.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
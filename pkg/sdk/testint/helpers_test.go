@@ -413,6 +413,36 @@ func createNetworkPolicy(t *testing.T, client *sdk.Client, req *sdk.CreateNetwor
 	}
 }
 
+func createNetworkRule(t *testing.T, client *sdk.Client, schema *sdk.Schema) (*sdk.NetworkRule, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := sdk.NewSchemaObjectIdentifier(schema.DatabaseName, schema.Name, random.StringN(12))
+	request := sdk.NewCreateNetworkRuleRequest(id, sdk.NetworkRuleTypeHostPort, sdk.NetworkRuleModeEgress).
+		WithValueList([]sdk.NetworkRuleValueRequest{*sdk.NewNetworkRuleValueRequest("example.com")})
+	err := client.NetworkRules.Create(ctx, request)
+	require.NoError(t, err)
+	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return networkRule, func() {
+		err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id).WithIfExists(sdk.Bool(true)))
+		require.NoError(t, err)
+	}
+}
+
+func createGenericStringSecret(t *testing.T, client *sdk.Client, schema *sdk.Schema) (*sdk.Secret, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := sdk.NewSchemaObjectIdentifier(schema.DatabaseName, schema.Name, random.StringN(12))
+	err := client.Secrets.CreateWithGenericString(ctx, sdk.NewCreateWithGenericStringSecretRequest(id, "secret"))
+	require.NoError(t, err)
+	secret, err := client.Secrets.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return secret, func() {
+		err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id).WithIfExists(sdk.Bool(true)))
+		require.NoError(t, err)
+	}
+}
+
 func createSessionPolicy(t *testing.T, client *sdk.Client, database *sdk.Database, schema *sdk.Schema) (*sdk.SessionPolicy, func()) {
 	t.Helper()
 	id := sdk.NewSchemaObjectIdentifier(database.Name, schema.Name, random.StringN(12))
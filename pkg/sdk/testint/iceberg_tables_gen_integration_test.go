@@ -0,0 +1,148 @@
+package testint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_IcebergTables(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	databaseTest, schemaTest := testDb(t), testSchema(t)
+
+	externalVolumeId := sdk.RandomAccountObjectIdentifier()
+	err := client.ExternalVolumes.Create(ctx, sdk.NewCreateExternalVolumeRequest(externalVolumeId, []sdk.ExternalVolumeStorageLocationRequest{
+		*externalVolumeS3StorageLocation(t, "s3-location"),
+	}).WithAllowWrites(sdk.Bool(true)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ExternalVolumes.Drop(ctx, sdk.NewDropExternalVolumeRequest(externalVolumeId).WithIfExists(sdk.Bool(true)))
+		require.NoError(t, err)
+	})
+
+	tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+
+	cleanupIcebergTableHandle := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createIcebergTableHandle := func(t *testing.T) *sdk.IcebergTable {
+		t.Helper()
+
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.StringN(8))
+		columns := []sdk.IcebergTableColumnRequest{
+			*sdk.NewIcebergTableColumnRequest("id", sdk.DataTypeNumber),
+		}
+		request := sdk.NewCreateWithSnowflakeCatalogIcebergTableRequest(id, columns, id.Name()+"/").
+			WithExternalVolume(sdk.String(externalVolumeId.Name()))
+		err := client.IcebergTables.CreateWithSnowflakeCatalog(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupIcebergTableHandle(id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return icebergTable
+	}
+
+	t.Run("create iceberg table with snowflake catalog", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.StringN(8))
+		columns := []sdk.IcebergTableColumnRequest{
+			*sdk.NewIcebergTableColumnRequest("id", sdk.DataTypeNumber).WithNotNull(sdk.Bool(true)),
+			*sdk.NewIcebergTableColumnRequest("name", sdk.DataTypeString).WithComment(sdk.String("name column")),
+		}
+		request := sdk.NewCreateWithSnowflakeCatalogIcebergTableRequest(id, columns, id.Name()+"/").
+			WithExternalVolume(sdk.String(externalVolumeId.Name())).
+			WithClusterBy([]string{"id"}).
+			WithStorageSerializationPolicy(sdk.Pointer(sdk.IcebergTableStorageSerializationPolicyCompatible)).
+			WithChangeTracking(sdk.Bool(true)).
+			WithComment(sdk.String("comment")).
+			WithTag([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}})
+		err := client.IcebergTables.CreateWithSnowflakeCatalog(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupIcebergTableHandle(id))
+
+		e, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), e.Name)
+		assert.Equal(t, databaseTest.Name, e.DatabaseName)
+		assert.Equal(t, schemaTest.Name, e.SchemaName)
+		assert.Equal(t, externalVolumeId.Name(), e.ExternalVolumeName)
+		assert.Equal(t, "SNOWFLAKE", e.CatalogName)
+		assert.Equal(t, "MANAGED", e.IcebergTableType)
+		assert.Equal(t, id.Name()+"/", e.BaseLocation)
+		assert.Equal(t, "comment", e.Comment)
+
+		tagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeTable)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", tagValue)
+	})
+
+	t.Run("alter iceberg table: set and unset", func(t *testing.T) {
+		e := createIcebergTableHandle(t)
+		id := e.ID()
+
+		set := sdk.NewIcebergTableSetRequest().
+			WithDataRetentionTimeInDays(sdk.Int(2)).
+			WithComment(sdk.String("new comment"))
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		o, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", o.Comment)
+
+		unset := sdk.NewIcebergTableUnsetRequest().
+			WithDataRetentionTimeInDays(sdk.Bool(true)).
+			WithComment(sdk.Bool(true))
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+
+		o, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, o.Comment)
+	})
+
+	t.Run("alter iceberg table: set and unset tags", func(t *testing.T) {
+		e := createIcebergTableHandle(t)
+		id := e.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: "v2"}}))
+		require.NoError(t, err)
+
+		tagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeTable)
+		require.NoError(t, err)
+		assert.Equal(t, "v2", tagValue)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeTable)
+		require.Error(t, err)
+	})
+
+	t.Run("show iceberg table: with like and in", func(t *testing.T) {
+		e := createIcebergTableHandle(t)
+
+		icebergTables, err := client.IcebergTables.Show(ctx, sdk.NewShowIcebergTableRequest().
+			WithLike(&sdk.Like{Pattern: &e.Name}).
+			WithIn(&sdk.In{Schema: schemaTest.ID()}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(icebergTables))
+		require.Equal(t, *e, icebergTables[0])
+	})
+
+	t.Run("show by id: missing iceberg table", func(t *testing.T) {
+		_, err := client.IcebergTables.ShowByID(ctx, sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, "missing_"+random.StringN(4)))
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
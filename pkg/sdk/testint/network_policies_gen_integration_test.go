@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -49,6 +50,58 @@ func TestInt_NetworkPolicies(t *testing.T) {
 		assert.Equal(t, len(req.BlockedIpList), np.EntriesInBlockedIpList)
 	})
 
+	createIngressNetworkRule := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.StringN(12))
+		err := client.NetworkRules.Create(ctx, sdk.NewCreateNetworkRuleRequest(id, sdk.NetworkRuleTypeIpv4, sdk.NetworkRuleModeIngress).
+			WithValueList([]sdk.NetworkRuleValueRequest{*sdk.NewNetworkRuleValueRequest("0.0.0.0/0")}))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("Create with network rules", func(t *testing.T) {
+		allowedRuleId, blockedRuleId := createIngressNetworkRule(t), createIngressNetworkRule(t)
+		req := sdk.NewCreateNetworkPolicyRequest(sdk.RandomAccountObjectIdentifier()).
+			WithAllowedNetworkRuleList([]sdk.SchemaObjectIdentifier{allowedRuleId}).
+			WithBlockedNetworkRuleList([]sdk.SchemaObjectIdentifier{blockedRuleId})
+		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
+		require.NoError(t, err)
+		t.Cleanup(dropNetworkPolicy)
+
+		np, err := client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 1, np.EntriesInAllowedNetworkRules)
+		assert.Equal(t, 1, np.EntriesInBlockedNetworkRules)
+	})
+
+	t.Run("Alter - add and remove network rules", func(t *testing.T) {
+		ruleId := createIngressNetworkRule(t)
+		req := defaultCreateRequest()
+		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
+		require.NoError(t, err)
+		t.Cleanup(dropNetworkPolicy)
+
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(req.GetName()).
+			WithAdd(sdk.NewAddNetworkRuleRequest().WithAllowedNetworkRuleList([]sdk.SchemaObjectIdentifier{ruleId})))
+		require.NoError(t, err)
+
+		np, err := client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 1, np.EntriesInAllowedNetworkRules)
+
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(req.GetName()).
+			WithRemove(sdk.NewRemoveNetworkRuleRequest().WithAllowedNetworkRuleList([]sdk.SchemaObjectIdentifier{ruleId})))
+		require.NoError(t, err)
+
+		np, err = client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 0, np.EntriesInAllowedNetworkRules)
+	})
+
 	t.Run("Alter - set allowed ip list", func(t *testing.T) {
 		req := defaultCreateRequest()
 		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
@@ -85,6 +138,23 @@ func TestInt_NetworkPolicies(t *testing.T) {
 		assert.Equal(t, 1, np.EntriesInBlockedIpList)
 	})
 
+	t.Run("Alter - unset blocked ip list", func(t *testing.T) {
+		req := defaultCreateRequest()
+		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
+		require.NoError(t, err)
+		t.Cleanup(dropNetworkPolicy)
+
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(req.GetName()).WithUnsetBlockedIpList(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		nps, err := client.NetworkPolicies.Show(ctx, sdk.NewShowNetworkPolicyRequest())
+		require.NoError(t, err)
+
+		np, err := findNetworkPolicy(nps, req.GetName().Name())
+		require.NoError(t, err)
+		assert.Equal(t, 0, np.EntriesInBlockedIpList)
+	})
+
 	t.Run("Alter - set comment", func(t *testing.T) {
 		req := defaultCreateRequest()
 		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
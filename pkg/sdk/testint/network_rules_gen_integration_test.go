@@ -0,0 +1,93 @@
+package testint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_NetworkRules(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	databaseTest, schemaTest := testDb(t), testSchema(t)
+
+	t.Run("create network rule", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.StringN(8))
+		request := sdk.NewCreateNetworkRuleRequest(id, sdk.NetworkRuleTypeIpv4, sdk.NetworkRuleModeIngress).
+			WithValueList([]sdk.NetworkRuleValueRequest{
+				*sdk.NewNetworkRuleValueRequest("0.0.0.0/0"),
+				*sdk.NewNetworkRuleValueRequest("1.1.1.1"),
+			}).
+			WithComment(sdk.String("comment"))
+		err := client.NetworkRules.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id))
+			require.NoError(t, err)
+		})
+
+		networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), networkRule.Name)
+		assert.Equal(t, databaseTest.Name, networkRule.DatabaseName)
+		assert.Equal(t, schemaTest.Name, networkRule.SchemaName)
+		assert.Equal(t, sdk.NetworkRuleTypeIpv4, networkRule.Type)
+		assert.Equal(t, sdk.NetworkRuleModeIngress, networkRule.Mode)
+		assert.Equal(t, 2, networkRule.EntriesInValueList)
+		assert.Equal(t, "comment", networkRule.Comment)
+
+		details, err := client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0.0.0.0/0", "1.1.1.1"}, details.ValueList)
+	})
+
+	t.Run("alter network rule: set and unset", func(t *testing.T) {
+		networkRule, networkRuleCleanup := createNetworkRule(t, client, schemaTest)
+		t.Cleanup(networkRuleCleanup)
+		id := networkRule.ID()
+
+		set := sdk.NewNetworkRuleSetRequest().
+			WithValueList([]sdk.NetworkRuleValueRequest{*sdk.NewNetworkRuleValueRequest("snowflake.com:443")}).
+			WithComment(sdk.String("new comment"))
+		err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		details, err := client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"snowflake.com:443"}, details.ValueList)
+		assert.Equal(t, "new comment", details.Comment)
+
+		unset := sdk.NewNetworkRuleUnsetRequest().
+			WithValueList(sdk.Bool(true)).
+			WithComment(sdk.Bool(true))
+		err = client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+
+		details, err = client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, details.ValueList)
+		assert.Empty(t, details.Comment)
+	})
+
+	t.Run("show network rule: with like and in", func(t *testing.T) {
+		networkRule, networkRuleCleanup := createNetworkRule(t, client, schemaTest)
+		t.Cleanup(networkRuleCleanup)
+
+		networkRules, err := client.NetworkRules.Show(ctx, sdk.NewShowNetworkRuleRequest().
+			WithLike(&sdk.Like{Pattern: &networkRule.Name}).
+			WithIn(&sdk.In{Schema: schemaTest.ID()}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(networkRules))
+		require.Equal(t, *networkRule, networkRules[0])
+	})
+
+	t.Run("show by id: missing network rule", func(t *testing.T) {
+		_, err := client.NetworkRules.ShowByID(ctx, sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, "missing_"+random.StringN(4)))
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
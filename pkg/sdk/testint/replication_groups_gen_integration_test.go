@@ -0,0 +1,125 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ReplicationGroups(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+	require.NoError(t, err)
+	allowedAccounts := []sdk.AccountIdentifier{sdk.NewAccountIdentifierFromAccountLocator(currentAccount)}
+
+	createReplicationGroup := func(t *testing.T, request *sdk.CreateReplicationGroupRequest) *sdk.ReplicationGroup {
+		t.Helper()
+		err := client.ReplicationGroups.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(request.GetName()).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, request.GetName())
+		require.NoError(t, err)
+		return replicationGroup
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateReplicationGroupRequest(id, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}, allowedAccounts).
+			WithAllowedDatabases([]sdk.AccountObjectIdentifier{testDb(t).ID()}).
+			WithReplicationSchedule(sdk.String("10 MINUTE"))
+
+		replicationGroup := createReplicationGroup(t, request)
+
+		assert.Equal(t, id.Name(), replicationGroup.Name)
+		assert.True(t, replicationGroup.IsPrimary)
+		assert.Equal(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}, replicationGroup.ObjectTypes)
+		assert.Equal(t, "10 MINUTE", replicationGroup.ReplicationSchedule)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{testDb(t).ID()}, databases)
+	})
+
+	t.Run("Alter: rename", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		createReplicationGroup(t, sdk.NewCreateReplicationGroupRequest(id, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, allowedAccounts))
+
+		newID := sdk.RandomAccountObjectIdentifier()
+		err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRenameTo(&newID))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(newID).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, newID)
+		require.NoError(t, err)
+		assert.Equal(t, newID.Name(), replicationGroup.Name)
+	})
+
+	t.Run("Alter: set, add and remove allowed databases", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		createReplicationGroup(t, sdk.NewCreateReplicationGroupRequest(id, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, allowedAccounts))
+
+		err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithSet(
+			sdk.NewReplicationGroupSetRequest().
+				WithObjectTypes([]sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}).
+				WithReplicationSchedule(sdk.String("20 MINUTE")),
+		))
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(
+			sdk.NewReplicationGroupAddRequest().WithAllowedDatabases([]sdk.AccountObjectIdentifier{testDb(t).ID()}),
+		))
+		require.NoError(t, err)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{testDb(t).ID()}, databases)
+
+		err = client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRemove(
+			sdk.NewReplicationGroupRemoveRequest().WithAllowedDatabases([]sdk.AccountObjectIdentifier{testDb(t).ID()}),
+		))
+		require.NoError(t, err)
+
+		databases, err = client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, databases)
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "20 MINUTE", replicationGroup.ReplicationSchedule)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		createReplicationGroup(t, sdk.NewCreateReplicationGroupRequest(id, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, allowedAccounts))
+
+		err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ReplicationGroups.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		createReplicationGroup(t, sdk.NewCreateReplicationGroupRequest(id, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, allowedAccounts))
+
+		replicationGroups, err := client.ReplicationGroups.Show(ctx, sdk.NewShowReplicationGroupRequest())
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(replicationGroups))
+		for _, replicationGroup := range replicationGroups {
+			names = append(names, replicationGroup.Name)
+		}
+		assert.Contains(t, names, id.Name())
+	})
+}
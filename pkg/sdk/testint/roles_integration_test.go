@@ -180,7 +180,7 @@ func TestInt_Roles(t *testing.T) {
 
 		r, err := client.Roles.ShowByID(ctx, sdk.NewShowByIdRoleRequest(role.ID()))
 		require.Nil(t, r)
-		require.Error(t, err)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
 	})
 
 	t.Run("show no options", func(t *testing.T) {
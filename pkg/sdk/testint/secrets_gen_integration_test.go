@@ -0,0 +1,101 @@
+package testint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Secrets(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	databaseTest, schemaTest := testDb(t), testSchema(t)
+
+	cleanupSecretHandle := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("create secret: with basic authentication", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.StringN(8))
+		request := sdk.NewCreateWithBasicAuthenticationSecretRequest(id, "user", "password").
+			WithComment(sdk.String("comment"))
+		err := client.Secrets.CreateWithBasicAuthentication(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupSecretHandle(id))
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), secret.Name)
+		assert.Equal(t, databaseTest.Name, secret.DatabaseName)
+		assert.Equal(t, schemaTest.Name, secret.SchemaName)
+		assert.Equal(t, string(sdk.SecretTypePassword), secret.SecretType)
+		assert.Equal(t, "comment", secret.Comment)
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "user", details.Username)
+	})
+
+	t.Run("create secret: with generic string", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.StringN(8))
+		err := client.Secrets.CreateWithGenericString(ctx, sdk.NewCreateWithGenericStringSecretRequest(id, "secret"))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSecretHandle(id))
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, string(sdk.SecretTypeGenericString), secret.SecretType)
+		assert.Empty(t, secret.Comment)
+	})
+
+	t.Run("alter secret: set and unset", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.StringN(8))
+		err := client.Secrets.CreateWithBasicAuthentication(ctx, sdk.NewCreateWithBasicAuthenticationSecretRequest(id, "user", "password"))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSecretHandle(id))
+
+		set := sdk.NewSecretSetRequest().
+			WithUsername(sdk.String("new_user")).
+			WithPassword(sdk.String("new_password")).
+			WithComment(sdk.String("comment"))
+		err = client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new_user", details.Username)
+		assert.Equal(t, "comment", details.Comment)
+
+		err = client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithUnset(sdk.NewSecretUnsetRequest().WithComment(sdk.Bool(true))))
+		require.NoError(t, err)
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, secret.Comment)
+	})
+
+	t.Run("show secret: with like and in", func(t *testing.T) {
+		secret, secretCleanup := createGenericStringSecret(t, client, schemaTest)
+		t.Cleanup(secretCleanup)
+
+		secrets, err := client.Secrets.Show(ctx, sdk.NewShowSecretRequest().
+			WithLike(&sdk.Like{Pattern: &secret.Name}).
+			WithIn(&sdk.In{Schema: schemaTest.ID()}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(secrets))
+		require.Equal(t, *secret, secrets[0])
+	})
+
+	t.Run("show by id: missing secret", func(t *testing.T) {
+		_, err := client.Secrets.ShowByID(ctx, sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, "missing_"+random.StringN(4)))
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
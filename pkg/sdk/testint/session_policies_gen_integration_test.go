@@ -4,7 +4,6 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -109,7 +108,7 @@ func TestInt_SessionPolicies(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = client.SessionPolicies.ShowByID(ctx, id)
-		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
 	})
 
 	t.Run("drop session_policy: non-existing", func(t *testing.T) {
@@ -206,7 +205,7 @@ func TestInt_SessionPolicies(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = client.SessionPolicies.ShowByID(ctx, id)
-		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
 
 		sessionPolicy, err := client.SessionPolicies.ShowByID(ctx, newId)
 		require.NoError(t, err)
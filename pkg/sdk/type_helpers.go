@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"strconv"
+	"strings"
 )
 
 // String returns a pointer to the given string.
@@ -55,3 +56,20 @@ func ToFloat64(s string) float64 {
 func Pointer[K any](v K) *K {
 	return &v
 }
+
+// ParseCommaSeparatedStringArray converts a list returned by Snowflake, e.g. "[a, 'b']" or "a,b", to a slice of its items.
+func ParseCommaSeparatedStringArray(value string, trimQuotes bool) []string {
+	value = strings.TrimSpace(strings.Trim(value, "[]"))
+	if value == "" {
+		return make([]string, 0)
+	}
+	items := strings.Split(value, ",")
+	for i, item := range items {
+		item = strings.TrimSpace(item)
+		if trimQuotes {
+			item = strings.Trim(item, `'"`)
+		}
+		items[i] = item
+	}
+	return items
+}
@@ -14,6 +14,8 @@ var (
 	_ validatable = new(DropUserOptions)
 	_ validatable = new(describeUserOptions)
 	_ validatable = new(ShowUserOptions)
+	_ validatable = new(AddProgrammaticAccessTokenOptions)
+	_ validatable = new(removeProgrammaticAccessTokenOptions)
 )
 
 type Users interface {
@@ -23,6 +25,8 @@ type Users interface {
 	Describe(ctx context.Context, id AccountObjectIdentifier) (*UserDetails, error)
 	Show(ctx context.Context, opts *ShowUserOptions) ([]User, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error)
+	AddProgrammaticAccessToken(ctx context.Context, id AccountObjectIdentifier, opts *AddProgrammaticAccessTokenOptions) (*ProgrammaticAccessToken, error)
+	RemoveProgrammaticAccessToken(ctx context.Context, id AccountObjectIdentifier, name AccountObjectIdentifier) error
 }
 
 var _ Users = (*users)(nil)
@@ -596,3 +600,73 @@ func (v *users) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User
 	}
 	return nil, ErrObjectNotExistOrAuthorized
 }
+
+// AddProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-user-add-programmatic-access-token.
+type AddProgrammaticAccessTokenOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"`
+	user     bool                    `ddl:"static" sql:"USER"`
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	userName AccountObjectIdentifier `ddl:"identifier"`
+	Name     AccountObjectIdentifier `ddl:"identifier" sql:"ADD PROGRAMMATIC ACCESS TOKEN"`
+
+	RoleRestriction                      *string `ddl:"parameter,single_quotes" sql:"ROLE_RESTRICTION"`
+	DaysToExpiry                         *int    `ddl:"parameter" sql:"DAYS_TO_EXPIRY"`
+	MinsToBypassNetworkPolicyRequirement *int    `ddl:"parameter" sql:"MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT"`
+	Comment                              *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *AddProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.userName) || !ValidObjectIdentifier(opts.Name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.DaysToExpiry != nil && *opts.DaysToExpiry < 1 {
+		errs = append(errs, errIntValue("AddProgrammaticAccessTokenOptions", "DaysToExpiry", IntErrGreaterOrEqual, 1))
+	}
+	if opts.MinsToBypassNetworkPolicyRequirement != nil && *opts.MinsToBypassNetworkPolicyRequirement < 1 {
+		errs = append(errs, errIntValue("AddProgrammaticAccessTokenOptions", "MinsToBypassNetworkPolicyRequirement", IntErrGreaterOrEqual, 1))
+	}
+	return errors.Join(errs...)
+}
+
+// ProgrammaticAccessToken holds the secret of a newly added programmatic access token.
+// Snowflake returns the secret only once, when the token is added.
+type ProgrammaticAccessToken struct {
+	Name   string `db:"token_name"`
+	Secret string `db:"token_secret"`
+}
+
+func (v *users) AddProgrammaticAccessToken(ctx context.Context, id AccountObjectIdentifier, opts *AddProgrammaticAccessTokenOptions) (*ProgrammaticAccessToken, error) {
+	opts = createIfNil(opts)
+	opts.userName = id
+	return validateAndQueryOne[ProgrammaticAccessToken](v.client, ctx, opts)
+}
+
+// removeProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-user-remove-programmatic-access-token.
+type removeProgrammaticAccessTokenOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"`
+	user     bool                    `ddl:"static" sql:"USER"`
+	userName AccountObjectIdentifier `ddl:"identifier"`
+	name     AccountObjectIdentifier `ddl:"identifier" sql:"REMOVE PROGRAMMATIC ACCESS TOKEN"`
+}
+
+func (opts *removeProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.userName) || !ValidObjectIdentifier(opts.name) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *users) RemoveProgrammaticAccessToken(ctx context.Context, id AccountObjectIdentifier, name AccountObjectIdentifier) error {
+	opts := &removeProgrammaticAccessTokenOptions{
+		userName: id,
+		name:     name,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
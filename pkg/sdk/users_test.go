@@ -299,3 +299,61 @@ func TestUserDescribe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE USER %s", id.FullyQualifiedName())
 	})
 }
+
+func TestUserAddProgrammaticAccessToken(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+	tokenID := RandomAccountObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &AddProgrammaticAccessTokenOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: days to expiry", func(t *testing.T) {
+		opts := &AddProgrammaticAccessTokenOptions{
+			userName:     id,
+			Name:         tokenID,
+			DaysToExpiry: Int(0),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errIntValue("AddProgrammaticAccessTokenOptions", "DaysToExpiry", IntErrGreaterOrEqual, 1))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &AddProgrammaticAccessTokenOptions{
+			userName: id,
+			Name:     tokenID,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER USER %s ADD PROGRAMMATIC ACCESS TOKEN %s`, id.FullyQualifiedName(), tokenID.FullyQualifiedName())
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &AddProgrammaticAccessTokenOptions{
+			IfExists:                             Bool(true),
+			userName:                             id,
+			Name:                                 tokenID,
+			RoleRestriction:                      String("ANALYST"),
+			DaysToExpiry:                         Int(30),
+			MinsToBypassNetworkPolicyRequirement: Int(10),
+			Comment:                              String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER USER IF EXISTS %s ADD PROGRAMMATIC ACCESS TOKEN %s ROLE_RESTRICTION = 'ANALYST' DAYS_TO_EXPIRY = 30 MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT = 10 COMMENT = 'comment'`, id.FullyQualifiedName(), tokenID.FullyQualifiedName())
+	})
+}
+
+func TestUserRemoveProgrammaticAccessToken(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+	tokenID := RandomAccountObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &removeProgrammaticAccessTokenOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &removeProgrammaticAccessTokenOptions{
+			userName: id,
+			name:     tokenID,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER USER %s REMOVE PROGRAMMATIC ACCESS TOKEN %s`, id.FullyQualifiedName(), tokenID.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,211 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	// tokenWord is a keyword or an unquoted identifier.
+	tokenWord
+	tokenQuotedIdentifier
+	// tokenString is a single quoted or dollar quoted string literal.
+	tokenString
+	tokenNumber
+	// tokenSymbol is any other single character, like a parenthesis, a comma or an operator.
+	tokenSymbol
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of input"
+	case tokenWord:
+		return "word"
+	case tokenQuotedIdentifier:
+		return "quoted identifier"
+	case tokenString:
+		return "string"
+	case tokenNumber:
+		return "number"
+	default:
+		return "symbol"
+	}
+}
+
+type token struct {
+	kind tokenKind
+	// text is the token as it appears in the input.
+	text string
+	// value is the unquoted and unescaped text of strings and quoted identifiers, and the text of the other tokens.
+	value string
+	// start and end are the byte offsets of the token in the input.
+	start int
+	end   int
+}
+
+// is returns true if the token is the given keyword. Keywords are case-insensitive.
+func (t token) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) isSymbol(symbol string) bool {
+	return t.kind == tokenSymbol && t.text == symbol
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return t.kind.String()
+	}
+	return fmt.Sprintf("%s %q at offset %d", t.kind, t.text, t.start)
+}
+
+// lexer splits Snowflake SQL into tokens. Whitespace and comments (--, // and /* */) are skipped.
+// The tokens are read one at a time, so the parser can stop at any point and take the rest of the input as is.
+type lexer struct {
+	input string
+	pos   int
+}
+
+func newLexer(input string) *lexer {
+	return &lexer{input: input}
+}
+
+func (l *lexer) peekRune(offset int) rune {
+	if l.pos+offset >= len(l.input) {
+		return utf8.RuneError
+	}
+	r, _ := utf8.DecodeRuneInString(l.input[l.pos+offset:])
+	return r
+}
+
+func (l *lexer) skipSpaceAndComments() error {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		switch {
+		case unicode.IsSpace(r):
+			l.pos += size
+		case strings.HasPrefix(l.input[l.pos:], "--"), strings.HasPrefix(l.input[l.pos:], "//"):
+			end := strings.IndexByte(l.input[l.pos:], '\n')
+			if end == -1 {
+				l.pos = len(l.input)
+			} else {
+				l.pos += end + 1
+			}
+		case strings.HasPrefix(l.input[l.pos:], "/*"):
+			end := strings.Index(l.input[l.pos+2:], "*/")
+			if end == -1 {
+				return fmt.Errorf("unterminated comment at offset %d", l.pos)
+			}
+			l.pos += end + 4
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (l *lexer) next() (token, error) {
+	if err := l.skipSpaceAndComments(); err != nil {
+		return token{}, err
+	}
+	start := l.pos
+	if start >= len(l.input) {
+		return token{kind: tokenEOF, start: start, end: start}, nil
+	}
+
+	r, size := utf8.DecodeRuneInString(l.input[start:])
+	var (
+		kind  tokenKind
+		value string
+		err   error
+	)
+	switch {
+	case r == '\'':
+		kind = tokenString
+		value, err = l.readQuoted('\'', true)
+	case r == '"':
+		kind = tokenQuotedIdentifier
+		value, err = l.readQuoted('"', false)
+	case r == '$' && l.peekRune(1) == '$':
+		kind = tokenString
+		end := strings.Index(l.input[start+2:], "$$")
+		if end == -1 {
+			return token{}, fmt.Errorf("unterminated string at offset %d", start)
+		}
+		value = l.input[start+2 : start+2+end]
+		l.pos = start + 2 + end + 2
+	case unicode.IsLetter(r) || r == '_':
+		kind = tokenWord
+		l.readWhile(func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$' })
+	case unicode.IsDigit(r):
+		kind = tokenNumber
+		l.readWhile(func(r rune) bool { return unicode.IsDigit(r) || r == '.' })
+	default:
+		kind = tokenSymbol
+		l.pos += size
+	}
+	if err != nil {
+		return token{}, err
+	}
+
+	text := l.input[start:l.pos]
+	if kind != tokenString && kind != tokenQuotedIdentifier {
+		value = text
+	}
+	return token{kind: kind, text: text, value: value, start: start, end: l.pos}, nil
+}
+
+func (l *lexer) readWhile(f func(rune) bool) {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if !f(r) {
+			return
+		}
+		l.pos += size
+	}
+}
+
+// readQuoted reads the text between the quotes starting at the current position. The quote is escaped by doubling it,
+// and, when backslashEscapes is set, by a backslash, as in Snowflake string literals.
+func (l *lexer) readQuoted(quote byte, backslashEscapes bool) (string, error) {
+	start := l.pos
+	var value strings.Builder
+	for i := start + 1; i < len(l.input); i++ {
+		c := l.input[i]
+		switch {
+		case backslashEscapes && c == '\\' && i+1 < len(l.input):
+			i++
+			value.WriteByte(unescapeCharacter(l.input[i]))
+		case c == quote && i+1 < len(l.input) && l.input[i+1] == quote:
+			i++
+			value.WriteByte(quote)
+		case c == quote:
+			l.pos = i + 1
+			return value.String(), nil
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated %c quote at offset %d", quote, start)
+}
+
+func unescapeCharacter(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	case '0':
+		return 0
+	default:
+		return c
+	}
+}
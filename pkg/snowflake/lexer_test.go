@@ -0,0 +1,100 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLexer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []token
+	}{
+		{"empty", "", nil},
+		{"whitespace only", " \t\n ", nil},
+		{"words", "create VIEW foo_1$", []token{
+			{kind: tokenWord, text: "create", value: "create", start: 0, end: 6},
+			{kind: tokenWord, text: "VIEW", value: "VIEW", start: 7, end: 11},
+			{kind: tokenWord, text: "foo_1$", value: "foo_1$", start: 12, end: 18},
+		}},
+		{"qualified name", `"db".s."v"`, []token{
+			{kind: tokenQuotedIdentifier, text: `"db"`, value: "db", start: 0, end: 4},
+			{kind: tokenSymbol, text: ".", value: ".", start: 4, end: 5},
+			{kind: tokenWord, text: "s", value: "s", start: 5, end: 6},
+			{kind: tokenSymbol, text: ".", value: ".", start: 6, end: 7},
+			{kind: tokenQuotedIdentifier, text: `"v"`, value: "v", start: 7, end: 10},
+		}},
+		{"quoted identifier with doubled quote", `"a""b c"`, []token{
+			{kind: tokenQuotedIdentifier, text: `"a""b c"`, value: `a"b c`, start: 0, end: 8},
+		}},
+		{"string with backslash escapes", `'it\'s\n\\'`, []token{
+			{kind: tokenString, text: `'it\'s\n\\'`, value: "it's\n\\", start: 0, end: 11},
+		}},
+		{"string with doubled quote", `'it''s'`, []token{
+			{kind: tokenString, text: `'it''s'`, value: "it's", start: 0, end: 7},
+		}},
+		{"dollar quoted string", `$$it's 'as'$$`, []token{
+			{kind: tokenString, text: `$$it's 'as'$$`, value: "it's 'as'", start: 0, end: 13},
+		}},
+		{"number and symbols", "(1.5,=)", []token{
+			{kind: tokenSymbol, text: "(", value: "(", start: 0, end: 1},
+			{kind: tokenNumber, text: "1.5", value: "1.5", start: 1, end: 4},
+			{kind: tokenSymbol, text: ",", value: ",", start: 4, end: 5},
+			{kind: tokenSymbol, text: "=", value: "=", start: 5, end: 6},
+			{kind: tokenSymbol, text: ")", value: ")", start: 6, end: 7},
+		}},
+		{"comments", "a -- as\nb // as\nc /* as\n */ d --", []token{
+			{kind: tokenWord, text: "a", value: "a", start: 0, end: 1},
+			{kind: tokenWord, text: "b", value: "b", start: 8, end: 9},
+			{kind: tokenWord, text: "c", value: "c", start: 16, end: 17},
+			{kind: tokenWord, text: "d", value: "d", start: 28, end: 29},
+		}},
+		{"unicode", "zażółć 'gęś'", []token{
+			{kind: tokenWord, text: "zażółć", value: "zażółć", start: 0, end: 10},
+			{kind: tokenString, text: "'gęś'", value: "gęś", start: 11, end: 18},
+		}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLexer(tt.input)
+			var got []token
+			for {
+				tok, err := l.next()
+				require.NoError(t, err)
+				if tok.kind == tokenEOF {
+					break
+				}
+				got = append(got, tok)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLexer_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"unterminated string", "a 'b", "unterminated ' quote at offset 2"},
+		{"unterminated escaped string", `'b\'`, "unterminated ' quote at offset 0"},
+		{"unterminated quoted identifier", `"b""`, `unterminated " quote at offset 0`},
+		{"unterminated dollar quoted string", "$$b$", "unterminated string at offset 0"},
+		{"unterminated comment", "a /* b", "unterminated comment at offset 2"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLexer(tt.input)
+			var err error
+			for tok := (token{kind: tokenWord}); err == nil && tok.kind != tokenEOF; {
+				tok, err = l.next()
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
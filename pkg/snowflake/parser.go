@@ -2,187 +2,493 @@ package snowflake
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"unicode"
 )
 
-// ViewSelectStatementExtractor is a simplistic parser that only exists to extract the select
-// statement from a create view statement
-//
-// The implementation is optimized for undertandable and predictable behavior. So far we only seek
-// to support queries of the sort that are generated by this project.
-//
-// Also there is little error handling and we assume queries are well-formed.
+// ViewDefinition is a CREATE VIEW or CREATE MATERIALIZED VIEW statement, as returned in the text column of
+// SHOW VIEWS and SHOW MATERIALIZED VIEWS.
+type ViewDefinition struct {
+	// Warehouse is set when the statement is preceded by USE WAREHOUSE, as for the materialized views created by this project.
+	Warehouse    string
+	OrReplace    bool
+	OrAlter      bool
+	Secure       bool
+	Temporary    bool
+	Recursive    bool
+	Materialized bool
+	IfNotExists  bool
+	// Name is the name of the view as it appears in the statement, e.g. "db"."schema"."view".
+	Name               string
+	Columns            []ViewColumn
+	CopyGrants         bool
+	Comment            *string
+	ChangeTracking     *bool
+	DataMetricSchedule *string
+	RowAccessPolicy    *ViewRowAccessPolicy
+	AggregationPolicy  *ViewAggregationPolicy
+	Tags               []ViewTag
+	Contacts           []ViewContact
+	// ClusterBy are the expressions of the CLUSTER BY clause of a materialized view.
+	ClusterBy []string
+	// Statement is the query of the view: everything after AS, comments included.
+	Statement string
+}
+
+type ViewColumn struct {
+	// Name is the name of the column, without the quotes of quoted identifiers.
+	Name             string
+	Comment          *string
+	MaskingPolicy    *ViewColumnMaskingPolicy
+	ProjectionPolicy string
+	Tags             []ViewTag
+}
+
+type ViewColumnMaskingPolicy struct {
+	Name  string
+	Using []string
+}
+
+type ViewRowAccessPolicy struct {
+	Name string
+	On   []string
+}
+
+type ViewAggregationPolicy struct {
+	Name      string
+	EntityKey []string
+}
+
+type ViewTag struct {
+	Name  string
+	Value string
+}
+
+type ViewContact struct {
+	Purpose string
+	// Name is the name of the contact as it appears in the statement, e.g. db.schema.contact.
+	Name string
+}
+
+// ParseViewDefinition parses the statement creating a view up to the AS keyword, and returns the rest of it as
+// the query of the view. Comments and string literals are handled everywhere, so a COMMENT containing AS, for example,
+// does not end the clauses early.
+func ParseViewDefinition(input string) (*ViewDefinition, error) {
+	p := &viewParser{lexer: newLexer(input)}
+	definition := p.parse()
+	if p.err != nil {
+		return nil, p.err
+	}
+	return definition, nil
+}
+
+// ViewSelectStatementExtractor extracts the select statement from a create view statement.
 type ViewSelectStatementExtractor struct {
-	input []rune
-	pos   int
+	input string
 }
 
 func NewViewSelectStatementExtractor(input string) *ViewSelectStatementExtractor {
 	return &ViewSelectStatementExtractor{
-		input: []rune(input),
+		input: input,
 	}
 }
 
 func (e *ViewSelectStatementExtractor) Extract() (string, error) {
-	fmt.Printf("[DEBUG] extracting view query %s\n", string(e.input))
-	e.consumeSpace()
-	e.consumeToken("create")
-	e.consumeSpace()
-	e.consumeToken("or replace")
-	e.consumeSpace()
-	e.consumeToken("secure")
-	e.consumeSpace()
-	e.consumeToken("recursive")
-	e.consumeSpace()
-	e.consumeToken("view")
-	e.consumeSpace()
-	e.consumeToken("if not exists")
-	e.consumeSpace()
-	e.consumeID()
-	// TODO column list
-	e.consumeSpace()
-	e.consumeToken("copy grants")
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeToken("as")
-	e.consumeSpace()
-
-	return string(e.input[e.pos:]), nil
+	log.Printf("[DEBUG] extracting view query %s\n", e.input)
+	return e.extract()
 }
 
 func (e *ViewSelectStatementExtractor) ExtractMaterializedView() (string, error) {
-	fmt.Printf("[DEBUG] extracting materialized view query: %s\n", string(e.input))
-	e.consumeSpace()
-	e.consumeToken("use warehouse")
-	e.consumeSpace()
-	e.consumeNonSpace() // warehouse name
-	e.consumeSpace()
-	e.consumeToken("create")
-	e.consumeSpace()
-	e.consumeToken("or replace")
-	e.consumeSpace()
-	e.consumeToken("secure")
-	e.consumeSpace()
-	e.consumeToken("materialized view")
-	e.consumeSpace()
-	e.consumeToken("if not exists")
-	e.consumeSpace()
-	e.consumeID()
-	// TODO copy grants
-	// TODO column list
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeToken("cluster by")
-	e.consumeSpace()
-	e.consumeClusterBy()
-	e.consumeSpace()
-	e.consumeToken("as")
-	e.consumeSpace()
-
-	return string(e.input[e.pos:]), nil
-}
-
-// consumeToken will move e.pos forward iff the token is the next part of the input. Comparison is
-// case-insensitive. Will return true if consumed.
-func (e *ViewSelectStatementExtractor) consumeToken(t string) bool {
-	found := 0
-	for i, r := range t {
-		// it is annoying that we have to convert the runes back to strings to do a case-insensitive
-		// comparison. Hopefully I am just missing something in the docs.
-		if e.pos+i > len(e.input) || !strings.EqualFold(string(r), string(e.input[e.pos+i])) {
-			break
+	log.Printf("[DEBUG] extracting materialized view query: %s\n", e.input)
+	return e.extract()
+}
+
+// extract returns the input as is when it is not a CREATE statement, e.g. when the text is empty because the role
+// does not own the secure view. It does not fail on the definitions the parser does not understand, e.g. with clauses
+// added to Snowflake after it, so that reading the view does not fail either: the query is then taken after the first
+// AS outside parentheses, or the input is returned as is when there is no such AS.
+func (e *ViewSelectStatementExtractor) extract() (string, error) {
+	first, err := newLexer(e.input).next()
+	if err != nil || !(first.is("create") || first.is("use")) {
+		return e.input, nil
+	}
+	definition, err := ParseViewDefinition(e.input)
+	if err == nil {
+		return definition.Statement, nil
+	}
+	if statement, ok := lenientStatement(e.input); ok {
+		log.Printf("[WARN] unable to parse view definition, taking the query after the first AS: %s", err)
+		return statement, nil
+	}
+	log.Printf("[WARN] unable to parse view definition, keeping it as is: %s", err)
+	return e.input, nil
+}
+
+// lenientStatement returns the input after the first AS outside parentheses. Comments and string literals are skipped,
+// so the AS in a comment or a tag value does not end the clauses early.
+func lenientStatement(input string) (string, bool) {
+	l := newLexer(input)
+	depth := 0
+	for {
+		t, err := l.next()
+		if err != nil || t.kind == tokenEOF {
+			return "", false
+		}
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			depth--
+		case depth == 0 && t.is("as"):
+			return strings.TrimLeftFunc(input[t.end:], unicode.IsSpace), true
 		}
-		found++
 	}
+}
+
+// viewParser is a recursive descent parser of ViewDefinition. The first error is kept in err and ends the parsing;
+// the helpers do nothing once it is set, so the grammar reads without error checks after every token.
+type viewParser struct {
+	lexer  *lexer
+	peeked *token
+	err    error
+}
+
+func (p *viewParser) fail(format string, args ...any) {
+	if p.err == nil {
+		p.err = fmt.Errorf(format, args...)
+	}
+}
 
-	if found == len(t) {
-		e.pos += len(t)
+func (p *viewParser) peek() token {
+	if p.err != nil {
+		return token{kind: tokenEOF, start: len(p.lexer.input), end: len(p.lexer.input)}
+	}
+	if p.peeked == nil {
+		t, err := p.lexer.next()
+		if err != nil {
+			p.err = err
+			return p.peek()
+		}
+		p.peeked = &t
+	}
+	return *p.peeked
+}
+
+func (p *viewParser) next() token {
+	t := p.peek()
+	p.peeked = nil
+	return t
+}
+
+func (p *viewParser) accept(keyword string) bool {
+	if p.peek().is(keyword) {
+		p.next()
 		return true
 	}
 	return false
 }
 
-func (e *ViewSelectStatementExtractor) consumeSpace() {
-	found := 0
-	for {
-		if e.pos+found > len(e.input)-1 || !unicode.IsSpace(e.input[e.pos+found]) {
-			break
+func (p *viewParser) acceptSymbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *viewParser) expect(keywords ...string) {
+	for _, keyword := range keywords {
+		if !p.accept(keyword) {
+			p.fail("expected %s, got %s", strings.ToUpper(keyword), p.peek())
+			return
 		}
-		found++
 	}
-	e.pos += found
 }
 
-func (e *ViewSelectStatementExtractor) consumeID() {
-	e.consumeNonSpace()
+func (p *viewParser) expectSymbol(symbol string) {
+	if !p.acceptSymbol(symbol) {
+		p.fail("expected %q, got %s", symbol, p.peek())
+	}
 }
 
-func (e *ViewSelectStatementExtractor) consumeNonSpace() {
-	found := 0
-	for {
-		if e.pos+found > len(e.input)-1 || unicode.IsSpace(e.input[e.pos+found]) {
+func (p *viewParser) identifierToken() token {
+	t := p.next()
+	// AS is reserved, so it cannot be an unquoted identifier.
+	if (t.kind != tokenWord && t.kind != tokenQuotedIdentifier) || t.is("as") {
+		p.fail("expected identifier, got %s", t)
+	}
+	return t
+}
+
+func (p *viewParser) identifier() string {
+	return p.identifierToken().value
+}
+
+// objectName returns the possibly qualified name as it appears in the input.
+func (p *viewParser) objectName() string {
+	first := p.identifierToken()
+	last := first
+	for p.acceptSymbol(".") {
+		last = p.identifierToken()
+	}
+	if p.err != nil {
+		return ""
+	}
+	return p.lexer.input[first.start:last.end]
+}
+
+func (p *viewParser) stringLiteral() string {
+	t := p.next()
+	if t.kind != tokenString {
+		p.fail("expected string, got %s", t)
+	}
+	return t.value
+}
+
+func (p *viewParser) boolean() bool {
+	t := p.next()
+	switch {
+	case t.is("true"):
+		return true
+	case t.is("false"):
+		return false
+	default:
+		p.fail("expected TRUE or FALSE, got %s", t)
+		return false
+	}
+}
+
+// identifierList parses a parenthesized, comma separated list of identifiers.
+func (p *viewParser) identifierList() []string {
+	var identifiers []string
+	p.expectSymbol("(")
+	for p.err == nil {
+		identifiers = append(identifiers, p.identifier())
+		if !p.acceptSymbol(",") {
 			break
 		}
-		found++
 	}
-	e.pos += found
+	p.expectSymbol(")")
+	return identifiers
 }
 
-func (e *ViewSelectStatementExtractor) consumeComment() {
-	if c := e.consumeToken("comment"); !c {
-		return
+// expressionList parses a parenthesized, comma separated list of expressions and returns them as they appear in the input.
+func (p *viewParser) expressionList() []string {
+	var expressions []string
+	p.expectSymbol("(")
+	for p.err == nil {
+		start, end, depth := p.peek().start, -1, 0
+		for p.err == nil {
+			t := p.peek()
+			if t.kind == tokenEOF || (depth == 0 && (t.isSymbol(",") || t.isSymbol(")"))) {
+				break
+			}
+			if t.isSymbol("(") {
+				depth++
+			} else if t.isSymbol(")") {
+				depth--
+			}
+			end = p.next().end
+		}
+		if end == -1 {
+			p.fail("expected expression, got %s", p.peek())
+			break
+		}
+		expressions = append(expressions, p.lexer.input[start:end])
+		if !p.acceptSymbol(",") {
+			break
+		}
 	}
+	p.expectSymbol(")")
+	return expressions
+}
 
-	e.consumeSpace()
+func (p *viewParser) tags() []ViewTag {
+	var tags []ViewTag
+	p.expectSymbol("(")
+	for p.err == nil {
+		tag := ViewTag{Name: p.objectName()}
+		p.expectSymbol("=")
+		tag.Value = p.stringLiteral()
+		tags = append(tags, tag)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	p.expectSymbol(")")
+	return tags
+}
 
-	if c := e.consumeToken("="); !c {
-		return
+func (p *viewParser) parse() *ViewDefinition {
+	d := &ViewDefinition{}
+	if p.accept("use") {
+		p.expect("warehouse")
+		d.Warehouse = p.objectName()
+		p.acceptSymbol(";")
 	}
 
-	e.consumeSpace()
+	p.expect("create")
+	if p.accept("or") {
+		if p.accept("alter") {
+			d.OrAlter = true
+		} else {
+			p.expect("replace")
+			d.OrReplace = true
+		}
+	}
+	d.Secure = p.accept("secure")
+	scoped := p.accept("local") || p.accept("global")
+	d.Temporary = p.accept("temp") || p.accept("temporary") || p.accept("volatile")
+	if scoped && !d.Temporary {
+		p.fail("expected TEMPORARY, got %s", p.peek())
+	}
+	d.Recursive = p.accept("recursive")
+	d.Materialized = p.accept("materialized")
+	p.expect("view")
+	if p.accept("if") {
+		p.expect("not", "exists")
+		d.IfNotExists = true
+	}
+	d.Name = p.objectName()
+	if p.peek().isSymbol("(") {
+		d.Columns = p.columns()
+	}
 
-	if c := e.consumeToken("'"); !c {
-		return
+	for p.err == nil {
+		t := p.next()
+		switch {
+		case t.is("as"):
+			// The query is taken as is, so it is not lexed: only the leading whitespace is dropped.
+			d.Statement = strings.TrimLeftFunc(p.lexer.input[t.end:], unicode.IsSpace)
+			return d
+		case t.is("copy"):
+			p.expect("grants")
+			d.CopyGrants = true
+		case t.is("comment"):
+			p.acceptSymbol("=")
+			comment := p.stringLiteral()
+			d.Comment = &comment
+		case t.is("change_tracking"):
+			p.expectSymbol("=")
+			changeTracking := p.boolean()
+			d.ChangeTracking = &changeTracking
+		case t.is("data_metric_schedule"):
+			p.expectSymbol("=")
+			schedule := p.stringLiteral()
+			d.DataMetricSchedule = &schedule
+		case t.is("cluster"):
+			p.expect("by")
+			d.ClusterBy = p.expressionList()
+		case t.is("with"):
+			if !p.withClause(d) {
+				p.fail("expected ROW ACCESS POLICY, AGGREGATION POLICY, TAG or CONTACT, got %s", p.peek())
+			}
+		case t.kind == tokenEOF:
+			p.fail("expected AS, got %s", t)
+		default:
+			// The clauses can be written without WITH too.
+			p.peeked = &t
+			if !p.withClause(d) {
+				p.fail("unexpected %s", t)
+			}
+		}
 	}
+	return d
+}
 
-	found := 0
-	escaped := false
-	for {
-		if e.pos+found > len(e.input)-1 {
-			break
+// withClause parses the row access policy, aggregation policy, tag or contact clause of the view,
+// and returns false if the next token does not start any of them.
+func (p *viewParser) withClause(d *ViewDefinition) bool {
+	switch {
+	case p.accept("row"):
+		p.expect("access", "policy")
+		policy := &ViewRowAccessPolicy{Name: p.objectName()}
+		p.expect("on")
+		policy.On = p.identifierList()
+		d.RowAccessPolicy = policy
+	case p.accept("aggregation"):
+		p.expect("policy")
+		policy := &ViewAggregationPolicy{Name: p.objectName()}
+		if p.accept("entity") {
+			p.expect("key")
+			policy.EntityKey = p.identifierList()
 		}
+		d.AggregationPolicy = policy
+	case p.accept("tag"):
+		d.Tags = append(d.Tags, p.tags()...)
+	case p.accept("contact"):
+		d.Contacts = append(d.Contacts, p.contacts()...)
+	default:
+		return false
+	}
+	return true
+}
 
-		if escaped { //nolint:gocritic // todo: please fix this to pass gocritic
-			escaped = false
-		} else if e.input[e.pos+found] == '\\' {
-			escaped = true
-		} else if e.input[e.pos+found] == '\'' {
+func (p *viewParser) contacts() []ViewContact {
+	var contacts []ViewContact
+	p.expectSymbol("(")
+	for p.err == nil {
+		contact := ViewContact{Purpose: p.identifier()}
+		p.expectSymbol("=")
+		contact.Name = p.objectName()
+		contacts = append(contacts, contact)
+		if !p.acceptSymbol(",") {
 			break
 		}
-		found++
 	}
-	e.pos += found
+	p.expectSymbol(")")
+	return contacts
+}
 
-	if !e.consumeToken("'") {
-		return
+func (p *viewParser) columns() []ViewColumn {
+	var columns []ViewColumn
+	p.expectSymbol("(")
+	for p.err == nil {
+		column := ViewColumn{Name: p.identifier()}
+		p.columnClauses(&column)
+		columns = append(columns, column)
+		if !p.acceptSymbol(",") {
+			break
+		}
 	}
+	p.expectSymbol(")")
+	return columns
 }
 
-func (e *ViewSelectStatementExtractor) consumeClusterBy() {
-	if e.input[e.pos] != '(' {
-		return
+// columnClauses parses the comment, masking policy, projection policy and tag clauses of the column.
+func (p *viewParser) columnClauses(column *ViewColumn) {
+	for p.err == nil {
+		if !p.columnClause(column) {
+			return
+		}
 	}
-	found := 1
-	for {
-		if e.pos+found > len(e.input)-1 || e.input[e.pos+found-1] == ')' {
-			break
+}
+
+func (p *viewParser) columnClause(column *ViewColumn) bool {
+	with := p.accept("with")
+	switch {
+	case !with && p.accept("comment"):
+		p.acceptSymbol("=")
+		comment := p.stringLiteral()
+		column.Comment = &comment
+	case p.accept("masking"):
+		p.expect("policy")
+		policy := &ViewColumnMaskingPolicy{Name: p.objectName()}
+		if p.accept("using") {
+			policy.Using = p.identifierList()
 		}
-		found++
+		column.MaskingPolicy = policy
+	case p.accept("projection"):
+		p.expect("policy")
+		column.ProjectionPolicy = p.objectName()
+	case p.accept("tag"):
+		column.Tags = append(column.Tags, p.tags()...)
+	case with:
+		p.fail("expected MASKING POLICY, PROJECTION POLICY or TAG, got %s", p.peek())
+		return false
+	default:
+		return false
 	}
-	e.pos += found
+	return true
 }
@@ -1,8 +1,9 @@
 package snowflake
 
 import (
-	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestViewSelectStatementExtractor_Extract(t *testing.T) {
@@ -55,6 +56,41 @@ from bar;`
 		{"commentEscape", args{commentEscape}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
 		{"full", args{full}, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", false},
+		{"columnList", args{"create view foo (a, b) as select 1, 2;"}, "select 1, 2;", false},
+		{"columnListWithComments", args{"create view foo (a comment 'id as text', b) as select 1, 2;"}, "select 1, 2;", false},
+		{"columnListWithMaskingPolicy", args{"create view foo (a with masking policy p using (a, b), b) as select a, b from bar;"}, "select a, b from bar;", false},
+		{"withClause", args{"create view foo as with cte as (select 1 as a) select a from cte;"}, "with cte as (select 1 as a) select a from cte;", false},
+		{"recursiveWithColumns", args{"create recursive view foo (id, parent_id) as with recursive tree as (select id, parent_id from bar) select * from tree;"}, "with recursive tree as (select id, parent_id from bar) select * from tree;", false},
+		{"rowAccessPolicy", args{"create view foo with row access policy db.s.p on (a) as select a from bar;"}, "select a from bar;", false},
+		{"rowAccessPolicyWithoutWith", args{"create view foo row access policy p on (a, b) as select a, b from bar;"}, "select a, b from bar;", false},
+		{"aggregationPolicy", args{"create view foo with aggregation policy p entity key (a) as select a from bar;"}, "select a from bar;", false},
+		{"tags", args{"create view foo with tag (db.s.t = 'v', t2 = 'it''s') as select * from bar;"}, "select * from bar;", false},
+		{"changeTracking", args{"create view foo change_tracking = true as select * from bar;"}, "select * from bar;", false},
+		{"commentWithAs", args{"create view foo comment = 'foo as bar' as select * from bar;"}, "select * from bar;", false},
+		{"commentDoubledQuote", args{"create view foo comment = 'asdf''s as' as select * from bar;"}, "select * from bar;", false},
+		{"commentDollarQuoted", args{"create view foo comment = $$it's as$$ as select * from bar;"}, "select * from bar;", false},
+		{"commentBeforeCopyGrants", args{"create view foo comment = 'c' copy grants as select * from bar;"}, "select * from bar;", false},
+		{"lineCommentInHeader", args{"create view foo -- select as\nas select * from bar;"}, "select * from bar;", false},
+		{"blockCommentInHeader", args{"create /* secure as */ view foo as select * from bar;"}, "select * from bar;", false},
+		{"quotedIdentifierWithAs", args{`create view "my as view" as select 1;`}, "select 1;", false},
+		{"quotedIdentifierWithQuote", args{`create view "db"."s"."a""b" as select 1;`}, "select 1;", false},
+		{"unicodeIdentifier", args{`create view "zażółć" as select 'gęślą' as jaźń;`}, "select 'gęślą' as jaźń;", false},
+		{"temporary", args{"create or replace local temporary view foo as select * from bar;"}, "select * from bar;", false},
+		{"whitespace", args{"CREATE\tOR  REPLACE\n SECURE   VIEW foo\n\tAS\n\n  SELECT 1"}, "SELECT 1", false},
+		{"allClauses", args{`create or replace secure recursive view if not exists "db"."s"."v" (a comment 'x', b with tag (t = 'v')) with row access policy p on (a) with tag (t = 'v') copy grants comment = 'as' change_tracking = false as select a, b from bar`}, "select a, b from bar", false},
+		{"orAlter", args{"create or alter view foo as select * from bar;"}, "select * from bar;", false},
+		{"dataMetricSchedule", args{"create view foo data_metric_schedule = 'USING CRON 0 8 * * * UTC' as select * from bar;"}, "select * from bar;", false},
+		{"contact", args{"create view foo with contact (steward = db.s.c, support = \"as\") as select * from bar;"}, "select * from bar;", false},
+		{"notCreate", args{"select * from bar;"}, "select * from bar;", false},
+		{"empty", args{""}, "", false},
+		// the definitions the parser does not understand fall back to the text after the first AS outside parentheses
+		{"notView", args{"create table foo as select * from bar;"}, "select * from bar;", false},
+		{"unknownClause", args{"create view foo with something (x = 'as') as select * from bar;"}, "select * from bar;", false},
+		{"localWithoutTemporary", args{"create local view foo as select * from bar;"}, "select * from bar;", false},
+		// or to the text as is, when there is no such AS
+		{"noAs", args{"create view foo"}, "create view foo", false},
+		{"unterminatedComment", args{"create view foo comment = 'c as select * from bar;"}, "create view foo comment = 'c as select * from bar;", false},
+		{"unterminatedColumnList", args{"create view foo (a, as select * from bar;"}, "create view foo (a, as select * from bar;", false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -120,6 +156,15 @@ from bar;`
 		{"clusterBy", args{clusterBy}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
 		{"full", args{full}, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", false},
+		{"clusterByExpressions", args{"create materialized view foo cluster by (date_trunc('day', ts), to_char(c2, 'a,b')) as select * from bar;"}, "select * from bar;", false},
+		{"useWarehouse", args{`USE WAREHOUSE "wh";CREATE MATERIALIZED VIEW "db"."s"."mv" AS SELECT 1`}, "SELECT 1", false},
+		{"copyGrants", args{"create or replace materialized view foo copy grants as select * from bar;"}, "select * from bar;", false},
+		{"columnList", args{"create materialized view foo (a comment 'x', b) cluster by (a) as select a, b from bar;"}, "select a, b from bar;", false},
+		{"rowAccessPolicy", args{"create materialized view foo with row access policy p on (a) as select a from bar;"}, "select a from bar;", false},
+		{"tags", args{"create materialized view foo with tag (t = 'as') as select * from bar;"}, "select * from bar;", false},
+		{"commentWithAs", args{"create materialized view foo comment = 'a as b' cluster by (c1) as select * from bar;"}, "select * from bar;", false},
+		{"unterminatedClusterBy", args{"create materialized view foo cluster by (c1 as select * from bar;"}, "create materialized view foo cluster by (c1 as select * from bar;", false},
+		{"emptyClusterBy", args{"create materialized view foo cluster by () as select * from bar;"}, "select * from bar;", false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -137,126 +182,87 @@ from bar;`
 	}
 }
 
-func TestViewSelectStatementExtractor_consumeToken(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	type args struct {
-		t string
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		args     args
-		posAfter int
-	}{
-		{"basic - found", fields{[]rune("foo"), 0}, args{"foo"}, 3},
-		{"basic - not found", fields{[]rune("foo"), 0}, args{"bar"}, 0},
-		{"basic - not found", fields{[]rune("fob"), 0}, args{"foo"}, 0},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeToken(tt.args.t)
-
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
-		})
-	}
-}
+func TestParseViewDefinition(t *testing.T) {
+	columnComment := "first"
+	comment := "as"
+	changeTracking := false
+	dataMetricSchedule := "TRIGGER_ON_CHANGES"
+	input := `USE WAREHOUSE wh; create or replace secure materialized view if not exists "db"."s"."v" (
+	"a" comment 'first' with masking policy db.s.mp using (a, b),
+	b with projection policy pp with tag (t1 = 'x', t2 = 'y')
+)
+copy grants
+comment = 'as'
+change_tracking = false
+data_metric_schedule = 'TRIGGER_ON_CHANGES'
+with row access policy rap on (a)
+with aggregation policy ap entity key (a, b)
+with tag (db.s.t = 'it''s')
+with contact (steward = db.s.c)
+cluster by (a, upper(b))
+as
+-- the query
+select a, b from bar`
 
-func TestViewSelectStatementExtractor_consumeSpace(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		posAfter int
-	}{
-		{"simple", fields{[]rune("   foo"), 0}, 3},
-		{"empty", fields{[]rune(""), 0}, 0},
-		{"middle", fields{[]rune("foo \t\n bar"), 3}, 7},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			fmt.Println(tt.name)
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeSpace()
+	definition, err := ParseViewDefinition(input)
 
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
-		})
-	}
+	require.NoError(t, err)
+	require.Equal(t, &ViewDefinition{
+		Warehouse:    "wh",
+		OrReplace:    true,
+		Secure:       true,
+		Materialized: true,
+		IfNotExists:  true,
+		Name:         `"db"."s"."v"`,
+		Columns: []ViewColumn{
+			{Name: "a", Comment: &columnComment, MaskingPolicy: &ViewColumnMaskingPolicy{Name: "db.s.mp", Using: []string{"a", "b"}}},
+			{Name: "b", ProjectionPolicy: "pp", Tags: []ViewTag{{Name: "t1", Value: "x"}, {Name: "t2", Value: "y"}}},
+		},
+		CopyGrants:         true,
+		Comment:            &comment,
+		ChangeTracking:     &changeTracking,
+		DataMetricSchedule: &dataMetricSchedule,
+		RowAccessPolicy:    &ViewRowAccessPolicy{Name: "rap", On: []string{"a"}},
+		AggregationPolicy:  &ViewAggregationPolicy{Name: "ap", EntityKey: []string{"a", "b"}},
+		Tags:               []ViewTag{{Name: "db.s.t", Value: "it's"}},
+		Contacts:           []ViewContact{{Purpose: "steward", Name: "db.s.c"}},
+		ClusterBy:          []string{"a", "upper(b)"},
+		Statement:          "-- the query\nselect a, b from bar",
+	}, definition)
 }
 
-func TestViewSelectStatementExtractor_consumeComment(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		posAfter int
-	}{
-		{"basic", fields{[]rune("comment='foo'"), 0}, 13},
-		{"escaped", fields{[]rune(`comment='fo\'o'`), 0}, 15},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeComment()
+func TestParseViewDefinition_OrAlter(t *testing.T) {
+	definition, err := ParseViewDefinition("create or alter secure view foo as select 1")
 
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
-		})
-	}
+	require.NoError(t, err)
+	require.True(t, definition.OrAlter)
+	require.False(t, definition.OrReplace)
+	require.True(t, definition.Secure)
+	require.Equal(t, "select 1", definition.Statement)
 }
 
-func TestViewSelectStatementExtractor_consumeClusterBy(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
+func TestParseViewDefinition_Errors(t *testing.T) {
 	tests := []struct {
-		name     string
-		fields   fields
-		posAfter int
+		name  string
+		input string
+		err   string
 	}{
-		{"none", fields{[]rune("as foo"), 0}, 0},
-		{"single", fields{[]rune("(c1)"), 0}, 4},
-		{"double", fields{[]rune("(c1, c2)"), 0}, 8},
+		{"empty", "", "expected CREATE, got end of input"},
+		{"missing name", "create view as select 1", `expected identifier, got word "as" at offset 12`},
+		{"missing as", "create view foo comment = 'c'", "expected AS, got end of input"},
+		{"unexpected token", "create view foo select 1", `unexpected word "select" at offset 16`},
+		{"with without clause", "create view foo with comment = 'c' as select 1", `expected ROW ACCESS POLICY, AGGREGATION POLICY, TAG or CONTACT, got word "comment" at offset 21`},
+		{"invalid data metric schedule", "create view foo data_metric_schedule = 5 as select 1", `expected string, got number "5" at offset 39`},
+		{"or without replace nor alter", "create or view foo as select 1", `expected REPLACE, got word "view" at offset 10`},
+		{"invalid change tracking", "create view foo change_tracking = yes as select 1", `expected TRUE or FALSE, got word "yes" at offset 34`},
+		{"unterminated quoted identifier", `create view "foo as select 1`, "unterminated \" quote at offset 12"},
+		{"unterminated block comment", "create view foo /* as select 1", "unterminated comment at offset 16"},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeClusterBy()
-
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
+			_, err := ParseViewDefinition(tt.input)
+			require.EqualError(t, err, tt.err)
 		})
 	}
 }
@@ -61,6 +61,26 @@ func TestProcedureCreateWithOptionalParams(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestProcedureCreateWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getProcedure(false)
+	s.WithLanguage("PYTHON")
+	s.WithRuntimeVersion("3.8")
+	s.WithPackages([]string{"snowflake-snowpark-python"})
+	s.WithHandler("handler.test")
+	s.WithExternalAccessIntegrations([]string{`"test_integration"`})
+	s.WithSecrets([]map[string]string{
+		{"variable_name": "cred", "secret_id": `"test_db"."test_schema"."test_secret"`},
+	})
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE PROCEDURE "test_db"."test_schema"."test_proc"` +
+		`() RETURNS VARCHAR LANGUAGE PYTHON RUNTIME_VERSION = '3.8' PACKAGES = ('snowflake-snowpark-python') ` +
+		`HANDLER = 'handler.test' EXTERNAL_ACCESS_INTEGRATIONS = ("test_integration") ` +
+		`SECRETS = ('cred' = "test_db"."test_schema"."test_secret") ` +
+		`EXECUTE AS CALLER AS $$var message = "Hi"` + "\nreturn message$$"
+	r.Equal(expected, createStmnt)
+}
+
 func TestProcedureDrop(t *testing.T) {
 	r := require.New(t)
 
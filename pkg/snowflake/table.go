@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -657,6 +658,21 @@ type Table struct {
 	IsExternal          sql.NullString `db:"is_external"`
 }
 
+// ShowTable returns the table described by the builder, or sql.ErrNoRows if it does not exist. The lookup is served
+// from the tables of the schema when the SHOW cache of the SDK is enabled.
+func ShowTable(db *sql.DB, tb *TableBuilder) (*Table, error) {
+	var tables []Table
+	if err := sdk.NewClientFromDB(db).QueryShow(context.Background(), &tables, tb.Show()); err != nil {
+		return nil, err
+	}
+	for i := range tables {
+		if tables[i].TableName.String == tb.name {
+			return &tables[i], nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
 func ScanTable(row *sqlx.Row) (*Table, error) {
 	t := &Table{}
 	e := row.StructScan(t)
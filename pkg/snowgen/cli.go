@@ -0,0 +1,113 @@
+package snowgen
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// Run inspects the account and writes the generated files. args are the command line arguments of the snowgen command.
+func Run(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("snowgen", flag.ContinueOnError)
+	profile := flags.String("profile", "", "profile of the Snowflake config file to connect with (the default connection chain is used when empty)")
+	databases := flags.String("databases", "", "comma separated names of the databases to read (all databases when empty)")
+	roles := flags.String("roles", "", "comma separated names of the roles to read (all roles when empty)")
+	objects := flags.String("objects", "", fmt.Sprintf("comma separated kinds of objects to read, out of %s (all kinds when empty)", joinObjectTypes(AllObjectTypes)))
+	output := flags.String("output", ".", "directory the files are written to")
+	force := flags.Bool("force", false, "overwrite the files which already exist in the output directory")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	opts := &Options{
+		Databases: splitList(*databases),
+		Roles:     splitList(*roles),
+	}
+	for _, s := range splitList(*objects) {
+		objectType, err := ToObjectType(s)
+		if err != nil {
+			return err
+		}
+		opts.ObjectTypes = append(opts.ObjectTypes, objectType)
+	}
+
+	client, err := newClient(*profile)
+	if err != nil {
+		return err
+	}
+	account, err := Inspect(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+	return writeFiles(*output, Generate(account), *force)
+}
+
+func newClient(profile string) (*sdk.Client, error) {
+	if profile == "" {
+		return sdk.NewDefaultClient()
+	}
+	config, err := sdk.ProfileConfig(profile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load profile %s: %w", profile, err)
+	}
+	if config == nil {
+		return nil, fmt.Errorf("profile %s not found in the Snowflake config file", profile)
+	}
+	return sdk.NewClient(config)
+}
+
+func writeFiles(dir string, files map[string][]byte, force bool) error {
+	if len(files) == 0 {
+		return errors.New("no objects found in the account for the given filters")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if !force {
+		for _, name := range names {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return fmt.Errorf("file %s already exists, use -force to overwrite it", filepath.Join(dir, name))
+			}
+		}
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func joinObjectTypes(objectTypes []ObjectType) string {
+	s := make([]string, len(objectTypes))
+	for i, objectType := range objectTypes {
+		s[i] = string(objectType)
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,321 @@
+package snowgen
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ImportsFile is the name of the file with the import blocks of all the generated resources.
+const ImportsFile = "imports.tf"
+
+// generator renders the resources in one file per kind of object, and their import blocks in ImportsFile.
+// The resources reference the databases, schemas and roles they belong to when those are generated too,
+// so that Terraform knows the dependencies between them.
+type generator struct {
+	files   map[string]*hclwrite.File
+	order   []string
+	imports *hclwrite.File
+
+	names     map[string]map[string]bool
+	databases map[string]string
+	schemas   map[string]string
+	roles     map[string]string
+}
+
+// Generate renders the resources of the objects of the account and the import blocks to adopt them.
+// It returns the content of the files by their name.
+func Generate(account *Account) map[string][]byte {
+	g := &generator{
+		files:     make(map[string]*hclwrite.File),
+		imports:   hclwrite.NewEmptyFile(),
+		names:     make(map[string]map[string]bool),
+		databases: make(map[string]string),
+		schemas:   make(map[string]string),
+		roles:     make(map[string]string),
+	}
+
+	for _, database := range account.Databases {
+		g.database(database)
+	}
+	for _, schema := range account.Schemas {
+		g.schema(schema)
+	}
+	for _, table := range account.Tables {
+		g.table(table)
+	}
+	for _, view := range account.Views {
+		g.view(view)
+	}
+	for _, warehouse := range account.Warehouses {
+		g.warehouse(warehouse)
+	}
+	for _, role := range account.Roles {
+		g.role(role)
+	}
+	for _, user := range account.Users {
+		g.user(user)
+	}
+	for _, grant := range groupGrants(account.Grants) {
+		g.grant(grant)
+	}
+
+	files := make(map[string][]byte)
+	for _, name := range g.order {
+		files[name] = g.files[name].Bytes()
+	}
+	if len(g.order) > 0 {
+		files[ImportsFile] = g.imports.Bytes()
+	}
+	return files
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// resourceName returns a unique Terraform name for a resource of the given type, based on the names of the object.
+func (g *generator) resourceName(resourceType string, parts ...string) string {
+	name := invalidNameCharacters.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	name = strings.Trim(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	if g.names[resourceType] == nil {
+		g.names[resourceType] = make(map[string]bool)
+	}
+	unique := name
+	for i := 2; g.names[resourceType][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	g.names[resourceType][unique] = true
+	return unique
+}
+
+// addResource appends the resource block to the file and its import block to ImportsFile. It returns
+// the address of the resource and the body of the block.
+func (g *generator) addResource(file string, resourceType string, name string, id string) (string, *hclwrite.Body) {
+	f, ok := g.files[file]
+	if !ok {
+		f = hclwrite.NewEmptyFile()
+		g.files[file] = f
+		g.order = append(g.order, file)
+	} else {
+		f.Body().AppendNewline()
+	}
+
+	address := hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: name}}
+	if len(g.imports.Body().Blocks()) > 0 {
+		g.imports.Body().AppendNewline()
+	}
+	importBody := g.imports.Body().AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", address)
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+
+	return resourceType + "." + name, f.Body().AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// setReference sets the attribute to the name attribute of the referenced resource, or to the name itself
+// when the resource is not generated.
+func setReference(body *hclwrite.Body, attribute string, address string, name string) {
+	if address == "" {
+		body.SetAttributeValue(attribute, cty.StringVal(name))
+		return
+	}
+	parts := strings.Split(address, ".")
+	body.SetAttributeTraversal(attribute, hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}, hcl.TraverseAttr{Name: parts[1]}, hcl.TraverseAttr{Name: "name"}})
+}
+
+func setOptionalString(body *hclwrite.Body, attribute string, value string) {
+	if value != "" {
+		body.SetAttributeValue(attribute, cty.StringVal(value))
+	}
+}
+
+func (g *generator) database(database sdk.Database) {
+	name := g.resourceName("snowflake_database", database.Name)
+	address, body := g.addResource("databases.tf", "snowflake_database", name, database.Name)
+	g.databases[database.Name] = address
+
+	body.SetAttributeValue("name", cty.StringVal(database.Name))
+	setOptionalString(body, "comment", database.Comment)
+	if database.Transient {
+		body.SetAttributeValue("is_transient", cty.True)
+	}
+	if database.RetentionTime != 1 {
+		body.SetAttributeValue("data_retention_time_in_days", cty.NumberIntVal(int64(database.RetentionTime)))
+	}
+}
+
+func (g *generator) schema(schema sdk.Schema) {
+	name := g.resourceName("snowflake_schema", schema.DatabaseName, schema.Name)
+	address, body := g.addResource("schemas.tf", "snowflake_schema", name, helpers.EncodeSnowflakeID(schema.DatabaseName, schema.Name))
+	g.schemas[helpers.EncodeSnowflakeID(schema.DatabaseName, schema.Name)] = address
+
+	setReference(body, "database", g.databases[schema.DatabaseName], schema.DatabaseName)
+	body.SetAttributeValue("name", cty.StringVal(schema.Name))
+	if schema.Comment != nil {
+		setOptionalString(body, "comment", *schema.Comment)
+	}
+	if schema.Options != nil && strings.Contains(*schema.Options, "TRANSIENT") {
+		body.SetAttributeValue("is_transient", cty.True)
+	}
+	if schema.Options != nil && strings.Contains(*schema.Options, "MANAGED ACCESS") {
+		body.SetAttributeValue("is_managed", cty.True)
+	}
+	if retentionTime, err := strconv.Atoi(schema.RetentionTime); err == nil && retentionTime != 1 {
+		body.SetAttributeValue("data_retention_time_in_days", cty.NumberIntVal(int64(retentionTime)))
+	}
+}
+
+func (g *generator) table(table Table) {
+	name := g.resourceName("snowflake_table", table.DatabaseName, table.SchemaName, table.Name)
+	_, body := g.addResource("tables.tf", "snowflake_table", name, helpers.EncodeSnowflakeID(table.DatabaseName, table.SchemaName, table.Name))
+
+	setReference(body, "database", g.databases[table.DatabaseName], table.DatabaseName)
+	setReference(body, "schema", g.schemas[helpers.EncodeSnowflakeID(table.DatabaseName, table.SchemaName)], table.SchemaName)
+	body.SetAttributeValue("name", cty.StringVal(table.Name))
+	setOptionalString(body, "comment", table.Comment)
+	if table.ChangeTracking {
+		body.SetAttributeValue("change_tracking", cty.True)
+	}
+	for _, column := range table.Columns {
+		columnBody := body.AppendNewBlock("column", nil).Body()
+		columnBody.SetAttributeValue("name", cty.StringVal(column.Name))
+		columnBody.SetAttributeValue("type", cty.StringVal(string(column.Type)))
+		if !column.IsNullable {
+			columnBody.SetAttributeValue("nullable", cty.False)
+		}
+		if column.Comment != nil {
+			setOptionalString(columnBody, "comment", *column.Comment)
+		}
+	}
+}
+
+func (g *generator) view(view sdk.View) {
+	name := g.resourceName("snowflake_view", view.DatabaseName, view.SchemaName, view.Name)
+	id, err := (&resources.ViewID{DatabaseName: view.DatabaseName, SchemaName: view.SchemaName, ViewName: view.Name}).String()
+	if err != nil {
+		log.Printf("[DEBUG] skipping view %s: %v", view.ID().FullyQualifiedName(), err)
+		return
+	}
+	_, body := g.addResource("views.tf", "snowflake_view", name, id)
+
+	setReference(body, "database", g.databases[view.DatabaseName], view.DatabaseName)
+	setReference(body, "schema", g.schemas[helpers.EncodeSnowflakeID(view.DatabaseName, view.SchemaName)], view.SchemaName)
+	body.SetAttributeValue("name", cty.StringVal(view.Name))
+	setOptionalString(body, "comment", view.Comment)
+	if view.IsSecure {
+		body.SetAttributeValue("is_secure", cty.True)
+	}
+	// The statement is extracted from the text of the view the same way snowflake_view reads it.
+	statement, err := snowflake.NewViewSelectStatementExtractor(view.Text).Extract()
+	if err != nil {
+		statement = view.Text
+	}
+	body.SetAttributeValue("statement", cty.StringVal(statement))
+}
+
+func (g *generator) warehouse(warehouse sdk.Warehouse) {
+	name := g.resourceName("snowflake_warehouse", warehouse.Name)
+	_, body := g.addResource("warehouses.tf", "snowflake_warehouse", name, warehouse.Name)
+
+	body.SetAttributeValue("name", cty.StringVal(warehouse.Name))
+	setOptionalString(body, "comment", warehouse.Comment)
+	if warehouse.Type != "" && warehouse.Type != sdk.WarehouseTypeStandard {
+		body.SetAttributeValue("warehouse_type", cty.StringVal(string(warehouse.Type)))
+	}
+	body.SetAttributeValue("warehouse_size", cty.StringVal(string(warehouse.Size)))
+	body.SetAttributeValue("auto_suspend", cty.NumberIntVal(int64(warehouse.AutoSuspend)))
+	body.SetAttributeValue("auto_resume", cty.BoolVal(warehouse.AutoResume))
+	if warehouse.MaxClusterCount > 1 {
+		body.SetAttributeValue("min_cluster_count", cty.NumberIntVal(int64(warehouse.MinClusterCount)))
+		body.SetAttributeValue("max_cluster_count", cty.NumberIntVal(int64(warehouse.MaxClusterCount)))
+		setOptionalString(body, "scaling_policy", string(warehouse.ScalingPolicy))
+	}
+	if warehouse.EnableQueryAcceleration {
+		body.SetAttributeValue("enable_query_acceleration", cty.True)
+		body.SetAttributeValue("query_acceleration_max_scale_factor", cty.NumberIntVal(int64(warehouse.QueryAccelerationMaxScaleFactor)))
+	}
+	if warehouse.ResourceMonitor != "" && warehouse.ResourceMonitor != "null" {
+		body.SetAttributeValue("resource_monitor", cty.StringVal(warehouse.ResourceMonitor))
+	}
+}
+
+func (g *generator) role(role sdk.Role) {
+	name := g.resourceName("snowflake_role", role.Name)
+	address, body := g.addResource("roles.tf", "snowflake_role", name, role.Name)
+	g.roles[role.Name] = address
+
+	body.SetAttributeValue("name", cty.StringVal(role.Name))
+	setOptionalString(body, "comment", role.Comment)
+}
+
+func (g *generator) user(user sdk.User) {
+	name := g.resourceName("snowflake_user", user.Name)
+	_, body := g.addResource("users.tf", "snowflake_user", name, user.Name)
+
+	body.SetAttributeValue("name", cty.StringVal(user.Name))
+	if !strings.EqualFold(user.LoginName, user.Name) {
+		setOptionalString(body, "login_name", user.LoginName)
+	}
+	if user.DisplayName != user.Name {
+		setOptionalString(body, "display_name", user.DisplayName)
+	}
+	setOptionalString(body, "first_name", user.FirstName)
+	setOptionalString(body, "last_name", user.LastName)
+	setOptionalString(body, "email", user.Email)
+	setOptionalString(body, "comment", user.Comment)
+	setOptionalString(body, "default_warehouse", user.DefaultWarehouse)
+	setOptionalString(body, "default_namespace", user.DefaultNamespace)
+	setOptionalString(body, "default_role", user.DefaultRole)
+	if user.Disabled {
+		body.SetAttributeValue("disabled", cty.True)
+	}
+}
+
+func (g *generator) grant(grant privilegesGrant) {
+	nameParts := []string{grant.roleName, string(grant.target.objectType), grant.target.objectName}
+	if grant.target.onAccount {
+		nameParts = []string{grant.roleName, "account"}
+	}
+	if grant.withGrantOption {
+		nameParts = append(nameParts, "with_grant_option")
+	}
+	name := g.resourceName("snowflake_grant_privileges_to_role", nameParts...)
+	_, body := g.addResource("grants.tf", "snowflake_grant_privileges_to_role", name, grant.id())
+
+	setReference(body, "role_name", g.roles[grant.roleName], grant.roleName)
+	privileges := make([]cty.Value, len(grant.privileges))
+	for i, privilege := range grant.privileges {
+		privileges[i] = cty.StringVal(privilege)
+	}
+	body.SetAttributeValue("privileges", cty.SetVal(privileges))
+	if grant.withGrantOption {
+		body.SetAttributeValue("with_grant_option", cty.True)
+	}
+
+	switch {
+	case grant.target.onAccount:
+		body.SetAttributeValue("on_account", cty.True)
+	case grant.target.onAccountObject:
+		on := body.AppendNewBlock("on_account_object", nil).Body()
+		on.SetAttributeValue("object_type", cty.StringVal(grant.target.objectType.String()))
+		on.SetAttributeValue("object_name", cty.StringVal(grant.target.objectName))
+	case grant.target.onSchema:
+		on := body.AppendNewBlock("on_schema", nil).Body()
+		on.SetAttributeValue("schema_name", cty.StringVal(grant.target.objectName))
+	case grant.target.onSchemaObject:
+		on := body.AppendNewBlock("on_schema_object", nil).Body()
+		on.SetAttributeValue("object_type", cty.StringVal(grant.target.objectType.String()))
+		on.SetAttributeValue("object_name", cty.StringVal(grant.target.objectName))
+	}
+}
@@ -0,0 +1,92 @@
+package snowgen
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	comment := "raw data"
+	account := &Account{
+		Databases: []sdk.Database{{Name: "ANALYTICS", RetentionTime: 1}},
+		Schemas:   []sdk.Schema{{Name: "RAW", DatabaseName: "ANALYTICS", RetentionTime: "7", Comment: &comment}},
+		Tables: []Table{{
+			Table: sdk.Table{Name: "EVENTS", DatabaseName: "ANALYTICS", SchemaName: "RAW"},
+			Columns: []sdk.TableColumnDetails{
+				{Name: "ID", Type: sdk.DataTypeNumber, IsNullable: false},
+				{Name: "PAYLOAD", Type: sdk.DataTypeVariant, IsNullable: true},
+			},
+		}},
+		Views: []sdk.View{{Name: "RECENT_EVENTS", DatabaseName: "ANALYTICS", SchemaName: "RAW", Text: "create view RECENT_EVENTS as select * from EVENTS"}},
+		Roles: []sdk.Role{{Name: "ANALYST"}},
+		Grants: []sdk.Grant{
+			{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("ANALYTICS"), GranteeName: sdk.NewAccountObjectIdentifier("ANALYST")},
+			{Privilege: "MONITOR", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("ANALYTICS"), GranteeName: sdk.NewAccountObjectIdentifier("ANALYST")},
+			{Privilege: "OWNERSHIP", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("ANALYTICS"), GranteeName: sdk.NewAccountObjectIdentifier("ANALYST")},
+		},
+	}
+
+	files := Generate(account)
+
+	assert.ElementsMatch(t, []string{"databases.tf", "schemas.tf", "tables.tf", "views.tf", "roles.tf", "grants.tf", ImportsFile}, keys(files))
+	assert.Contains(t, string(files["databases.tf"]), `resource "snowflake_database" "analytics" {`)
+	assert.NotContains(t, string(files["databases.tf"]), "data_retention_time_in_days")
+	assert.Contains(t, string(files["schemas.tf"]), "database                    = snowflake_database.analytics.name")
+	assert.Contains(t, string(files["schemas.tf"]), "data_retention_time_in_days = 7")
+	assert.Contains(t, string(files["tables.tf"]), "schema   = snowflake_schema.analytics_raw.name")
+	assert.Contains(t, string(files["tables.tf"]), "nullable = false")
+	assert.Contains(t, string(files["views.tf"]), `statement = "select * from EVENTS"`)
+	assert.Contains(t, string(files["grants.tf"]), "role_name  = snowflake_role.analyst.name")
+	assert.Contains(t, string(files["grants.tf"]), `privileges = ["MONITOR", "USAGE"]`)
+
+	imports := string(files[ImportsFile])
+	assert.Contains(t, imports, "to = snowflake_schema.analytics_raw\n  id = \"ANALYTICS|RAW\"")
+	assert.Contains(t, imports, "to = snowflake_table.analytics_raw_events\n  id = \"ANALYTICS|RAW|EVENTS\"")
+	assert.Contains(t, imports, `id = "ANALYTICS|RAW|RECENT_EVENTS"`)
+	assert.Contains(t, imports, `id = "ANALYST|MONITOR,USAGE|false|false|false|true|false|false|false|false|DATABASE|ANALYTICS||false||false|"`)
+}
+
+func TestGenerate_Empty(t *testing.T) {
+	assert.Empty(t, Generate(&Account{}))
+}
+
+func TestResourceName(t *testing.T) {
+	g := &generator{names: make(map[string]map[string]bool)}
+
+	assert.Equal(t, "my_db", g.resourceName("snowflake_database", "My-DB"))
+	assert.Equal(t, "my_db_2", g.resourceName("snowflake_database", "MY DB"))
+	assert.Equal(t, "my_db", g.resourceName("snowflake_role", "MY_DB"))
+	assert.Equal(t, "_1db", g.resourceName("snowflake_database", "1DB"))
+	assert.Equal(t, "db_schema", g.resourceName("snowflake_schema", "DB", "\"schema\""))
+}
+
+func TestGroupGrants(t *testing.T) {
+	role := sdk.NewAccountObjectIdentifier("ANALYST")
+	grants := groupGrants([]sdk.Grant{
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeSchema, Name: sdk.NewAccountObjectIdentifier("DB.SCHEMA"), GranteeName: role},
+		{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: sdk.NewAccountObjectIdentifier("DB.SCHEMA.TABLE"), GranteeName: role, GrantOption: true},
+		{Privilege: "CREATE TABLE", GrantedOn: sdk.ObjectTypeSchema, Name: sdk.NewAccountObjectIdentifier("DB.SCHEMA"), GranteeName: role},
+		{Privilege: "CREATE DATABASE", GrantedOn: sdk.ObjectTypeAccount, Name: sdk.NewAccountObjectIdentifier("ACCOUNT"), GranteeName: role},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeFunction, Name: sdk.NewAccountObjectIdentifier("DB.SCHEMA.\"F(NUMBER):NUMBER\""), GranteeName: role},
+	})
+
+	require.Len(t, grants, 3)
+	assert.True(t, grants[0].target.onSchema)
+	assert.Equal(t, []string{"CREATE TABLE", "USAGE"}, grants[0].privileges)
+	assert.Equal(t, `ANALYST|CREATE TABLE,USAGE|false|false|false|false|true|false|false|false||||false|"DB"."SCHEMA"|false|`, grants[0].id())
+	assert.True(t, grants[1].target.onSchemaObject)
+	assert.True(t, grants[1].withGrantOption)
+	assert.Equal(t, `"DB"."SCHEMA"."TABLE"`, grants[1].target.objectName)
+	assert.True(t, grants[2].target.onAccount)
+}
+
+func keys(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,157 @@
+package snowgen
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// accountObjectTypes are the types snowflake_grant_privileges_to_role supports in on_account_object.
+var accountObjectTypes = []sdk.ObjectType{
+	sdk.ObjectTypeDatabase,
+	sdk.ObjectTypeFailoverGroup,
+	sdk.ObjectTypeIntegration,
+	sdk.ObjectTypeReplicationGroup,
+	sdk.ObjectTypeResourceMonitor,
+	sdk.ObjectTypeUser,
+	sdk.ObjectTypeWarehouse,
+	sdk.ObjectTypeExternalVolume,
+}
+
+// schemaObjectTypes are the types of the schema objects, without arguments, the generator emits grants on.
+var schemaObjectTypes = []sdk.ObjectType{
+	sdk.ObjectTypeAlert,
+	sdk.ObjectTypeDynamicTable,
+	sdk.ObjectTypeEventTable,
+	sdk.ObjectTypeExternalTable,
+	sdk.ObjectTypeFileFormat,
+	sdk.ObjectTypeIcebergTable,
+	sdk.ObjectTypeMaskingPolicy,
+	sdk.ObjectTypeMaterializedView,
+	sdk.ObjectTypePipe,
+	sdk.ObjectTypeRowAccessPolicy,
+	sdk.ObjectTypeSequence,
+	sdk.ObjectTypeStage,
+	sdk.ObjectTypeStream,
+	sdk.ObjectTypeTable,
+	sdk.ObjectTypeTag,
+	sdk.ObjectTypeTask,
+	sdk.ObjectTypeView,
+}
+
+// grantTarget is the object privileges are granted on, in the terms of snowflake_grant_privileges_to_role.
+type grantTarget struct {
+	onAccount       bool
+	onAccountObject bool
+	onSchema        bool
+	onSchemaObject  bool
+	objectType      sdk.ObjectType
+	// objectName is the name of the account object, or the fully qualified name of the schema or schema object.
+	objectName string
+	// databaseName is the database of the object, if any, used to scope the grants.
+	databaseName string
+}
+
+// newGrantTarget returns false for the grants the generator does not emit: ownership, which is not
+// a privilege of snowflake_grant_privileges_to_role, grants of roles, and grants on objects with arguments.
+func newGrantTarget(grant sdk.Grant) (grantTarget, bool) {
+	if grant.Privilege == "OWNERSHIP" {
+		return grantTarget{}, false
+	}
+	switch {
+	case grant.GrantedOn == sdk.ObjectTypeAccount:
+		return grantTarget{onAccount: true}, true
+	case slices.Contains(accountObjectTypes, grant.GrantedOn):
+		target := grantTarget{onAccountObject: true, objectType: grant.GrantedOn, objectName: grant.Name.Name()}
+		if grant.GrantedOn == sdk.ObjectTypeDatabase {
+			target.databaseName = grant.Name.Name()
+		}
+		return target, true
+	case grant.GrantedOn == sdk.ObjectTypeSchema:
+		id, err := helpers.DecodeSnowflakeParameterID(grant.Name.Name())
+		if err != nil {
+			return grantTarget{}, false
+		}
+		schemaID, ok := id.(sdk.DatabaseObjectIdentifier)
+		if !ok {
+			return grantTarget{}, false
+		}
+		return grantTarget{onSchema: true, objectType: grant.GrantedOn, objectName: schemaID.FullyQualifiedName(), databaseName: schemaID.DatabaseName()}, true
+	case slices.Contains(schemaObjectTypes, grant.GrantedOn):
+		id, err := helpers.DecodeSnowflakeParameterID(grant.Name.Name())
+		if err != nil {
+			return grantTarget{}, false
+		}
+		objectID, ok := id.(sdk.SchemaObjectIdentifier)
+		if !ok {
+			return grantTarget{}, false
+		}
+		return grantTarget{onSchemaObject: true, objectType: grant.GrantedOn, objectName: objectID.FullyQualifiedName(), databaseName: objectID.DatabaseName()}, true
+	default:
+		return grantTarget{}, false
+	}
+}
+
+// privilegesGrant is one snowflake_grant_privileges_to_role: the privileges granted to a role on an object.
+type privilegesGrant struct {
+	roleName        string
+	target          grantTarget
+	withGrantOption bool
+	privileges      []string
+}
+
+// id returns the ID of the snowflake_grant_privileges_to_role, as set on create.
+func (g privilegesGrant) id() string {
+	id := resources.GrantPrivilegesToAccountRoleID{
+		RoleName:        g.roleName,
+		Privileges:      g.privileges,
+		WithGrantOption: g.withGrantOption,
+		OnAccount:       g.target.onAccount,
+		OnAccountObject: g.target.onAccountObject,
+		OnSchema:        g.target.onSchema,
+		OnSchemaObject:  g.target.onSchemaObject,
+	}
+	switch {
+	case g.target.onAccountObject, g.target.onSchemaObject:
+		id.ObjectType = g.target.objectType.String()
+		id.ObjectName = g.target.objectName
+	case g.target.onSchema:
+		id.SchemaName = g.target.objectName
+	}
+	return id.String()
+}
+
+// groupGrants groups the privileges granted to the same role on the same object with the same grant option,
+// the way they are declared in snowflake_grant_privileges_to_role.
+func groupGrants(grants []sdk.Grant) []privilegesGrant {
+	type key struct {
+		roleName        string
+		target          grantTarget
+		withGrantOption bool
+	}
+	var keys []key
+	privileges := make(map[key][]string)
+	for _, grant := range grants {
+		target, ok := newGrantTarget(grant)
+		if !ok {
+			continue
+		}
+		k := key{roleName: grant.GranteeName.Name(), target: target, withGrantOption: grant.GrantOption}
+		if _, ok := privileges[k]; !ok {
+			keys = append(keys, k)
+		}
+		if !slices.Contains(privileges[k], grant.Privilege) {
+			privileges[k] = append(privileges[k], grant.Privilege)
+		}
+	}
+
+	grouped := make([]privilegesGrant, 0, len(keys))
+	for _, k := range keys {
+		sort.Strings(privileges[k])
+		grouped = append(grouped, privilegesGrant{roleName: k.roleName, target: k.target, withGrantOption: k.withGrantOption, privileges: privileges[k]})
+	}
+	return grouped
+}
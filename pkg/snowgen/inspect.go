@@ -0,0 +1,243 @@
+package snowgen
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// ObjectType is a kind of object the generator can emit resources for.
+type ObjectType string
+
+const (
+	ObjectTypeDatabases  ObjectType = "databases"
+	ObjectTypeSchemas    ObjectType = "schemas"
+	ObjectTypeTables     ObjectType = "tables"
+	ObjectTypeViews      ObjectType = "views"
+	ObjectTypeWarehouses ObjectType = "warehouses"
+	ObjectTypeRoles      ObjectType = "roles"
+	ObjectTypeUsers      ObjectType = "users"
+	ObjectTypeGrants     ObjectType = "grants"
+)
+
+var AllObjectTypes = []ObjectType{
+	ObjectTypeDatabases,
+	ObjectTypeSchemas,
+	ObjectTypeTables,
+	ObjectTypeViews,
+	ObjectTypeWarehouses,
+	ObjectTypeRoles,
+	ObjectTypeUsers,
+	ObjectTypeGrants,
+}
+
+func ToObjectType(s string) (ObjectType, error) {
+	objectType := ObjectType(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(AllObjectTypes, objectType) {
+		return "", fmt.Errorf("invalid object type: %s", s)
+	}
+	return objectType, nil
+}
+
+var (
+	systemDatabases = []string{"SNOWFLAKE", "SNOWFLAKE_SAMPLE_DATA"}
+	systemSchemas   = []string{"INFORMATION_SCHEMA"}
+	systemRoles     = []string{"ACCOUNTADMIN", "ORGADMIN", "PUBLIC", "SECURITYADMIN", "SYSADMIN", "USERADMIN"}
+	systemUsers     = []string{"SNOWFLAKE"}
+)
+
+// Options scope the objects read from the account.
+type Options struct {
+	// Databases limits the databases, and the schemas, tables, views and grants in them, to the given names. All databases are read when empty.
+	Databases []string
+	// Roles limits the roles, and the grants to them, to the given names. All roles are read when empty.
+	Roles []string
+	// ObjectTypes are the kinds of objects to read. All kinds are read when empty.
+	ObjectTypes []ObjectType
+}
+
+func (opts *Options) includes(objectType ObjectType) bool {
+	return len(opts.ObjectTypes) == 0 || slices.Contains(opts.ObjectTypes, objectType)
+}
+
+func (opts *Options) includesDatabase(name string) bool {
+	if len(opts.Databases) == 0 {
+		return !slices.Contains(systemDatabases, name)
+	}
+	return slices.ContainsFunc(opts.Databases, func(s string) bool { return strings.EqualFold(s, name) })
+}
+
+func (opts *Options) includesRole(name string) bool {
+	if len(opts.Roles) == 0 {
+		return !slices.Contains(systemRoles, name)
+	}
+	return slices.ContainsFunc(opts.Roles, func(s string) bool { return strings.EqualFold(s, name) })
+}
+
+// Table is a table with its columns.
+type Table struct {
+	sdk.Table
+	Columns []sdk.TableColumnDetails
+}
+
+// Account is a snapshot of the objects read from an account.
+type Account struct {
+	Databases  []sdk.Database
+	Schemas    []sdk.Schema
+	Tables     []Table
+	Views      []sdk.View
+	Warehouses []sdk.Warehouse
+	Roles      []sdk.Role
+	Users      []sdk.User
+	// Grants are the grants of privileges to Roles.
+	Grants []sdk.Grant
+}
+
+// Inspect reads the objects in the scope of the options with the Show methods of the client.
+func Inspect(ctx context.Context, client *sdk.Client, opts *Options) (*Account, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	account := &Account{}
+
+	// The databases are read whenever one of the kinds of objects they contain is requested.
+	if opts.includes(ObjectTypeDatabases) || opts.includes(ObjectTypeSchemas) || opts.includes(ObjectTypeTables) || opts.includes(ObjectTypeViews) {
+		databases, err := client.Databases.Show(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to show databases: %w", err)
+		}
+		for _, database := range databases {
+			// Databases created from shares and application databases are not managed with snowflake_database.
+			if database.Origin != "" || (database.Kind != "" && database.Kind != "STANDARD") {
+				continue
+			}
+			if opts.includesDatabase(database.Name) {
+				account.Databases = append(account.Databases, database)
+			}
+		}
+	}
+
+	for _, database := range account.Databases {
+		if err := account.inspectDatabase(ctx, client, opts, database.ID()); err != nil {
+			return nil, err
+		}
+	}
+	if !opts.includes(ObjectTypeDatabases) {
+		account.Databases = nil
+	}
+
+	if opts.includes(ObjectTypeWarehouses) {
+		warehouses, err := client.Warehouses.Show(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to show warehouses: %w", err)
+		}
+		for _, warehouse := range warehouses {
+			if !strings.HasPrefix(warehouse.Name, "SYSTEM$") {
+				account.Warehouses = append(account.Warehouses, warehouse)
+			}
+		}
+	}
+
+	if opts.includes(ObjectTypeRoles) || opts.includes(ObjectTypeGrants) {
+		roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+		if err != nil {
+			return nil, fmt.Errorf("unable to show roles: %w", err)
+		}
+		for _, role := range roles {
+			if opts.includesRole(role.Name) {
+				account.Roles = append(account.Roles, role)
+			}
+		}
+	}
+
+	if opts.includes(ObjectTypeUsers) {
+		users, err := client.Users.Show(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to show users: %w", err)
+		}
+		for _, user := range users {
+			if !slices.Contains(systemUsers, user.Name) {
+				account.Users = append(account.Users, user)
+			}
+		}
+	}
+
+	if opts.includes(ObjectTypeGrants) {
+		for _, role := range account.Roles {
+			grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: role.ID()}})
+			if err != nil {
+				return nil, fmt.Errorf("unable to show grants to role %s: %w", role.Name, err)
+			}
+			for _, grant := range grants {
+				target, ok := newGrantTarget(grant)
+				if !ok {
+					log.Printf("[DEBUG] skipping grant of %s on %s %s to role %s", grant.Privilege, grant.GrantedOn, grant.Name.Name(), role.Name)
+					continue
+				}
+				if target.databaseName == "" || opts.includesDatabase(target.databaseName) {
+					account.Grants = append(account.Grants, grant)
+				}
+			}
+		}
+		if !opts.includes(ObjectTypeRoles) {
+			account.Roles = nil
+		}
+	}
+	return account, nil
+}
+
+func (account *Account) inspectDatabase(ctx context.Context, client *sdk.Client, opts *Options, id sdk.AccountObjectIdentifier) error {
+	if opts.includes(ObjectTypeSchemas) {
+		schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{In: &sdk.SchemaIn{Database: sdk.Bool(true), Name: id}})
+		if err != nil {
+			return fmt.Errorf("unable to show schemas in database %s: %w", id.Name(), err)
+		}
+		for _, schema := range schemas {
+			// The PUBLIC schema is created with the database.
+			if !slices.Contains(systemSchemas, schema.Name) && schema.Name != "PUBLIC" {
+				account.Schemas = append(account.Schemas, schema)
+			}
+		}
+	}
+
+	if opts.includes(ObjectTypeTables) {
+		tables, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().WithIn(&sdk.In{Database: id}))
+		if err != nil {
+			return fmt.Errorf("unable to show tables in database %s: %w", id.Name(), err)
+		}
+		for _, table := range tables {
+			if slices.Contains(systemSchemas, table.SchemaName) || table.IsExternal || table.IsEvent {
+				continue
+			}
+			tableID := sdk.NewSchemaObjectIdentifier(table.DatabaseName, table.SchemaName, table.Name)
+			columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(tableID))
+			if err != nil {
+				return fmt.Errorf("unable to describe columns of table %s: %w", tableID.FullyQualifiedName(), err)
+			}
+			account.Tables = append(account.Tables, Table{Table: table, Columns: columns})
+		}
+	}
+
+	if opts.includes(ObjectTypeViews) {
+		views, err := client.Views.Show(ctx, sdk.NewShowViewRequest().WithIn(&sdk.In{Database: id}))
+		if err != nil {
+			return fmt.Errorf("unable to show views in database %s: %w", id.Name(), err)
+		}
+		for _, view := range views {
+			if slices.Contains(systemSchemas, view.SchemaName) {
+				continue
+			}
+			// Materialized views are managed with snowflake_materialized_view, which the generator does not emit yet.
+			if view.IsMaterialized {
+				log.Printf("[DEBUG] skipping materialized view %s", view.ID().FullyQualifiedName())
+				continue
+			}
+			account.Views = append(account.Views, view)
+		}
+	}
+	return nil
+}